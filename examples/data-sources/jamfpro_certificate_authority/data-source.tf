@@ -0,0 +1,49 @@
+data "jamfpro_certificate_authority" "built_in" {}
+
+output "built_in_ca_subject" {
+  value = data.jamfpro_certificate_authority.built_in.subject
+}
+
+output "built_in_ca_not_after" {
+  value = data.jamfpro_certificate_authority.built_in.not_after
+}
+
+// Install the built-in CA as a trusted root using the profile generator
+resource "jamfpro_macos_configuration_profile_plist_generator" "built_in_ca_root" {
+  name                = "Jamf Pro Built-in CA"
+  description         = "Trusts the Jamf Pro built-in certificate authority"
+  distribution_method = "Install Automatically"
+  redeploy_on_update  = "Newly Assigned"
+  user_removable      = false
+  level               = "System"
+
+  scope {
+    all_computers = true
+  }
+
+  payloads {
+    payload_description_header  = "Trusts the Jamf Pro built-in certificate authority"
+    payload_enabled_header      = true
+    payload_organization_header = "Deployment Theory"
+    payload_type_header         = "Configuration"
+    payload_version_header      = 1
+    payload_scope_header        = "System"
+
+    payload_content {
+      dynamic "setting" {
+        for_each = data.jamfpro_certificate_authority.built_in.certificates[0].root_payload_settings
+        content {
+          key        = setting.value.key
+          value      = setting.value.value
+          value_type = setting.value.value_type
+        }
+      }
+
+      payload_display_name = data.jamfpro_certificate_authority.built_in.certificates[0].common_name
+      payload_enabled      = true
+      payload_organization = "Deployment Theory"
+      payload_type         = "com.apple.security.root"
+      payload_version      = 1
+    }
+  }
+}
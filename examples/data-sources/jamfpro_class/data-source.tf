@@ -0,0 +1,11 @@
+data "jamfpro_class" "by_name" {
+  name = "Year 9 Science"
+}
+
+data "jamfpro_class" "by_id" {
+  id = "1"
+}
+
+output "class_students" {
+  value = data.jamfpro_class.by_name.student_usernames
+}
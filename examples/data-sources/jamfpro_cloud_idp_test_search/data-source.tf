@@ -0,0 +1,17 @@
+data "jamfpro_cloud_idp_test_search" "verify" {
+  cloud_idp_id = jamfpro_cloud_idp.entra_id.id
+  username     = "jane.doe@contoso.com"
+  group_name   = "Mac Users"
+}
+
+check "directory_lookup" {
+  assert {
+    condition     = length(data.jamfpro_cloud_idp_test_search.verify.users) == 1
+    error_message = "Test user was not found in the cloud identity provider."
+  }
+
+  assert {
+    condition     = data.jamfpro_cloud_idp_test_search.verify.is_member
+    error_message = "Test user is not a member of the test group."
+  }
+}
@@ -0,0 +1,26 @@
+# All 14" M1 Pro MacBook Pros with their hardware and user details
+data "jamfpro_computer_inventories" "mbp_m1_pro" {
+  filter   = "hardware.model==\"MacBookPro18,1\""
+  sort     = "general.name:asc"
+  sections = ["general", "hardware", "user_and_location"]
+}
+
+# Every computer, general section only, capped at 5000 records
+data "jamfpro_computer_inventories" "fleet" {
+  page_size   = 500
+  max_results = 5000
+}
+
+# Seed a static group from the query
+resource "jamfpro_static_computer_group" "mbp_m1_pro" {
+  name                  = "MacBook Pro 14 (M1 Pro)"
+  assigned_computer_ids = [for computer in data.jamfpro_computer_inventories.mbp_m1_pro.computers : tonumber(computer.id)]
+}
+
+output "fleet_summary" {
+  value = {
+    total     = data.jamfpro_computer_inventories.fleet.total_count
+    truncated = data.jamfpro_computer_inventories.fleet.truncated
+    serials   = data.jamfpro_computer_inventories.mbp_m1_pro.computers[*].hardware[0].serial_number
+  }
+}
@@ -0,0 +1,19 @@
+# Macs assigned to the ADE instance that have not yet been assigned to a prestage
+data "jamfpro_device_enrollment_devices" "unassigned_macs" {
+  device_enrollment_id = jamfpro_device_enrollments.abm.id
+  model                = "Mac"
+  unassigned           = true
+}
+
+# Route new Macs to the engineering prestage
+resource "jamfpro_computer_prestage_scope" "engineering_new_macs" {
+  prestage_id    = jamfpro_computer_prestage_enrollment.engineering.id
+  authoritative  = false
+  serial_numbers = data.jamfpro_device_enrollment_devices.unassigned_macs.devices[*].serial_number
+}
+
+# Devices whose enrollment profile has been pushed
+data "jamfpro_device_enrollment_devices" "pushed" {
+  device_enrollment_id = jamfpro_device_enrollments.abm.id
+  profile_status       = "PUSHED"
+}
@@ -0,0 +1,11 @@
+data "jamfpro_directory_binding" "by_id" {
+  id = "1"
+}
+
+data "jamfpro_directory_binding" "by_name" {
+  name = "Example AD Binding"
+}
+
+output "directory_binding_domain" {
+  value = data.jamfpro_directory_binding.by_name.domain
+}
@@ -0,0 +1,11 @@
+data "jamfpro_ebook" "by_name" {
+  name = "Employee Handbook"
+}
+
+data "jamfpro_ebook" "by_id" {
+  id = "1"
+}
+
+output "ebook_scope" {
+  value = data.jamfpro_ebook.by_name.scope
+}
@@ -0,0 +1,11 @@
+data "jamfpro_ibeacon" "by_name" {
+  name = "Library"
+}
+
+data "jamfpro_ibeacon" "by_id" {
+  id = "1"
+}
+
+output "ibeacon_uuid" {
+  value = data.jamfpro_ibeacon.by_name.uuid
+}
@@ -0,0 +1,22 @@
+# All supervised iPads, reading only the sections needed
+data "jamfpro_mobile_device_inventories" "supervised_ipads" {
+  filter   = "general.supervised==true and hardware.model==\"iPad*\""
+  sort     = "general.displayName:asc"
+  sections = ["general", "hardware"]
+}
+
+# Every mobile device with all sections populated
+data "jamfpro_mobile_device_inventories" "all" {}
+
+output "supervised_ipads" {
+  value = {
+    count          = data.jamfpro_mobile_device_inventories.supervised_ipads.total_count
+    serial_numbers = data.jamfpro_mobile_device_inventories.supervised_ipads.mobile_devices[*].serial_number
+  }
+}
+
+# Seed a static group with the matching devices
+resource "jamfpro_static_mobile_device_group" "supervised_ipads" {
+  name                       = "Supervised iPads"
+  assigned_mobile_device_ids = [for device in data.jamfpro_mobile_device_inventories.supervised_ipads.mobile_devices : tonumber(device.id)]
+}
@@ -0,0 +1,21 @@
+# Look up a single mobile device by serial number
+data "jamfpro_mobile_device_inventory" "by_serial" {
+  serial_number = "DMPXK1ABCDEF"
+}
+
+# Or by Jamf Pro ID, UDID or display name
+data "jamfpro_mobile_device_inventory" "by_id" {
+  id = "42"
+}
+
+output "ipad_details" {
+  value = {
+    name        = data.jamfpro_mobile_device_inventory.by_serial.name
+    os_version  = data.jamfpro_mobile_device_inventory.by_serial.general[0].os_version
+    model       = data.jamfpro_mobile_device_inventory.by_serial.hardware[0].model
+    username    = data.jamfpro_mobile_device_inventory.by_serial.user_and_location[0].username
+    supervised  = data.jamfpro_mobile_device_inventory.by_serial.general[0].supervised
+    app_count   = length(data.jamfpro_mobile_device_inventory.by_serial.applications)
+    profile_ids = data.jamfpro_mobile_device_inventory.by_serial.profiles[*].identifier
+  }
+}
@@ -0,0 +1,13 @@
+# Titles available from the Jamf patch source
+data "jamfpro_patch_title_catalog" "chrome" {
+  name = "Google Chrome"
+}
+
+output "chrome_titles" {
+  value = data.jamfpro_patch_title_catalog.chrome.titles
+}
+
+# Titles available from an external patch source
+data "jamfpro_patch_title_catalog" "internal" {
+  source_id = jamfpro_patch_external_source.patch_server.id
+}
@@ -0,0 +1,7 @@
+data "jamfpro_removable_mac_address" "by_id" {
+  id = "1"
+}
+
+data "jamfpro_removable_mac_address" "by_name" {
+  name = "A0:CE:C8:12:34:56"
+}
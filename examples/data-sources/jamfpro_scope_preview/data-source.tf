@@ -0,0 +1,33 @@
+# Preview which computers a policy scope would apply to before applying it
+data "jamfpro_scope_preview" "office_rollout" {
+  scope {
+    all_computers      = false
+    computer_group_ids = [jamfpro_smart_computer_group.office_macs.id]
+    building_ids       = [jamfpro_building.hq.id]
+
+    limitations {
+      network_segment_ids = [jamfpro_network_segment.office.id]
+    }
+
+    exclusions {
+      computer_serial_numbers = ["C02ABC123XYZ"]
+    }
+  }
+}
+
+output "office_rollout_preview" {
+  value = {
+    targeted  = data.jamfpro_scope_preview.office_rollout.targeted_count
+    excluded  = data.jamfpro_scope_preview.office_rollout.excluded_count
+    effective = data.jamfpro_scope_preview.office_rollout.computer_count
+    computers = data.jamfpro_scope_preview.office_rollout.computers[*].name
+  }
+}
+
+# Fail the plan if a scope change would reach more computers than expected
+check "office_rollout_size" {
+  assert {
+    condition     = data.jamfpro_scope_preview.office_rollout.computer_count <= 250
+    error_message = "The office rollout scope now applies to more than 250 computers."
+  }
+}
@@ -0,0 +1,26 @@
+# All members of a smart computer group
+data "jamfpro_smart_computer_group_membership" "lab" {
+  name = "Lab Macs"
+}
+
+# Members filtered by name and read one page at a time
+data "jamfpro_smart_computer_group_membership" "lab_page" {
+  id         = data.jamfpro_smart_computer_group_membership.lab.id
+  name_regex = "^LAB-"
+  page       = 0
+  page_size  = 50
+}
+
+# Seed a static group from the current smart group membership
+resource "jamfpro_static_computer_group" "lab_snapshot" {
+  name                  = "Lab Macs Snapshot"
+  assigned_computer_ids = data.jamfpro_smart_computer_group_membership.lab.members[*].id
+}
+
+output "lab_members" {
+  value = {
+    total          = data.jamfpro_smart_computer_group_membership.lab.total_count
+    serial_numbers = data.jamfpro_smart_computer_group_membership.lab.members[*].serial_number
+    udids          = data.jamfpro_smart_computer_group_membership.lab_page.members[*].udid
+  }
+}
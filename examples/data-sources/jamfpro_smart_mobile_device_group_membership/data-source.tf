@@ -0,0 +1,19 @@
+# All members of a smart mobile device group
+data "jamfpro_smart_mobile_device_group_membership" "shared_ipads" {
+  name = "Shared iPads"
+}
+
+# Members filtered by serial number and read one page at a time
+data "jamfpro_smart_mobile_device_group_membership" "shared_ipads_page" {
+  id                  = data.jamfpro_smart_mobile_device_group_membership.shared_ipads.id
+  serial_number_regex = "^DMP"
+  page                = 1
+  page_size           = 25
+}
+
+output "shared_ipad_members" {
+  value = {
+    total = data.jamfpro_smart_mobile_device_group_membership.shared_ipads.total_count
+    udids = data.jamfpro_smart_mobile_device_group_membership.shared_ipads.members[*].udid
+  }
+}
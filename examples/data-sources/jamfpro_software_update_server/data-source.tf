@@ -0,0 +1,11 @@
+data "jamfpro_software_update_server" "by_id" {
+  id = "1"
+}
+
+data "jamfpro_software_update_server" "by_name" {
+  name = "Example SWU Server"
+}
+
+output "software_update_server_address" {
+  value = "${data.jamfpro_software_update_server.by_name.ip_address}:${data.jamfpro_software_update_server.by_name.port}"
+}
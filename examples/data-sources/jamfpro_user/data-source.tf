@@ -0,0 +1,11 @@
+data "jamfpro_user" "by_username" {
+  username = "jane.doe"
+}
+
+data "jamfpro_user" "by_email" {
+  email = "jane.doe@example.com"
+}
+
+output "user_full_name" {
+  value = data.jamfpro_user.by_email.full_name
+}
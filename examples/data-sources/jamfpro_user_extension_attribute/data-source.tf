@@ -0,0 +1,7 @@
+data "jamfpro_user_extension_attribute" "by_name" {
+  name = "Cost Centre"
+}
+
+output "cost_centre_choices" {
+  value = data.jamfpro_user_extension_attribute.by_name.popup_menu_choices
+}
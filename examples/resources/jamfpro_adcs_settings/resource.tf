@@ -0,0 +1,22 @@
+// AD CS connector with an outbound connection from Jamf Pro
+resource "jamfpro_adcs_settings" "outbound" {
+  display_name       = "Example AD CS"
+  ca_name            = "example-ADCS-CA"
+  fqdn               = "adcs.example.com"
+  adcs_url           = "https://adcs-connector.example.com"
+  revocation_enabled = true
+  outbound           = true
+
+  server_certificate_file_name  = "adcs-connector.cer"
+  server_certificate_file_bytes = filebase64("${path.module}/adcs-connector.cer")
+
+  client_certificate_file_name  = "jamf-pro-client.pfx"
+  client_certificate_file_bytes = filebase64("${path.module}/jamf-pro-client.pfx")
+  client_certificate_password   = var.adcs_client_certificate_password
+}
+
+variable "adcs_client_certificate_password" {
+  description = "The password of the AD CS connector client certificate keystore."
+  type        = string
+  sensitive   = true
+}
@@ -0,0 +1,55 @@
+resource "jamfpro_blueprint" "baseline" {
+  name             = "macOS Baseline"
+  description      = "Passcode, storage and software update enforcement"
+  device_group_ids = ["12"]
+
+  declaration {
+    type = "com.apple.configuration.passcode.settings"
+    payload = jsonencode({
+      RequirePasscode       = true
+      MinimumLength         = 12
+      MaximumFailedAttempts = 10
+    })
+  }
+
+  declaration {
+    type = "com.apple.configuration.diskmanagement.settings"
+    payload = jsonencode({
+      Restrictions = {
+        ExternalStorage = "ReadOnly"
+      }
+    })
+  }
+
+  declaration {
+    type = "com.apple.configuration.softwareupdate.enforcement.specific"
+    payload = jsonencode({
+      TargetOSVersion     = "15.4"
+      TargetLocalDateTime = "2025-05-01T18:00:00"
+    })
+  }
+
+  declaration {
+    type = "com.apple.configuration.management.status-subscriptions"
+    payload = jsonencode({
+      StatusItems = [
+        { Name = "softwareupdate.install-state" },
+        { Name = "device.operating-system.version" }
+      ]
+    })
+  }
+}
+
+resource "jamfpro_blueprint" "staged" {
+  name             = "Staged SSH configuration"
+  device_group_ids = ["14"]
+  deploy           = false
+
+  declaration {
+    type = "com.apple.configuration.services.configuration-files"
+    payload = jsonencode({
+      ServiceType        = "com.apple.sshd"
+      DataAssetReference = "sshd-config-asset"
+    })
+  }
+}
@@ -0,0 +1,16 @@
+resource "jamfpro_class" "year_9_science" {
+  name        = "Year 9 Science"
+  description = "Managed by Terraform"
+
+  student_usernames = ["student1", "student2"]
+  teacher_usernames = ["teacher1"]
+
+  student_group_ids       = [jamfpro_user_group.year_9_students.id]
+  mobile_device_group_ids = [jamfpro_static_mobile_device_group.science_ipads.id]
+
+  meeting_time {
+    days       = "M W F"
+    start_time = 1300
+    end_time   = 1345
+  }
+}
@@ -0,0 +1,17 @@
+# The consent code is returned by Microsoft after a tenant administrator grants the
+# Jamf Pro app admin consent. It is only needed when the connection is created.
+resource "jamfpro_cloud_idp" "entra_id" {
+  display_name = "Contoso Entra ID"
+  tenant_id    = "00000000-0000-0000-0000-000000000000"
+  consent_code = var.entra_admin_consent_code
+  enabled      = true
+
+  search_timeout                              = 30
+  transitive_membership_enabled               = true
+  membership_calculation_optimization_enabled = true
+
+  # Mappings not set here use the Jamf Pro defaults
+  user_mappings_username      = "userPrincipalName"
+  user_mappings_email_address = "mail"
+  user_mappings_position      = "jobTitle"
+}
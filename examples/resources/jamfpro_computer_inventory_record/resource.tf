@@ -0,0 +1,50 @@
+# Push asset data from a CMDB onto an existing computer. Only the fields set here are managed;
+# destroying the resource leaves the computer and its inventory as they are.
+resource "jamfpro_computer_inventory_record" "c02abc123xyz" {
+  serial_number = "C02ABC123XYZ"
+  asset_tag     = "IT-004211"
+  site_id       = jamfpro_site.london.id
+  building_id   = jamfpro_building.hq.id
+  department_id = jamfpro_department.engineering.id
+
+  user_and_location {
+    username      = "jdoe"
+    real_name     = "Jane Doe"
+    email_address = "jane.doe@example.com"
+    position      = "Engineer"
+    room          = "4.01"
+  }
+
+  purchasing {
+    purchased       = true
+    po_number       = "PO-2024-0193"
+    po_date         = "2024-03-14"
+    vendor          = "Apple"
+    warranty_date   = "2027-03-14"
+    life_expectancy = 4
+  }
+
+  extension_attribute {
+    id     = jamfpro_computer_extension_attribute.cost_centre.id
+    values = ["CC-1001"]
+  }
+}
+
+# Drive many records from a map of CMDB data
+locals {
+  cmdb_assets = {
+    "C02DEF456UVW" = { asset_tag = "IT-004212", username = "asmith" }
+    "C02GHI789RST" = { asset_tag = "IT-004213", username = "bjones" }
+  }
+}
+
+resource "jamfpro_computer_inventory_record" "cmdb" {
+  for_each = local.cmdb_assets
+
+  serial_number = each.key
+  asset_tag     = each.value.asset_tag
+
+  user_and_location {
+    username = each.value.username
+  }
+}
@@ -0,0 +1,13 @@
+# Authoritative: the prestage is assigned exactly these computers
+resource "jamfpro_computer_prestage_scope" "engineering" {
+  prestage_id    = jamfpro_computer_prestage_enrollment.engineering.id
+  serial_numbers = ["C02ABC123DEF", "C02GHI456JKL"]
+}
+
+# Non-authoritative: adds and removes only these computers, e.g. from a procurement pipeline,
+# leaving computers assigned by other configurations or in Jamf Pro in place
+resource "jamfpro_computer_prestage_scope" "new_purchases" {
+  prestage_id    = jamfpro_computer_prestage_enrollment.sales.id
+  authoritative  = false
+  serial_numbers = var.purchased_mac_serial_numbers
+}
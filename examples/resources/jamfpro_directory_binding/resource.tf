@@ -0,0 +1,33 @@
+resource "jamfpro_directory_binding" "example" {
+  name        = "Example AD Binding"
+  priority    = 1
+  domain      = "corp.example.com"
+  username    = "svc-jamf-bind"
+  password    = var.directory_binding_password
+  computer_ou = "OU=Macs,DC=corp,DC=example,DC=com"
+  type        = "Active Directory"
+}
+
+variable "directory_binding_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "jamfpro_policy" "bind_to_ad" {
+  name    = "Bind to Active Directory"
+  enabled = true
+
+  scope {
+    all_computers = false
+  }
+
+  payloads {
+    account_maintenance {
+      directory_bindings {
+        binding {
+          id = jamfpro_directory_binding.example.id
+        }
+      }
+    }
+  }
+}
@@ -0,0 +1,30 @@
+resource "jamfpro_ebook" "employee_handbook" {
+  name            = "Employee Handbook"
+  author          = "People Team"
+  version         = "2.1"
+  url             = "https://books.apple.com/us/book/id0000000000"
+  deployment_type = "Make Available in Self Service"
+
+  self_service {
+    self_service_display_name = "Employee Handbook"
+    install_button_text       = "Download"
+    self_service_description  = "The latest employee handbook."
+    feature_on_main_page      = true
+    self_service_category_ids = [jamfpro_category.reading.id]
+  }
+
+  scope {
+    all_computers           = false
+    computer_group_ids      = [jamfpro_smart_computer_group.staff_macs.id]
+    mobile_device_group_ids = [jamfpro_static_mobile_device_group.staff_ipads.id]
+    class_ids               = [jamfpro_class.year_9_science.id]
+
+    limitations {
+      ibeacon_ids = [jamfpro_ibeacon.library.id]
+    }
+
+    exclusions {
+      mobile_device_serial_numbers = ["F9FXXXXXXXXX"]
+    }
+  }
+}
@@ -0,0 +1,11 @@
+resource "jamfpro_ibeacon" "library" {
+  name  = "Library"
+  uuid  = "55DB9D1C-6A2C-4F4A-9A4E-5C3F1A7B2E10"
+  major = 1
+}
+
+# Use the region to limit or exclude scope
+resource "jamfpro_ibeacon" "any_region" {
+  name = "Campus - any beacon"
+  uuid = "55DB9D1C-6A2C-4F4A-9A4E-5C3F1A7B2E10"
+}
@@ -23,6 +23,22 @@ resource "jamfpro_macos_configuration_profile_plist" "jamfpro_macos_configuratio
 
 }
 
+// Example of a profile with deterministic payload UUIDs, which stay stable when the profile is
+// replaced on the same tenant and deliberately differ between tenants
+resource "jamfpro_macos_configuration_profile_plist" "wifi" {
+  name                = "Corporate Wi-Fi"
+  level               = "System"
+  distribution_method = "Install Automatically"
+  redeploy_on_update  = "Newly Assigned"
+  payloads            = file("${path.module}/path/to/your/wifi.mobileconfig")
+  payload_uuid_mode   = "deterministic"
+  payload_uuid_key    = "jamfpro_macos_configuration_profile_plist.wifi"
+
+  scope {
+    all_computers = true
+  }
+}
+
 // Example of creating a macOS configuration profile in Jamf Pro for self service using a plist source file
 resource "jamfpro_macos_configuration_profile_plist" "jamfpro_macos_configuration_profile_001" {
   name                = "your-name-${var.version_number}"
@@ -182,3 +182,77 @@ resource "jamfpro_macos_configuration_profile_plist_generator" "jamfpro_macos_co
     }
   }
 }
+
+// example hcl generated plist using typed values, nested dictionaries and arrays, with deterministic
+// payload UUIDs that stay stable when the profile is replaced on the same tenant
+resource "jamfpro_macos_configuration_profile_plist_generator" "jamfpro_macos_configuration_profile_plist_generator_004" {
+  name                = "tf-localtest-generator-typed-values"
+  description         = "Typed values, nested dictionaries and arrays"
+  distribution_method = "Install Automatically"
+  redeploy_on_update  = "Newly Assigned"
+  user_removable      = false
+  level               = "System"
+  payload_uuid_mode   = "deterministic"
+  payload_uuid_key    = "jamfpro_macos_configuration_profile_plist_generator.jamfpro_macos_configuration_profile_plist_generator_004"
+
+  scope {
+    all_computers = true
+    all_jss_users = false
+  }
+
+  payloads {
+    payload_description_header  = "Typed values, nested dictionaries and arrays"
+    payload_enabled_header      = true
+    payload_organization_header = "Deployment Theory"
+    payload_type_header         = "Configuration"
+    payload_version_header      = 1
+    payload_scope_header        = "System"
+
+    payload_content {
+      setting {
+        key        = "AutomaticCheckEnabled"
+        value      = "true"
+        value_type = "boolean"
+      }
+      setting {
+        key        = "BuildNumber"
+        value      = "10"
+        value_type = "string" // without value_type this would be inferred as an integer
+      }
+      setting {
+        key        = "ReminderInterval"
+        value      = "1.5"
+        value_type = "real"
+      }
+      setting {
+        key        = "NotBefore"
+        value      = "2025-01-01T00:00:00Z"
+        value_type = "date"
+      }
+      setting {
+        key = "Services"
+        dictionary {
+          key        = "Accessibility"
+          value_json = jsonencode([
+            {
+              Allowed         = true
+              CodeRequirement = "identifier \"com.example.app\" and anchor apple generic"
+              Identifier      = "com.example.app"
+              IdentifierType  = "bundleID"
+            }
+          ])
+        }
+      }
+      setting {
+        key        = "AllowedDomains"
+        value_json = jsonencode(["example.com", "example.org"])
+      }
+
+      payload_display_name = "Typed Values"
+      payload_enabled      = true
+      payload_organization = "Deployment Theory"
+      payload_type         = "com.example.typed"
+      payload_version      = 1
+    }
+  }
+}
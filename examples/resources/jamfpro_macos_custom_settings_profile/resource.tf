@@ -0,0 +1,34 @@
+// custom settings from an HCL object
+resource "jamfpro_macos_custom_settings_profile" "google_chrome" {
+  name              = "tf-example-custom-settings-chrome"
+  description       = "Managed preferences for Google Chrome"
+  organization      = "Deployment Theory"
+  level             = "System"
+  preference_domain = "com.google.Chrome"
+
+  settings = jsonencode({
+    HomepageLocation          = "https://www.example.com"
+    IncognitoModeAvailability = 1
+    PasswordManagerEnabled    = false
+    URLBlocklist              = ["example.org", "example.net"]
+  })
+
+  // optional, validates the settings at plan time
+  schema_manifest = file("${path.module}/schemas/com.google.Chrome.json")
+
+  scope {
+    all_computers      = false
+    computer_group_ids = [1]
+  }
+}
+
+// custom settings from a .plist or .json file
+resource "jamfpro_macos_custom_settings_profile" "microsoft_autoupdate" {
+  name              = "tf-example-custom-settings-msau"
+  preference_domain = "com.microsoft.autoupdate2"
+  settings_file     = "${path.module}/settings/com.microsoft.autoupdate2.plist"
+
+  scope {
+    all_computers = true
+  }
+}
@@ -0,0 +1,48 @@
+resource "jamfpro_macos_pppc_profile" "security_agent" {
+  name               = "tf-example-pppc-security-agent"
+  description        = "Grants the security agent full disk access and allows it to script Finder"
+  organization       = "Deployment Theory"
+  redeploy_on_update = "Newly Assigned"
+
+  scope {
+    all_computers      = false
+    computer_group_ids = [1]
+  }
+
+  service {
+    name             = "SystemPolicyAllFiles"
+    identifier       = "com.example.securityagent"
+    identifier_type  = "bundleID"
+    code_requirement = "identifier \"com.example.securityagent\" and anchor apple generic and certificate leaf[subject.OU] = ABCDE12345"
+    authorization    = "Allow"
+  }
+
+  service {
+    name             = "SystemPolicyAllFiles"
+    identifier       = "/usr/local/bin/securityagentd"
+    identifier_type  = "path"
+    code_requirement = "identifier securityagentd and anchor apple generic and certificate leaf[subject.OU] = ABCDE12345"
+    authorization    = "Allow"
+    comment          = "Daemon binary"
+  }
+
+  service {
+    name             = "AppleEvents"
+    identifier       = "com.example.securityagent"
+    code_requirement = "identifier \"com.example.securityagent\" and anchor apple generic and certificate leaf[subject.OU] = ABCDE12345"
+    authorization    = "Allow"
+
+    apple_events_receiver {
+      identifier       = "com.apple.finder"
+      identifier_type  = "bundleID"
+      code_requirement = "identifier \"com.apple.finder\" and anchor apple"
+    }
+  }
+
+  service {
+    name             = "ScreenCapture"
+    identifier       = "com.example.securityagent"
+    code_requirement = "identifier \"com.example.securityagent\" and anchor apple generic and certificate leaf[subject.OU] = ABCDE12345"
+    authorization    = "AllowStandardUserToSetSystemService"
+  }
+}
@@ -90,3 +90,31 @@ Minimum Requirements:
     }
   }
 }
+
+// Managed app configuration built from a map and encoded as a plist <dict>
+resource "jamfpro_mobile_device_application" "managed_app_configuration" {
+  name         = "Example App"
+  display_name = "Example App"
+  bundle_id    = "com.example.app"
+  version      = "1.0"
+
+  deploy_as_managed_app = true
+
+  app_configuration {
+    preferences_map = {
+      ServerURL      = "https://example.com"
+      SerialNumber   = "$SERIALNUMBER"
+      UserEmail      = "$EMAIL"
+      AssetOwner     = "$EXTENSIONATTRIBUTE_12"
+      Port           = 8443
+      SSOEnabled     = true
+      PinCode        = jsonencode("0042")
+      AllowedDomains = jsonencode(["example.com", "example.org"])
+      ProxySettings  = jsonencode({ Host = "proxy.example.com", Port = 3128 })
+    }
+  }
+
+  scope {
+    all_mobile_devices = true
+  }
+}
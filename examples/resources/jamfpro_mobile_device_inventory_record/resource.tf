@@ -0,0 +1,27 @@
+# Push asset data from a CMDB onto an existing mobile device. Only the fields set here are
+# managed; destroying the resource leaves the device and its inventory as they are.
+resource "jamfpro_mobile_device_inventory_record" "dmpxk1abcdef" {
+  serial_number = "DMPXK1ABCDEF"
+  asset_tag     = "IPAD-000871"
+  building_id   = jamfpro_building.hq.id
+  department_id = jamfpro_department.sales.id
+
+  user_and_location {
+    username      = "jdoe"
+    real_name     = "Jane Doe"
+    email_address = "jane.doe@example.com"
+  }
+
+  purchasing {
+    purchased     = true
+    po_number     = "PO-2024-0207"
+    vendor        = "Apple"
+    apple_care_id = "AC123456789"
+    warranty_date = "2026-05-01"
+  }
+
+  extension_attribute {
+    id     = jamfpro_mobile_device_extension_attribute.cost_centre.id
+    values = ["CC-2002"]
+  }
+}
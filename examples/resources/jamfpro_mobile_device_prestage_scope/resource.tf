@@ -0,0 +1,12 @@
+# Authoritative: the prestage is assigned exactly these mobile devices
+resource "jamfpro_mobile_device_prestage_scope" "retail_ipads" {
+  prestage_id    = jamfpro_mobile_device_prestage_enrollment.retail.id
+  serial_numbers = ["DMPXYZ123ABC", "DMPXYZ456DEF"]
+}
+
+# Non-authoritative: adds and removes only these mobile devices
+resource "jamfpro_mobile_device_prestage_scope" "new_purchases" {
+  prestage_id    = jamfpro_mobile_device_prestage_enrollment.staff.id
+  authoritative  = false
+  serial_numbers = var.purchased_iphone_serial_numbers
+}
@@ -1,3 +1,9 @@
+resource "jamfpro_software_update_server" "example" {
+  name       = "Example SWU Server"
+  ip_address = "swu.example.com"
+  port       = 8088
+}
+
 resource "jamfpro_network_segment" "jamfpro_network_segment_001" {
   name                 = "Example Network Segment"
   starting_address     = "192.168.1.1"
@@ -5,7 +11,7 @@ resource "jamfpro_network_segment" "jamfpro_network_segment_001" {
   distribution_server  = "Example Distribution Server"
   distribution_point   = "Example Distribution Point"
   url                  = "http://example.com"
-  swu_server           = "Example SWU Server"
+  swu_server_id        = jamfpro_software_update_server.example.id
   building             = "Main Building"
   department           = "IT Department"
   override_buildings   = true
@@ -0,0 +1,6 @@
+resource "jamfpro_patch_external_source" "patch_server" {
+  name        = "Internal Patch Server"
+  host_name   = "patch.example.com"
+  port        = 443
+  ssl_enabled = true
+}
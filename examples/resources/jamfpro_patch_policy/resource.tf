@@ -0,0 +1,35 @@
+resource "jamfpro_patch_policy" "google_chrome_self_service" {
+  name                            = "Google Chrome - Self Service"
+  software_title_configuration_id = jamfpro_patch_software_title_configuration.google_chrome.id
+  target_version                  = "121.0.6167.85"
+  distribution_method             = "selfservice"
+  enabled                         = true
+
+  user_interaction {
+    install_button_text      = "Update"
+    self_service_description = "Updates Google Chrome to the latest approved version."
+
+    notification_enabled = true
+    notification_type    = "Self Service and Notification Center"
+    notification_subject = "Google Chrome update available"
+    notification_message = "A new version of Google Chrome is available in Self Service."
+    reminders_enabled    = true
+    reminder_frequency   = 1
+
+    deadline_enabled = true
+    deadline_period  = 7
+
+    grace_period_duration             = 30
+    grace_period_notification_subject = "Google Chrome will restart"
+    grace_period_message              = "$APP_NAMES will quit in $DELAY_MINUTES minutes so that $SOFTWARE_TITLE can be updated."
+  }
+
+  scope {
+    all_computers      = false
+    computer_group_ids = [jamfpro_smart_computer_group.chrome_installed.id]
+
+    exclusions {
+      computer_serial_numbers = ["C02XXXXXXXXX"]
+    }
+  }
+}
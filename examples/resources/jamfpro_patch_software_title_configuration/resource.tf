@@ -0,0 +1,22 @@
+resource "jamfpro_patch_software_title_configuration" "google_chrome" {
+  display_name        = "Google Chrome"
+  software_title_id   = "1"
+  category_id         = jamfpro_category.browsers.id
+  ui_notifications    = true
+  email_notifications = false
+
+  extension_attribute {
+    ea_id    = "google-chrome-ea"
+    accepted = true
+  }
+
+  package {
+    package_id = jamfpro_package.google_chrome_120.id
+    version    = "120.0.6099.129"
+  }
+
+  package {
+    package_id = jamfpro_package.google_chrome_121.id
+    version    = "121.0.6167.85"
+  }
+}
@@ -0,0 +1,3 @@
+resource "jamfpro_removable_mac_address" "usb_ethernet_adapter" {
+  name = "A0:CE:C8:12:34:56"
+}
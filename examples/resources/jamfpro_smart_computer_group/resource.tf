@@ -26,3 +26,10 @@ resource "jamfpro_smart_computer_group" "smart_example" {
   }
 
 }
+
+resource "jamfpro_smart_computer_group" "sonoma_or_air_outside_lab" {
+  name = "Sonoma or MacBook Air outside the lab"
+
+  # Alternative to criteria blocks; priorities and parentheses are computed
+  criteria_expression = "(\"Operating System Version\" like \"15.\" or \"Model\" is \"MacBookAir10,1\") and not \"Computer Group\" member of \"Lab\""
+}
@@ -26,3 +26,10 @@ resource "jamfpro_smart_mobile_device_group" "smart_example" {
   }
 
 }
+
+resource "jamfpro_smart_mobile_device_group" "expression_example" {
+  name = "Supervised iPads running iPadOS 18"
+
+  # Alternative to criteria blocks; priorities and parentheses are computed
+  criteria_expression = "\"Model\" like \"iPad\" and \"Supervised\" is \"Supervised\" and \"OS Version\" like \"18.\""
+}
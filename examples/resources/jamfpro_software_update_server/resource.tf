@@ -0,0 +1,6 @@
+resource "jamfpro_software_update_server" "example" {
+  name            = "Example SWU Server"
+  ip_address      = "swu.example.com"
+  port            = 8088
+  set_system_wide = true
+}
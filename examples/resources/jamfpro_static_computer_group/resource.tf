@@ -7,4 +7,12 @@ resource "jamfpro_static_computer_group" "jamfpro_static_computer_group_001" {
 
   # Optional: Specify computers for static groups
   assigned_computer_ids = [1, 2, 3]
-}
\ No newline at end of file
+}
+# Reference computers by serial number or UDID instead of tenant specific Jamf IDs. They are
+# resolved to IDs at apply time, unknown values are reported as errors, and drift is shown by serial.
+resource "jamfpro_static_computer_group" "jamfpro_static_computer_group_002" {
+  name = "Example Static Computer Group by Serial"
+
+  assigned_computer_serial_numbers = ["C02ABC123DEF", "C02GHI456JKL"]
+  assigned_computer_udids          = ["8A3F4C52-1D2E-4B6A-9F0C-7E1D2C3B4A5F"]
+}
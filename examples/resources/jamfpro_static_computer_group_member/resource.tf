@@ -0,0 +1,22 @@
+# The group definition does not own its members, so members added below, by other
+# configurations or in the Jamf Pro GUI are not reported as drift.
+resource "jamfpro_static_computer_group" "lab" {
+  name = "Lab Computers"
+
+  lifecycle {
+    ignore_changes = [assigned_computer_ids]
+  }
+}
+
+resource "jamfpro_static_computer_group_member" "lab_01" {
+  group_id    = jamfpro_static_computer_group.lab.id
+  computer_id = 101
+}
+
+resource "jamfpro_static_computer_group_member" "lab_02" {
+  group_id    = jamfpro_static_computer_group.lab.id
+  computer_id = 102
+}
+
+# Existing memberships can be imported with:
+# terraform import jamfpro_static_computer_group_member.lab_01 <group_id>:<computer_id>
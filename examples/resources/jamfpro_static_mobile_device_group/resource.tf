@@ -8,3 +8,10 @@ resource "jamfpro_static_mobile_device_group" "jamfpro_static_mobile_device_grou
   # Optional: Specify computers for static groups
   assigned_mobile_device_ids = [1, 2, 3]
 }
+
+# Reference mobile devices by serial number or UDID instead of tenant specific Jamf IDs.
+resource "jamfpro_static_mobile_device_group" "jamfpro_static_mobile_device_group_002" {
+  name = "Example Mobile Device Group by Serial"
+
+  assigned_mobile_device_serial_numbers = ["DMPXK1ABCD12", "DMPXK1EFGH34"]
+}
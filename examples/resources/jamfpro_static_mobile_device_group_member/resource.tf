@@ -0,0 +1,17 @@
+# The group definition does not own its members, so members added below, by other
+# configurations or in the Jamf Pro GUI are not reported as drift.
+resource "jamfpro_static_mobile_device_group" "loaner_ipads" {
+  name = "Loaner iPads"
+
+  lifecycle {
+    ignore_changes = [assigned_mobile_device_ids]
+  }
+}
+
+resource "jamfpro_static_mobile_device_group_member" "loaner_01" {
+  group_id         = jamfpro_static_mobile_device_group.loaner_ipads.id
+  mobile_device_id = 201
+}
+
+# Existing memberships can be imported with:
+# terraform import jamfpro_static_mobile_device_group_member.loaner_01 <group_id>:<mobile_device_id>
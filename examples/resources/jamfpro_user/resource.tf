@@ -0,0 +1,20 @@
+resource "jamfpro_user" "jane_doe" {
+  username     = "jane.doe"
+  full_name    = "Jane Doe"
+  email        = "jane.doe@example.com"
+  phone_number = "555-0100"
+  position     = "Engineer"
+  site_ids     = [1]
+
+  extension_attribute {
+    id    = jamfpro_user_extension_attribute.cost_centre.id
+    value = "Engineering"
+  }
+}
+
+# Users can then be added to static user groups
+resource "jamfpro_user_group" "engineers" {
+  name              = "Engineers"
+  is_smart          = false
+  assigned_user_ids = [jamfpro_user.jane_doe.id]
+}
@@ -0,0 +1,11 @@
+resource "jamfpro_user_extension_attribute" "employee_id" {
+  name        = "Employee ID"
+  description = "HR employee number"
+  data_type   = "Integer"
+}
+
+resource "jamfpro_user_extension_attribute" "cost_centre" {
+  name               = "Cost Centre"
+  input_type         = "Pop-up Menu"
+  popup_menu_choices = ["Engineering", "Finance", "Sales"]
+}
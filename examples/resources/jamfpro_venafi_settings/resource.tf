@@ -0,0 +1,13 @@
+resource "jamfpro_venafi_settings" "example" {
+  name               = "Example Venafi"
+  proxy_address      = "venafi-proxy.example.com:9443"
+  revocation_enabled = false
+  client_id          = "jamf-pro"
+  refresh_token      = var.venafi_refresh_token
+}
+
+variable "venafi_refresh_token" {
+  description = "A Venafi refresh token for the Jamf Pro API integration."
+  type        = string
+  sensitive   = true
+}
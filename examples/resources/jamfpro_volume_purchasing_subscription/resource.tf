@@ -0,0 +1,17 @@
+resource "jamfpro_volume_purchasing_subscription" "example" {
+  name     = "Volume Purchasing Alerts"
+  enabled  = true
+  triggers = ["NO_MORE_LICENSES", "REMOVED_FROM_APP_STORE"]
+
+  location_ids = [jamfpro_volume_purchasing_locations.example.id]
+
+  internal_recipient {
+    account_id = "1"
+    frequency  = "DAILY"
+  }
+
+  external_recipient {
+    name  = "IT Service Desk"
+    email = "servicedesk@example.com"
+  }
+}
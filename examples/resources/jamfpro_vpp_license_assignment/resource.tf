@@ -0,0 +1,17 @@
+// The plan fails when the assignment needs more licenses than
+// license_count_total - license_count_in_use of the content.
+resource "jamfpro_vpp_license_assignment" "example" {
+  name                          = "Jamf Self Service - Staff"
+  volume_purchasing_location_id = 1
+  adam_id                       = "718509958"
+  content_type                  = "ios_app"
+
+  jss_user_ids       = [12, 15]
+  jss_user_group_ids = [4]
+
+  exclusion_jss_user_ids = [20]
+}
+
+output "self_service_licenses_available" {
+  value = jamfpro_vpp_license_assignment.example.license_count_total - jamfpro_vpp_license_assignment.example.license_count_in_use
+}
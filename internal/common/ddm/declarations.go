@@ -0,0 +1,203 @@
+// common/ddm/declarations.go
+// Description: This file contains plan time validation of Declarative Device Management (DDM)
+// declarations against Apple's declaration schemas, and their mapping to Jamf Pro blueprint components.
+package ddm
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/plist"
+)
+
+// Supported DDM declaration types.
+const (
+	DeclarationTypeSoftwareUpdateEnforcement = "com.apple.configuration.softwareupdate.enforcement.specific"
+	DeclarationTypePasscodeSettings          = "com.apple.configuration.passcode.settings"
+	DeclarationTypeDiskManagementSettings    = "com.apple.configuration.diskmanagement.settings"
+	DeclarationTypeServiceConfigurationFiles = "com.apple.configuration.services.configuration-files"
+	DeclarationTypeStatusSubscriptions       = "com.apple.configuration.management.status-subscriptions"
+)
+
+// declarationPayloadSchemas holds the 'Payload' schema of each supported declaration type, expressed
+// in the JSON schema subset understood by plist.ValidateAgainstJamfJSONSchema. The schemas mirror
+// the declarations published in Apple's device-management repository.
+var declarationPayloadSchemas = map[string]string{
+	DeclarationTypeSoftwareUpdateEnforcement: `{
+		"type": "object",
+		"additionalProperties": false,
+		"required": ["TargetOSVersion", "TargetLocalDateTime"],
+		"properties": {
+			"TargetOSVersion": {"type": "string", "pattern": "^[0-9]+(\\.[0-9]+){0,2}$"},
+			"TargetBuildVersion": {"type": "string"},
+			"TargetLocalDateTime": {"type": "string", "pattern": "^[0-9]{4}-[0-9]{2}-[0-9]{2}T[0-9]{2}:[0-9]{2}:[0-9]{2}$"},
+			"DetailsURL": {"type": "string", "pattern": "^https://"}
+		}
+	}`,
+	DeclarationTypePasscodeSettings: `{
+		"type": "object",
+		"additionalProperties": false,
+		"properties": {
+			"RequirePasscode": {"type": "boolean"},
+			"RequireAlphanumericPasscode": {"type": "boolean"},
+			"RequireComplexPasscode": {"type": "boolean"},
+			"MinimumLength": {"type": "integer", "minimum": 0, "maximum": 16},
+			"MinimumComplexCharacters": {"type": "integer", "minimum": 0, "maximum": 4},
+			"MaximumFailedAttempts": {"type": "integer", "minimum": 2, "maximum": 11},
+			"FailedAttemptsResetInMinutes": {"type": "integer", "minimum": 0},
+			"MaximumGracePeriodInMinutes": {"type": "integer", "minimum": 0},
+			"MaximumInactivityInMinutes": {"type": "integer", "minimum": 0},
+			"MaximumPasscodeAgeInDays": {"type": "integer", "minimum": 0, "maximum": 730},
+			"PasscodeReuseLimit": {"type": "integer", "minimum": 1, "maximum": 50},
+			"ChangeAtNextAuth": {"type": "boolean"},
+			"CustomRegex": {
+				"type": "object",
+				"additionalProperties": false,
+				"required": ["Regex"],
+				"properties": {
+					"Regex": {"type": "string", "minLength": 1},
+					"Description": {"type": "object"}
+				}
+			}
+		}
+	}`,
+	DeclarationTypeDiskManagementSettings: `{
+		"type": "object",
+		"additionalProperties": false,
+		"properties": {
+			"Restrictions": {
+				"type": "object",
+				"additionalProperties": false,
+				"properties": {
+					"ExternalStorage": {"type": "string", "enum": ["Allowed", "ReadOnly", "Disallowed"]},
+					"NetworkStorage": {"type": "string", "enum": ["Allowed", "ReadOnly", "Disallowed"]}
+				}
+			}
+		}
+	}`,
+	DeclarationTypeServiceConfigurationFiles: `{
+		"type": "object",
+		"additionalProperties": false,
+		"required": ["ServiceType", "DataAssetReference"],
+		"properties": {
+			"ServiceType": {"type": "string", "pattern": "^com\\.apple\\.[A-Za-z0-9.-]+$"},
+			"DataAssetReference": {"type": "string", "minLength": 1}
+		}
+	}`,
+	DeclarationTypeStatusSubscriptions: `{
+		"type": "object",
+		"additionalProperties": false,
+		"required": ["StatusItems"],
+		"properties": {
+			"StatusItems": {
+				"type": "array",
+				"minItems": 1,
+				"items": {
+					"type": "object",
+					"additionalProperties": false,
+					"required": ["Name"],
+					"properties": {
+						"Name": {"type": "string", "minLength": 1}
+					}
+				}
+			}
+		}
+	}`,
+}
+
+// blueprintComponentIdentifiers maps each supported declaration type to the identifier of the Jamf
+// Pro blueprint component that deploys it.
+var blueprintComponentIdentifiers = map[string]string{
+	DeclarationTypeSoftwareUpdateEnforcement: "com.jamf.ddm.sw-updates",
+	DeclarationTypePasscodeSettings:          "com.jamf.ddm.passcode-settings",
+	DeclarationTypeDiskManagementSettings:    "com.jamf.ddm.disk-management",
+	DeclarationTypeServiceConfigurationFiles: "com.jamf.ddm.service-configuration-files",
+	DeclarationTypeStatusSubscriptions:       "com.jamf.ddm.status-subscriptions",
+}
+
+// BlueprintComponentIdentifier returns the Jamf Pro blueprint component identifier of a declaration type.
+func BlueprintComponentIdentifier(declarationType string) (string, bool) {
+	identifier, ok := blueprintComponentIdentifiers[declarationType]
+	return identifier, ok
+}
+
+// DeclarationTypeOfBlueprintComponent returns the declaration type deployed by a Jamf Pro blueprint
+// component, the inverse of BlueprintComponentIdentifier.
+func DeclarationTypeOfBlueprintComponent(identifier string) (string, bool) {
+	for declarationType, componentIdentifier := range blueprintComponentIdentifiers {
+		if componentIdentifier == identifier {
+			return declarationType, true
+		}
+	}
+	return "", false
+}
+
+// SupportedDeclarationTypes returns the declaration types that can be validated, in alphabetical order.
+func SupportedDeclarationTypes() []string {
+	types := make([]string, 0, len(declarationPayloadSchemas))
+	for t := range declarationPayloadSchemas {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// ValidateDeclarationPayload validates the 'Payload' of a declaration of declarationType, given as a
+// JSON object, against Apple's schema for that declaration type.
+func ValidateDeclarationPayload(declarationType string, payloadJSON string) error {
+	schema, ok := declarationPayloadSchemas[declarationType]
+	if !ok {
+		return fmt.Errorf("unsupported declaration type '%s'; supported types are %v", declarationType, SupportedDeclarationTypes())
+	}
+
+	decoded, err := plist.DecodeJSONValue(payloadJSON)
+	if err != nil {
+		return err
+	}
+
+	payload, ok := decoded.(map[string]any)
+	if !ok {
+		return fmt.Errorf("declaration payload must be a JSON object")
+	}
+
+	if errs := plist.ValidateAgainstJamfJSONSchema(payload, schema); len(errs) > 0 {
+		return fmt.Errorf("invalid %s declaration:\n%w", declarationType, errors.Join(errs...))
+	}
+
+	return nil
+}
+
+// ValidateDeclaration validates a complete declaration document with 'Type', 'Identifier' and
+// 'Payload' keys, as stored by Jamf Pro, against Apple's schema for its declaration type.
+func ValidateDeclaration(declarationJSON string) error {
+	decoded, err := plist.DecodeJSONValue(declarationJSON)
+	if err != nil {
+		return err
+	}
+
+	declaration, ok := decoded.(map[string]any)
+	if !ok {
+		return fmt.Errorf("declaration must be a JSON object")
+	}
+
+	declarationType, _ := declaration["Type"].(string)
+	if declarationType == "" {
+		return fmt.Errorf("declaration is missing the 'Type' key")
+	}
+	if identifier, _ := declaration["Identifier"].(string); identifier == "" {
+		return fmt.Errorf("declaration is missing the 'Identifier' key")
+	}
+
+	payload, ok := declaration["Payload"].(map[string]any)
+	if !ok {
+		return fmt.Errorf("declaration 'Payload' must be a JSON object")
+	}
+
+	payloadJSON, err := plist.EncodeJSONValue(payload)
+	if err != nil {
+		return err
+	}
+
+	return ValidateDeclarationPayload(declarationType, payloadJSON)
+}
@@ -0,0 +1,62 @@
+package ddm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateDeclarationPayload(t *testing.T) {
+	tests := []struct {
+		name            string
+		declarationType string
+		payload         string
+		wantErr         bool
+	}{
+		{name: "Software update enforcement", declarationType: DeclarationTypeSoftwareUpdateEnforcement, payload: `{"TargetOSVersion": "15.1", "TargetLocalDateTime": "2025-01-01T09:00:00"}`},
+		{name: "Software update missing date", declarationType: DeclarationTypeSoftwareUpdateEnforcement, payload: `{"TargetOSVersion": "15.1"}`, wantErr: true},
+		{name: "Software update invalid date", declarationType: DeclarationTypeSoftwareUpdateEnforcement, payload: `{"TargetOSVersion": "15.1", "TargetLocalDateTime": "tomorrow"}`, wantErr: true},
+		{name: "Passcode", declarationType: DeclarationTypePasscodeSettings, payload: `{"RequirePasscode": true, "MinimumLength": 8, "MaximumFailedAttempts": 10}`},
+		{name: "Passcode out of range", declarationType: DeclarationTypePasscodeSettings, payload: `{"MinimumLength": 20}`, wantErr: true},
+		{name: "Passcode unknown key", declarationType: DeclarationTypePasscodeSettings, payload: `{"MinimumLenght": 8}`, wantErr: true},
+		{name: "Disk management", declarationType: DeclarationTypeDiskManagementSettings, payload: `{"Restrictions": {"ExternalStorage": "ReadOnly"}}`},
+		{name: "Disk management invalid enum", declarationType: DeclarationTypeDiskManagementSettings, payload: `{"Restrictions": {"ExternalStorage": "Blocked"}}`, wantErr: true},
+		{name: "Service configuration files", declarationType: DeclarationTypeServiceConfigurationFiles, payload: `{"ServiceType": "com.apple.sshd", "DataAssetReference": "asset-1"}`},
+		{name: "Status subscriptions", declarationType: DeclarationTypeStatusSubscriptions, payload: `{"StatusItems": [{"Name": "softwareupdate.install-state"}]}`},
+		{name: "Status subscriptions empty", declarationType: DeclarationTypeStatusSubscriptions, payload: `{"StatusItems": []}`, wantErr: true},
+		{name: "Unsupported type", declarationType: "com.apple.configuration.unknown", payload: `{}`, wantErr: true},
+		{name: "Payload not an object", declarationType: DeclarationTypePasscodeSettings, payload: `[]`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateDeclarationPayload(tt.declarationType, tt.payload)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateDeclaration(t *testing.T) {
+	assert.NoError(t, ValidateDeclaration(`{"Type": "com.apple.configuration.passcode.settings", "Identifier": "passcode", "Payload": {"RequirePasscode": true}}`))
+	assert.Error(t, ValidateDeclaration(`{"Type": "com.apple.configuration.passcode.settings", "Payload": {}}`))
+	assert.Error(t, ValidateDeclaration(`{"Identifier": "passcode", "Payload": {}}`))
+	assert.Error(t, ValidateDeclaration(`{"Type": "com.apple.configuration.passcode.settings", "Identifier": "passcode", "Payload": {"MinimumLength": "eight"}}`))
+}
+
+func TestBlueprintComponentIdentifiers(t *testing.T) {
+	for _, declarationType := range SupportedDeclarationTypes() {
+		identifier, ok := BlueprintComponentIdentifier(declarationType)
+		assert.True(t, ok, declarationType)
+
+		roundTrip, ok := DeclarationTypeOfBlueprintComponent(identifier)
+		assert.True(t, ok, identifier)
+		assert.Equal(t, declarationType, roundTrip)
+	}
+
+	_, ok := DeclarationTypeOfBlueprintComponent("com.jamf.ddm.unknown")
+	assert.False(t, ok)
+}
@@ -0,0 +1,181 @@
+// common/device_identifiers/lookup.go
+// Description: This file contains the lookups between Jamf Pro device IDs and the serial numbers and
+// UDIDs that identify a device across tenants and re-enrollments.
+package device_identifiers
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+// lookupBatchSize is the number of values sent in a single inventory filter.
+const lookupBatchSize = 100
+
+// Kind is the type of device referenced by an identifier field.
+type Kind int
+
+const (
+	Computers Kind = iota
+	MobileDevices
+)
+
+func (k Kind) String() string {
+	if k == MobileDevices {
+		return "mobile device"
+	}
+	return "computer"
+}
+
+// Device holds the identifiers of a single device.
+type Device struct {
+	ID           int
+	SerialNumber string
+	UDID         string
+}
+
+// ResolveIDs returns the Jamf Pro IDs of the devices with the given serial numbers and UDIDs.
+// Serial numbers and UDIDs that do not match a device are reported together in the error.
+func ResolveIDs(client *jamfpro.Client, kind Kind, serialNumbers, udids []string) ([]int, error) {
+	if len(serialNumbers) == 0 && len(udids) == 0 {
+		return nil, nil
+	}
+
+	var devices []Device
+	var err error
+	switch kind {
+	case MobileDevices:
+		devices, err = listMobileDevices(client)
+	default:
+		devices, err = lookupComputers(client, "hardware.serialNumber", serialNumbers)
+		if err == nil {
+			var byUDID []Device
+			byUDID, err = lookupComputers(client, "udid", udids)
+			devices = append(devices, byUDID...)
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up %ss: %v", kind, err)
+	}
+
+	bySerial := make(map[string]int, len(devices))
+	byUDID := make(map[string]int, len(devices))
+	for _, device := range devices {
+		if device.SerialNumber != "" {
+			bySerial[strings.ToUpper(device.SerialNumber)] = device.ID
+		}
+		if device.UDID != "" {
+			byUDID[strings.ToUpper(device.UDID)] = device.ID
+		}
+	}
+
+	seen := make(map[int]bool)
+	var ids []int
+	var unknown []string
+	resolve := func(values []string, index map[string]int, label string) {
+		for _, value := range values {
+			id, ok := index[strings.ToUpper(value)]
+			if !ok {
+				unknown = append(unknown, fmt.Sprintf("%s '%s'", label, value))
+				continue
+			}
+			if !seen[id] {
+				seen[id] = true
+				ids = append(ids, id)
+			}
+		}
+	}
+	resolve(serialNumbers, bySerial, "serial number")
+	resolve(udids, byUDID, "UDID")
+
+	if len(unknown) > 0 {
+		return nil, fmt.Errorf("no %s found in Jamf Pro with %s", kind, strings.Join(unknown, ", "))
+	}
+
+	sort.Ints(ids)
+	return ids, nil
+}
+
+// DescribeIDs returns the serial number and UDID of each device ID that exists in Jamf Pro.
+func DescribeIDs(client *jamfpro.Client, kind Kind, ids []int) (map[int]Device, error) {
+	out := make(map[int]Device, len(ids))
+	if len(ids) == 0 {
+		return out, nil
+	}
+
+	var devices []Device
+	var err error
+	switch kind {
+	case MobileDevices:
+		devices, err = listMobileDevices(client)
+	default:
+		values := make([]string, len(ids))
+		for i, id := range ids {
+			values[i] = strconv.Itoa(id)
+		}
+		devices, err = lookupComputers(client, "id", values)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up %ss: %v", kind, err)
+	}
+
+	for _, device := range devices {
+		out[device.ID] = device
+	}
+
+	return out, nil
+}
+
+// lookupComputers queries computer inventory for the computers whose field matches one of values.
+func lookupComputers(client *jamfpro.Client, field string, values []string) ([]Device, error) {
+	var devices []Device
+
+	for start := 0; start < len(values); start += lookupBatchSize {
+		batch := values[start:min(start+lookupBatchSize, len(values))]
+
+		quoted := make([]string, len(batch))
+		for i, value := range batch {
+			quoted[i] = strconv.Quote(value)
+		}
+
+		params := url.Values{}
+		params.Add("section", "GENERAL")
+		params.Add("section", "HARDWARE")
+		params.Set("filter", fmt.Sprintf("%s=in=(%s)", field, strings.Join(quoted, ",")))
+
+		inventory, err := client.GetComputersInventory(params)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, computer := range inventory.Results {
+			id, err := strconv.Atoi(computer.ID)
+			if err != nil {
+				continue
+			}
+			devices = append(devices, Device{ID: id, SerialNumber: computer.Hardware.SerialNumber, UDID: computer.UDID})
+		}
+	}
+
+	return devices, nil
+}
+
+// listMobileDevices returns every mobile device; the Classic API list already carries serial
+// numbers and UDIDs, so one request serves any number of lookups.
+func listMobileDevices(client *jamfpro.Client) ([]Device, error) {
+	list, err := client.GetMobileDevices()
+	if err != nil {
+		return nil, err
+	}
+
+	devices := make([]Device, 0, len(list.MobileDevices))
+	for _, device := range list.MobileDevices {
+		devices = append(devices, Device{ID: device.ID, SerialNumber: device.SerialNumber, UDID: device.UDID})
+	}
+
+	return devices, nil
+}
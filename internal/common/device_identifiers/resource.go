@@ -0,0 +1,316 @@
+// common/device_identifiers/resource.go
+// Description: This file contains the resource wrapper that lets device ID attributes be configured
+// by serial number or UDID. Serial numbers and UDIDs are resolved into the ID attribute before
+// create and update, so resource constructors only ever see IDs, and are split back out of the ID
+// attribute after every read, so drift is reported against the serial number or UDID configured.
+package device_identifiers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Field describes a device ID attribute with parallel serial number and UDID attributes.
+type Field struct {
+	// Block is the path of nested single item blocks holding the attributes, e.g.
+	// ["scope", "exclusions"]. An empty path refers to top level attributes.
+	Block []string
+	// IDs, SerialNumbers and UDIDs are the attribute names within the block.
+	IDs           string
+	SerialNumbers string
+	UDIDs         string
+	Kind          Kind
+}
+
+// ComputerScopeFields are the computer identifier attributes of GetSharedmacOSComputerSchemaScope.
+var ComputerScopeFields = []Field{
+	{Block: []string{"scope"}, IDs: "computer_ids", SerialNumbers: "computer_serial_numbers", UDIDs: "computer_udids", Kind: Computers},
+	{Block: []string{"scope", "exclusions"}, IDs: "computer_ids", SerialNumbers: "computer_serial_numbers", UDIDs: "computer_udids", Kind: Computers},
+}
+
+// MobileDeviceScopeFields are the mobile device identifier attributes of GetSharedMobileDeviceSchemaScope.
+var MobileDeviceScopeFields = []Field{
+	{Block: []string{"scope"}, IDs: "mobile_device_ids", SerialNumbers: "mobile_device_serial_numbers", UDIDs: "mobile_device_udids", Kind: MobileDevices},
+	{Block: []string{"scope", "exclusions"}, IDs: "mobile_device_ids", SerialNumbers: "mobile_device_serial_numbers", UDIDs: "mobile_device_udids", Kind: MobileDevices},
+}
+
+// configured holds the identifiers a field was configured with before a CRUD operation.
+type configured struct {
+	ids           []int
+	serialNumbers []string
+	udids         []string
+}
+
+// WrapResource wraps the create, read and update functions of r to support the serial number and
+// UDID attributes of fields. It returns r.
+func WrapResource(r *schema.Resource, fields ...Field) *schema.Resource {
+	if create := r.CreateContext; create != nil {
+		r.CreateContext = func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			return apply(ctx, d, meta, fields, create)
+		}
+	}
+	if update := r.UpdateContext; update != nil {
+		r.UpdateContext = func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			return apply(ctx, d, meta, fields, update)
+		}
+	}
+	if read := r.ReadContext; read != nil {
+		r.ReadContext = func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			prior := capture(d, fields)
+			diags := read(ctx, d, meta)
+			if diags.HasError() || d.Id() == "" {
+				return diags
+			}
+			return append(diags, partition(d, meta, fields, prior)...)
+		}
+	}
+	return r
+}
+
+// apply resolves serial numbers and UDIDs into IDs, runs a create or update and splits the IDs read
+// back from Jamf Pro into the attributes they were configured with.
+func apply(ctx context.Context, d *schema.ResourceData, meta any, fields []Field, op func(context.Context, *schema.ResourceData, any) diag.Diagnostics) diag.Diagnostics {
+	prior := capture(d, fields)
+	client := meta.(*jamfpro.Client)
+
+	for i, field := range fields {
+		c := prior[i]
+		if len(c.serialNumbers) == 0 && len(c.udids) == 0 {
+			continue
+		}
+
+		ids, err := ResolveIDs(client, field.Kind, c.serialNumbers, c.udids)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("in '%s': %v", field.path(field.SerialNumbers), err))
+		}
+
+		if err := updateBlock(d, field, func(block map[string]any) {
+			block[field.IDs] = withIDs(block[field.IDs], ids)
+		}); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	diags := op(ctx, d, meta)
+	if diags.HasError() || d.Id() == "" {
+		return diags
+	}
+
+	return append(diags, partition(d, meta, fields, prior)...)
+}
+
+// partition moves the IDs of devices configured by serial number or UDID out of the ID attribute
+// and into the serial number or UDID attribute. Devices that are also listed by ID stay there too.
+func partition(d *schema.ResourceData, meta any, fields []Field, prior []configured) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+
+	for i, field := range fields {
+		c := prior[i]
+		if len(c.serialNumbers) == 0 && len(c.udids) == 0 {
+			continue
+		}
+
+		block := getBlock(d, field)
+		if block == nil {
+			continue
+		}
+		ids := intValues(block[field.IDs])
+
+		devices, err := DescribeIDs(client, field.Kind, ids)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("in '%s': %v", field.path(field.SerialNumbers), err))
+		}
+
+		var keptIDs []int
+		var serialNumbers, udids []string
+		for _, id := range ids {
+			device := devices[id]
+			placed := false
+			if device.SerialNumber != "" {
+				if value, ok := findFold(c.serialNumbers, device.SerialNumber); ok {
+					serialNumbers = append(serialNumbers, value)
+					placed = true
+				}
+			}
+			if device.UDID != "" {
+				if value, ok := findFold(c.udids, device.UDID); ok {
+					udids = append(udids, value)
+					placed = true
+				}
+			}
+			if !placed || slices.Contains(c.ids, id) {
+				keptIDs = append(keptIDs, id)
+			}
+		}
+
+		if err := updateBlock(d, field, func(block map[string]any) {
+			block[field.IDs] = replaceIDs(block[field.IDs], keptIDs)
+			block[field.SerialNumbers] = stringSet(serialNumbers)
+			block[field.UDIDs] = stringSet(udids)
+		}); err != nil {
+			return diag.FromErr(err)
+		}
+
+		log.Printf("[DEBUG] %s: %d ID(s), %d serial number(s), %d UDID(s) after read", field.path(field.IDs), len(keptIDs), len(serialNumbers), len(udids))
+	}
+
+	return nil
+}
+
+// capture records the identifiers each field is configured with.
+func capture(d *schema.ResourceData, fields []Field) []configured {
+	out := make([]configured, len(fields))
+	for i, field := range fields {
+		block := getBlock(d, field)
+		if block == nil {
+			continue
+		}
+		out[i] = configured{
+			ids:           intValues(block[field.IDs]),
+			serialNumbers: stringValues(block[field.SerialNumbers]),
+			udids:         stringValues(block[field.UDIDs]),
+		}
+	}
+	return out
+}
+
+// getBlock returns the attributes of the block holding field, or nil when the block is not set.
+func getBlock(d *schema.ResourceData, field Field) map[string]any {
+	if len(field.Block) == 0 {
+		return map[string]any{
+			field.IDs:           d.Get(field.IDs),
+			field.SerialNumbers: d.Get(field.SerialNumbers),
+			field.UDIDs:         d.Get(field.UDIDs),
+		}
+	}
+
+	root := d.Get(field.Block[0])
+	return descend(root, field.Block[1:])
+}
+
+// updateBlock applies change to the block holding field and writes the top level attribute back.
+func updateBlock(d *schema.ResourceData, field Field, change func(block map[string]any)) error {
+	if len(field.Block) == 0 {
+		block := getBlock(d, field)
+		change(block)
+		for key, value := range block {
+			if err := d.Set(key, value); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	root := d.Get(field.Block[0])
+	block := descend(root, field.Block[1:])
+	if block == nil {
+		return nil
+	}
+	change(block)
+
+	return d.Set(field.Block[0], root)
+}
+
+// descend follows path through single item block lists starting at value.
+func descend(value any, path []string) map[string]any {
+	list, ok := value.([]any)
+	if !ok || len(list) == 0 {
+		return nil
+	}
+	block, ok := list[0].(map[string]any)
+	if !ok {
+		return nil
+	}
+	if len(path) == 0 {
+		return block
+	}
+	return descend(block[path[0]], path[1:])
+}
+
+func (f Field) path(attr string) string {
+	parts := make([]string, 0, len(f.Block)*2+1)
+	for _, block := range f.Block {
+		parts = append(parts, block, "0")
+	}
+	return strings.Join(append(parts, attr), ".")
+}
+
+// withIDs adds ids to a set or list of IDs, keeping its type.
+func withIDs(value any, ids []int) any {
+	merged := intValues(value)
+	for _, id := range ids {
+		if !slices.Contains(merged, id) {
+			merged = append(merged, id)
+		}
+	}
+	return replaceIDs(value, merged)
+}
+
+// replaceIDs returns ids in the same collection type as value.
+func replaceIDs(value any, ids []int) any {
+	items := make([]any, len(ids))
+	for i, id := range ids {
+		items[i] = id
+	}
+	if _, ok := value.([]any); ok {
+		return items
+	}
+	return schema.NewSet(schema.HashInt, items)
+}
+
+func stringSet(values []string) *schema.Set {
+	sort.Strings(values)
+	items := make([]any, len(values))
+	for i, v := range values {
+		items[i] = v
+	}
+	return schema.NewSet(schema.HashString, items)
+}
+
+func intValues(value any) []int {
+	var out []int
+	for _, v := range listValues(value) {
+		if id, ok := v.(int); ok {
+			out = append(out, id)
+		}
+	}
+	return out
+}
+
+func stringValues(value any) []string {
+	var out []string
+	for _, v := range listValues(value) {
+		if s, ok := v.(string); ok && s != "" {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+func listValues(value any) []any {
+	switch v := value.(type) {
+	case *schema.Set:
+		return v.List()
+	case []any:
+		return v
+	}
+	return nil
+}
+
+// findFold returns the value in values equal to target ignoring case.
+func findFold(values []string, target string) (string, bool) {
+	for _, v := range values {
+		if strings.EqualFold(v, target) {
+			return v, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,73 @@
+package device_identifiers
+
+import (
+	"testing"
+
+	sharedschemas "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/shared_schemas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testScopeResourceData(t *testing.T) *schema.ResourceData {
+	t.Helper()
+
+	s := map[string]*schema.Schema{
+		"scope": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem:     sharedschemas.GetSharedmacOSComputerSchemaScope(),
+		},
+	}
+
+	return schema.TestResourceDataRaw(t, s, map[string]any{
+		"scope": []any{map[string]any{
+			"all_computers":           false,
+			"computer_ids":            []any{7},
+			"computer_serial_numbers": []any{"C02ABC", "C02DEF"},
+			"exclusions": []any{map[string]any{
+				"computer_udids": []any{"UDID-1"},
+			}},
+		}},
+	})
+}
+
+func TestCapture(t *testing.T) {
+	d := testScopeResourceData(t)
+
+	captured := capture(d, ComputerScopeFields)
+	require.Len(t, captured, 2)
+
+	assert.Equal(t, []int{7}, captured[0].ids)
+	assert.ElementsMatch(t, []string{"C02ABC", "C02DEF"}, captured[0].serialNumbers)
+	assert.Empty(t, captured[0].udids)
+	assert.Equal(t, []string{"UDID-1"}, captured[1].udids)
+}
+
+func TestUpdateBlockNested(t *testing.T) {
+	d := testScopeResourceData(t)
+	exclusions := ComputerScopeFields[1]
+
+	require.NoError(t, updateBlock(d, exclusions, func(block map[string]any) {
+		block[exclusions.IDs] = withIDs(block[exclusions.IDs], []int{42})
+	}))
+
+	assert.ElementsMatch(t, []any{42}, d.Get("scope.0.exclusions.0.computer_ids").(*schema.Set).List())
+	assert.ElementsMatch(t, []any{7}, d.Get("scope.0.computer_ids").(*schema.Set).List())
+	assert.Equal(t, 2, d.Get("scope.0.computer_serial_numbers").(*schema.Set).Len())
+}
+
+func TestWithIDsKeepsCollectionType(t *testing.T) {
+	list := withIDs([]any{1, 2}, []int{2, 3})
+	assert.Equal(t, []any{1, 2, 3}, list)
+
+	set := withIDs(schema.NewSet(schema.HashInt, []any{1}), []int{3})
+	require.IsType(t, &schema.Set{}, set)
+	assert.ElementsMatch(t, []any{1, 3}, set.(*schema.Set).List())
+}
+
+func TestFieldPath(t *testing.T) {
+	assert.Equal(t, "scope.0.exclusions.0.computer_serial_numbers", ComputerScopeFields[1].path("computer_serial_numbers"))
+	assert.Equal(t, "assigned_computer_ids", Field{}.path("assigned_computer_ids"))
+}
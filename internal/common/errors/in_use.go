@@ -0,0 +1,27 @@
+package errors
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ResourceInUseError reports that a resource cannot be deleted because other Jamf Pro objects still
+// reference it. Deletes failing with this error are not retried, as retrying cannot succeed until
+// the references are removed.
+type ResourceInUseError struct {
+	Resource   string
+	Referrers  []string
+	Underlying error
+}
+
+func (e *ResourceInUseError) Error() string {
+	msg := fmt.Sprintf("%s is still referenced by %s; remove these references before deleting it", e.Resource, strings.Join(e.Referrers, ", "))
+	if e.Underlying != nil {
+		msg += fmt.Sprintf(" (API error: %v)", e.Underlying)
+	}
+	return msg
+}
+
+func (e *ResourceInUseError) Unwrap() error {
+	return e.Underlying
+}
@@ -0,0 +1,262 @@
+// common/group_dependencies/graph.go
+// Description: This file contains the group-to-group dependency graph built from smart group
+// 'member of' / 'not member of' criteria, used to detect circular group references at plan time.
+package group_dependencies
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/smart_criteria"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mitchellh/mapstructure"
+)
+
+// GroupKind describes how groups of one device type reference each other.
+type GroupKind struct {
+	// Label names the group type in diagnostics, e.g. "smart computer group".
+	Label string
+	// CriterionName is the criterion used to reference another group, e.g. "Computer Group".
+	CriterionName string
+	// smartGroupsEndpoint is the Jamf Pro API endpoint listing the smart groups with their criteria.
+	smartGroupsEndpoint string
+}
+
+// ComputerGroups references computer groups through the "Computer Group" criterion.
+var ComputerGroups = GroupKind{
+	Label:               "smart computer group",
+	CriterionName:       "Computer Group",
+	smartGroupsEndpoint: "/api/v2/computer-groups/smart-groups",
+}
+
+// MobileDeviceGroups references mobile device groups through the "Mobile Device Group" criterion.
+var MobileDeviceGroups = GroupKind{
+	Label:               "smart mobile device group",
+	CriterionName:       "Mobile Device Group",
+	smartGroupsEndpoint: "/api/v1/mobile-device-groups/smart-groups",
+}
+
+// SmartGroup is an existing smart group and the groups its criteria reference.
+type SmartGroup struct {
+	ID         int
+	Name       string
+	References []string
+}
+
+// smartGroupListItem is a smart group as listed by the Jamf Pro API. Computer groups are listed with
+// 'id' and 'name', mobile device groups with 'groupId' and 'groupName'.
+type smartGroupListItem struct {
+	ID        string                                   `mapstructure:"id"`
+	GroupID   string                                   `mapstructure:"groupId"`
+	Name      string                                   `mapstructure:"name"`
+	GroupName string                                   `mapstructure:"groupName"`
+	Criteria  []jamfpro.SharedSubsetCriteriaJamfProAPI `mapstructure:"criteria"`
+}
+
+// ListSmartGroups returns every smart group of kind together with the groups it references, using a
+// single paginated list request instead of reading each group.
+func ListSmartGroups(client *jamfpro.Client, kind GroupKind) ([]SmartGroup, error) {
+	resp, err := client.DoPaginatedGet(kind.smartGroupsEndpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list %ss: %v", kind.Label, err)
+	}
+
+	groups := make([]SmartGroup, 0, len(resp.Results))
+	for _, result := range resp.Results {
+		var item smartGroupListItem
+		decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{WeaklyTypedInput: true, Result: &item})
+		if err != nil {
+			return nil, err
+		}
+		if err := decoder.Decode(result); err != nil {
+			return nil, fmt.Errorf("failed to decode %s: %v", kind.Label, err)
+		}
+
+		group := SmartGroup{Name: item.Name, References: ReferencedGroups(criteriaFromJamfProAPI(item.Criteria), kind.CriterionName)}
+		if group.Name == "" {
+			group.Name = item.GroupName
+		}
+		id := item.ID
+		if id == "" {
+			id = item.GroupID
+		}
+		group.ID, _ = strconv.Atoi(id)
+
+		groups = append(groups, group)
+	}
+
+	return groups, nil
+}
+
+// plannedReferences holds, per group kind, the references of every smart group planned so far by
+// this provider process, keyed by group name. Planned references take precedence over the ones held
+// by Jamf Pro, so cycles between groups created or changed in the same run are found at plan time.
+var plannedReferences = struct {
+	sync.Mutex
+	byKind map[string]map[string][]string
+}{byKind: make(map[string]map[string][]string)}
+
+// recordPlannedReferences records the planned references of a group.
+func recordPlannedReferences(kind GroupKind, name string, references []string) {
+	plannedReferences.Lock()
+	defer plannedReferences.Unlock()
+
+	if plannedReferences.byKind[kind.Label] == nil {
+		plannedReferences.byKind[kind.Label] = make(map[string][]string)
+	}
+	plannedReferences.byKind[kind.Label][name] = references
+}
+
+// newReferenceResolver returns the references lookup used by FindCycle. Planned groups are resolved
+// from their planned configuration; all other groups from listExisting, which is called at most once.
+func newReferenceResolver(kind GroupKind, listExisting func() ([]SmartGroup, error)) func(name string) ([]string, error) {
+	var existing map[string][]string
+
+	return func(name string) ([]string, error) {
+		plannedReferences.Lock()
+		refs, ok := plannedReferences.byKind[kind.Label][name]
+		plannedReferences.Unlock()
+		if ok {
+			return refs, nil
+		}
+
+		if existing == nil {
+			existing = make(map[string][]string)
+			groups, err := listExisting()
+			if err != nil {
+				log.Printf("[DEBUG] ValidateNoCycles: unable to list %ss, treating them as having no references: %v", kind.Label, err)
+			}
+			for _, group := range groups {
+				existing[group.Name] = group.References
+			}
+		}
+
+		return existing[name], nil
+	}
+}
+
+// ReferencedGroups returns the names of the groups referenced by 'member of' / 'not member of'
+// criteria on criterionName, in order and without duplicates.
+func ReferencedGroups(criteria []smart_criteria.Criterion, criterionName string) []string {
+	var names []string
+	seen := make(map[string]bool)
+	for _, c := range criteria {
+		if c.Name != criterionName || (c.SearchType != "member of" && c.SearchType != "not member of") {
+			continue
+		}
+		if !seen[c.Value] {
+			seen[c.Value] = true
+			names = append(names, c.Value)
+		}
+	}
+	return names
+}
+
+// FindCycle walks the dependency graph from start, whose direct references are given, and returns
+// the first path leading back to start, e.g. [A, B, C, A]. references resolves the groups referenced
+// by any other group. Cycles that do not pass through start are not reported.
+func FindCycle(start string, startReferences []string, references func(name string) ([]string, error)) ([]string, error) {
+	visited := make(map[string]bool)
+
+	var walk func(path []string, next []string) ([]string, error)
+	walk = func(path []string, next []string) ([]string, error) {
+		for _, name := range next {
+			if name == start {
+				return append(append([]string{}, path...), name), nil
+			}
+			if visited[name] {
+				continue
+			}
+			visited[name] = true
+
+			refs, err := references(name)
+			if err != nil {
+				return nil, err
+			}
+			if cycle, err := walk(append(path, name), refs); err != nil || cycle != nil {
+				return cycle, err
+			}
+		}
+		return nil, nil
+	}
+
+	return walk([]string{start}, startReferences)
+}
+
+// ValidateNoCycles rejects a smart group whose configured criteria would create a circular group
+// reference, either with the groups that already exist in Jamf Pro or with the other smart groups
+// planned in the same run.
+func ValidateNoCycles(_ context.Context, diff *schema.ResourceDiff, meta any, resourceType string, kind GroupKind) error {
+	if raw := diff.GetRawConfig(); !raw.IsNull() {
+		if !raw.GetAttr("name").IsWhollyKnown() || !raw.GetAttr("criteria").IsWhollyKnown() || !raw.GetAttr("criteria_expression").IsWhollyKnown() {
+			return nil
+		}
+	}
+
+	resourceName := diff.Get("name").(string)
+
+	criteriaList, err := smart_criteria.GetCriteriaList(diff)
+	if err != nil {
+		return fmt.Errorf("in '%s.%s': %v", resourceType, resourceName, err)
+	}
+
+	direct := ReferencedGroups(smart_criteria.CriteriaFromMaps(criteriaList), kind.CriterionName)
+	recordPlannedReferences(kind, resourceName, direct)
+	if len(direct) == 0 {
+		return nil
+	}
+
+	client, ok := meta.(*jamfpro.Client)
+	if !ok {
+		return nil
+	}
+
+	references := newReferenceResolver(kind, func() ([]SmartGroup, error) {
+		return ListSmartGroups(client, kind)
+	})
+
+	cycle, err := FindCycle(resourceName, direct, references)
+	if err != nil {
+		return fmt.Errorf("in '%s.%s': unable to check group references: %v", resourceType, resourceName, err)
+	}
+	if cycle != nil {
+		return fmt.Errorf("in '%s.%s': circular group reference detected: %s; Jamf Pro cannot recalculate membership of groups that depend on themselves",
+			resourceType, resourceName, formatPath(cycle))
+	}
+
+	return nil
+}
+
+// criteriaFromJamfProAPI converts Jamf Pro API criteria into smart_criteria criteria.
+func criteriaFromJamfProAPI(criteria []jamfpro.SharedSubsetCriteriaJamfProAPI) []smart_criteria.Criterion {
+	out := make([]smart_criteria.Criterion, len(criteria))
+	for i, c := range criteria {
+		out[i] = smart_criteria.Criterion{
+			Name:       c.Name,
+			Priority:   c.Priority,
+			AndOr:      c.AndOr,
+			SearchType: c.SearchType,
+			Value:      c.Value,
+		}
+		if c.OpeningParen != nil {
+			out[i].OpeningParen = *c.OpeningParen
+		}
+		if c.ClosingParen != nil {
+			out[i].ClosingParen = *c.ClosingParen
+		}
+	}
+	return out
+}
+
+func formatPath(path []string) string {
+	quoted := make([]string, len(path))
+	for i, name := range path {
+		quoted[i] = fmt.Sprintf("'%s'", name)
+	}
+	return strings.Join(quoted, " -> ")
+}
@@ -0,0 +1,95 @@
+package group_dependencies
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/smart_criteria"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReferencedGroups(t *testing.T) {
+	criteria := []smart_criteria.Criterion{
+		{Name: "Computer Group", SearchType: "member of", Value: "Lab"},
+		{Name: "Computer Group", SearchType: "not member of", Value: "Staff"},
+		{Name: "Computer Group", SearchType: "member of", Value: "Lab"},
+		{Name: "Computer Name", SearchType: "like", Value: "Lab"},
+		{Name: "Mobile Device Group", SearchType: "member of", Value: "iPads"},
+	}
+
+	assert.Equal(t, []string{"Lab", "Staff"}, ReferencedGroups(criteria, "Computer Group"))
+}
+
+func TestFindCycle(t *testing.T) {
+	graph := map[string][]string{
+		"B": {"C"},
+		"C": {"D", "A"},
+		"D": {},
+		"X": {"Y"},
+		"Y": {"X"},
+	}
+	references := func(name string) ([]string, error) {
+		return graph[name], nil
+	}
+
+	tests := []struct {
+		name       string
+		start      string
+		references []string
+		expected   []string
+	}{
+		{name: "Indirect cycle", start: "A", references: []string{"B"}, expected: []string{"A", "B", "C", "A"}},
+		{name: "Self reference", start: "A", references: []string{"A"}, expected: []string{"A", "A"}},
+		{name: "No cycle", start: "A", references: []string{"D"}, expected: nil},
+		{name: "Unrelated cycle is ignored", start: "A", references: []string{"X"}, expected: nil},
+		{name: "Unknown group", start: "A", references: []string{"Missing"}, expected: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cycle, err := FindCycle(tt.start, tt.references, references)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, cycle)
+		})
+	}
+}
+
+func TestFindCyclePropagatesErrors(t *testing.T) {
+	_, err := FindCycle("A", []string{"B"}, func(string) ([]string, error) {
+		return nil, fmt.Errorf("boom")
+	})
+	assert.Error(t, err)
+}
+
+func TestReferenceResolverPrefersPlannedGroups(t *testing.T) {
+	kind := GroupKind{Label: "test group", CriterionName: "Test Group"}
+	recordPlannedReferences(kind, "New A", []string{"New B"})
+	recordPlannedReferences(kind, "New B", []string{"Existing"})
+	recordPlannedReferences(kind, "Existing", []string{"New A"})
+
+	listCalls := 0
+	references := newReferenceResolver(kind, func() ([]SmartGroup, error) {
+		listCalls++
+		return []SmartGroup{
+			{ID: 1, Name: "Existing", References: nil},
+			{ID: 2, Name: "Other", References: []string{"Existing"}},
+		}, nil
+	})
+
+	cycle, err := FindCycle("New A", []string{"New B"}, references)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"New A", "New B", "Existing", "New A"}, cycle)
+	assert.Equal(t, 0, listCalls)
+
+	refs, err := references("Other")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Existing"}, refs)
+	_, err = references("Unknown")
+	require.NoError(t, err)
+	assert.Equal(t, 1, listCalls)
+}
+
+func TestFormatPath(t *testing.T) {
+	assert.Equal(t, "'A' -> 'B' -> 'A'", formatPath([]string{"A", "B", "A"}))
+}
@@ -0,0 +1,281 @@
+// common/group_dependencies/referrers.go
+// Description: This file contains the lookup of the Jamf Pro objects that still reference a computer
+// or mobile device group, used to explain why a group delete is refused instead of retrying it until
+// the delete timeout.
+package group_dependencies
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/errors"
+)
+
+// referrerLookupConcurrency bounds the number of policy and profile reads in flight at once.
+const referrerLookupConcurrency = 8
+
+// referrerLookupTimeout bounds the whole referrer lookup so that it finishes well inside the group
+// resources' delete timeout. When it is exceeded the delete fails with the generic Jamf Pro error.
+const referrerLookupTimeout = 30 * time.Second
+
+// FindComputerGroupReferrers returns the smart computer groups, policies and macOS configuration
+// profiles that reference the computer group with the given ID and name, described as
+// "policy 'Name' (ID 12)". It gives up with ctx's error once ctx is done.
+func FindComputerGroupReferrers(ctx context.Context, client *jamfpro.Client, id int, name string) ([]string, error) {
+	var referrers []string
+
+	smartGroups, err := smartGroupReferrers(client, ComputerGroups, id, name)
+	if err != nil {
+		return nil, err
+	}
+	referrers = append(referrers, smartGroups...)
+
+	policies, err := client.GetPolicies()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list policies: %v", err)
+	}
+	matched, err := matchConcurrently(ctx, len(policies.Policy), func(i int) (bool, error) {
+		item := policies.Policy[i]
+		policy, err := client.GetPolicyByID(strconv.Itoa(item.ID))
+		if err != nil {
+			return false, fmt.Errorf("failed to read policy '%s' (ID %d): %v", item.Name, item.ID, err)
+		}
+		scope := policy.Scope
+		return policyGroupsContain(scope.ComputerGroups, id, name) ||
+			(scope.Exclusions != nil && policyGroupsContain(scope.Exclusions.ComputerGroups, id, name)), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, i := range matched {
+		referrers = append(referrers, describe("policy", policies.Policy[i].Name, policies.Policy[i].ID))
+	}
+
+	profiles, err := client.GetMacOSConfigurationProfiles()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list macOS configuration profiles: %v", err)
+	}
+	matched, err = matchConcurrently(ctx, len(profiles.Results), func(i int) (bool, error) {
+		item := profiles.Results[i]
+		profile, err := client.GetMacOSConfigurationProfileByID(strconv.Itoa(item.ID))
+		if err != nil {
+			return false, fmt.Errorf("failed to read macOS configuration profile '%s' (ID %d): %v", item.Name, item.ID, err)
+		}
+		for _, entity := range append(profile.Scope.ComputerGroups, profile.Scope.Exclusions.ComputerGroups...) {
+			if entity.ID == id || entity.Name == name {
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, i := range matched {
+		referrers = append(referrers, describe("macOS configuration profile", profiles.Results[i].Name, profiles.Results[i].ID))
+	}
+
+	return referrers, nil
+}
+
+// FindMobileDeviceGroupReferrers returns the smart mobile device groups and mobile device
+// configuration profiles that reference the mobile device group with the given ID and name.
+func FindMobileDeviceGroupReferrers(ctx context.Context, client *jamfpro.Client, id int, name string) ([]string, error) {
+	var referrers []string
+
+	smartGroups, err := smartGroupReferrers(client, MobileDeviceGroups, id, name)
+	if err != nil {
+		return nil, err
+	}
+	referrers = append(referrers, smartGroups...)
+
+	profiles, err := client.GetMobileDeviceConfigurationProfiles()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list mobile device configuration profiles: %v", err)
+	}
+	matched, err := matchConcurrently(ctx, len(profiles.ConfigurationProfiles), func(i int) (bool, error) {
+		item := profiles.ConfigurationProfiles[i]
+		profile, err := client.GetMobileDeviceConfigurationProfileByID(strconv.Itoa(item.ID))
+		if err != nil {
+			return false, fmt.Errorf("failed to read mobile device configuration profile '%s' (ID %d): %v", item.Name, item.ID, err)
+		}
+		for _, entity := range append(profile.Scope.MobileDeviceGroups, profile.Scope.Exclusions.MobileDeviceGroups...) {
+			if entity.ID == id || entity.Name == name {
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, i := range matched {
+		item := profiles.ConfigurationProfiles[i]
+		referrers = append(referrers, describe("mobile device configuration profile", item.Name, item.ID))
+	}
+
+	return referrers, nil
+}
+
+// DeleteComputerGroupFunc returns a delete function for crud.Delete that, when Jamf Pro refuses the
+// delete, reports the objects still referencing the group as an errors.ResourceInUseError so the
+// delete fails immediately instead of retrying until timeout. The lookup is bounded by
+// referrerLookupTimeout and by ctx.
+func DeleteComputerGroupFunc(ctx context.Context, client *jamfpro.Client, name string) func(resourceID string) error {
+	return deleteGroupFunc(client.DeleteComputerGroupByID, func(id int) ([]string, error) {
+		ctx, cancel := context.WithTimeout(ctx, referrerLookupTimeout)
+		defer cancel()
+		return FindComputerGroupReferrers(ctx, client, id, name)
+	}, "computer group", name)
+}
+
+// DeleteMobileDeviceGroupFunc is the mobile device group equivalent of DeleteComputerGroupFunc.
+func DeleteMobileDeviceGroupFunc(ctx context.Context, client *jamfpro.Client, name string) func(resourceID string) error {
+	return deleteGroupFunc(client.DeleteMobileDeviceGroupByID, func(id int) ([]string, error) {
+		ctx, cancel := context.WithTimeout(ctx, referrerLookupTimeout)
+		defer cancel()
+		return FindMobileDeviceGroupReferrers(ctx, client, id, name)
+	}, "mobile device group", name)
+}
+
+// deleteGroupFunc wraps sdkDelete with a referrer lookup that runs once, after the first failure.
+func deleteGroupFunc(sdkDelete func(string) error, findReferrers func(id int) ([]string, error), label, name string) func(resourceID string) error {
+	checked := false
+
+	return func(resourceID string) error {
+		apiErr := sdkDelete(resourceID)
+		if apiErr == nil || checked {
+			return apiErr
+		}
+		checked = true
+
+		id, err := strconv.Atoi(resourceID)
+		if err != nil {
+			return apiErr
+		}
+
+		referrers, err := findReferrers(id)
+		if err != nil {
+			log.Printf("[WARN] Unable to look up references to %s '%s': %v", label, name, err)
+			return apiErr
+		}
+		if len(referrers) == 0 {
+			return apiErr
+		}
+
+		return &errors.ResourceInUseError{
+			Resource:   fmt.Sprintf("%s '%s'", label, name),
+			Referrers:  referrers,
+			Underlying: apiErr,
+		}
+	}
+}
+
+// smartGroupReferrers returns the smart groups of kind, other than the group itself, whose criteria
+// reference the named group.
+func smartGroupReferrers(client *jamfpro.Client, kind GroupKind, id int, name string) ([]string, error) {
+	groups, err := ListSmartGroups(client, kind)
+	if err != nil {
+		return nil, err
+	}
+
+	var referrers []string
+	for _, group := range groups {
+		if group.ID == id {
+			continue
+		}
+		for _, ref := range group.References {
+			if ref == name {
+				referrers = append(referrers, describe(kind.Label, group.Name, group.ID))
+				break
+			}
+		}
+	}
+	return referrers, nil
+}
+
+// matchConcurrently calls match for every index in [0, n) on at most referrerLookupConcurrency
+// workers and returns the matching indices in ascending order. It stops on the first match error,
+// or returns ctx's error without waiting for reads still in flight once ctx is done.
+func matchConcurrently(ctx context.Context, n int, match func(i int) (bool, error)) ([]int, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	matched := make([]bool, n)
+	errs := make(chan error, n)
+	jobs := make(chan int)
+	done := make(chan struct{})
+
+	var wg sync.WaitGroup
+	for range min(referrerLookupConcurrency, n) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				ok, err := match(i)
+				if err != nil {
+					errs <- err
+					cancel()
+					continue
+				}
+				matched[i] = ok
+			}
+		}()
+	}
+	go func() {
+		defer close(jobs)
+		for i := range n {
+			select {
+			case jobs <- i:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+	}
+	select {
+	case err := <-errs:
+		return nil, err
+	default:
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("referrer lookup did not finish in time: %w", err)
+	}
+
+	var indices []int
+	for i, ok := range matched {
+		if ok {
+			indices = append(indices, i)
+		}
+	}
+	return indices, nil
+}
+
+func policyGroupsContain(groups *[]jamfpro.PolicySubsetComputerGroup, id int, name string) bool {
+	if groups == nil {
+		return false
+	}
+	for _, g := range *groups {
+		if g.ID == id || g.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func describe(kind, name string, id int) string {
+	return fmt.Sprintf("%s '%s' (ID %d)", kind, name, id)
+}
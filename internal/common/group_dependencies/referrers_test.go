@@ -0,0 +1,57 @@
+package group_dependencies
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchConcurrently(t *testing.T) {
+	var inFlight, peak atomic.Int32
+	matched, err := matchConcurrently(context.Background(), 50, func(i int) (bool, error) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			previous := peak.Load()
+			if current <= previous || peak.CompareAndSwap(previous, current) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		return i%10 == 3, nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []int{3, 13, 23, 33, 43}, matched)
+	assert.LessOrEqual(t, int(peak.Load()), referrerLookupConcurrency)
+}
+
+func TestMatchConcurrentlyPropagatesErrors(t *testing.T) {
+	_, err := matchConcurrently(context.Background(), 20, func(i int) (bool, error) {
+		if i == 7 {
+			return false, fmt.Errorf("failed to read policy %d", i)
+		}
+		return false, nil
+	})
+
+	assert.EqualError(t, err, "failed to read policy 7")
+}
+
+func TestMatchConcurrentlyGivesUpAtDeadline(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := matchConcurrently(ctx, 1000, func(int) (bool, error) {
+		time.Sleep(10 * time.Millisecond)
+		return true, nil
+	})
+
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), time.Second)
+}
@@ -0,0 +1,104 @@
+// common/group_membership/delta.go
+// Description: This file contains the Classic API add / remove delta updates of static group
+// membership, which change individual members without replacing the group's full member list.
+package group_membership
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+const (
+	uriComputerGroups     = "/JSSResource/computergroups"
+	uriMobileDeviceGroups = "/JSSResource/mobiledevicegroups"
+)
+
+type deltaMember struct {
+	ID int `xml:"id"`
+}
+
+// computerGroupDelta only carries the delta containers so the group name, site and remaining
+// members are left untouched.
+type computerGroupDelta struct {
+	XMLName   xml.Name      `xml:"computer_group"`
+	Additions []deltaMember `xml:"computer_additions>computer,omitempty"`
+	Deletions []deltaMember `xml:"computer_deletions>computer,omitempty"`
+}
+
+type mobileDeviceGroupDelta struct {
+	XMLName   xml.Name      `xml:"mobile_device_group"`
+	Additions []deltaMember `xml:"mobile_device_additions>mobile_device,omitempty"`
+	Deletions []deltaMember `xml:"mobile_device_deletions>mobile_device,omitempty"`
+}
+
+// AddComputersToGroup adds computers to a static computer group, leaving existing members in place.
+func AddComputersToGroup(client *jamfpro.Client, groupID string, computerIDs ...int) error {
+	return putDelta(client, uriComputerGroups, groupID, &computerGroupDelta{Additions: deltaMembers(computerIDs)})
+}
+
+// RemoveComputersFromGroup removes computers from a static computer group, leaving other members in place.
+func RemoveComputersFromGroup(client *jamfpro.Client, groupID string, computerIDs ...int) error {
+	return putDelta(client, uriComputerGroups, groupID, &computerGroupDelta{Deletions: deltaMembers(computerIDs)})
+}
+
+// AddMobileDevicesToGroup adds mobile devices to a static mobile device group, leaving existing
+// members in place.
+func AddMobileDevicesToGroup(client *jamfpro.Client, groupID string, deviceIDs ...int) error {
+	return putDelta(client, uriMobileDeviceGroups, groupID, &mobileDeviceGroupDelta{Additions: deltaMembers(deviceIDs)})
+}
+
+// RemoveMobileDevicesFromGroup removes mobile devices from a static mobile device group, leaving
+// other members in place.
+func RemoveMobileDevicesFromGroup(client *jamfpro.Client, groupID string, deviceIDs ...int) error {
+	return putDelta(client, uriMobileDeviceGroups, groupID, &mobileDeviceGroupDelta{Deletions: deltaMembers(deviceIDs)})
+}
+
+// MemberID returns the Terraform ID of a group member resource, "<group_id>:<device_id>".
+func MemberID(groupID string, deviceID int) string {
+	return fmt.Sprintf("%s:%d", groupID, deviceID)
+}
+
+// ParseMemberID splits a group member resource ID into the group ID and device ID.
+func ParseMemberID(id string) (string, int, error) {
+	groupID, deviceID, ok := strings.Cut(id, ":")
+	if !ok || groupID == "" {
+		return "", 0, fmt.Errorf("invalid group member ID '%s', expected '<group_id>:<device_id>'", id)
+	}
+
+	device, err := strconv.Atoi(deviceID)
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid group member ID '%s': device ID must be a number", id)
+	}
+
+	return groupID, device, nil
+}
+
+func putDelta(client *jamfpro.Client, uri, groupID string, payload any) error {
+	endpoint := fmt.Sprintf("%s/id/%s", uri, groupID)
+
+	var response struct {
+		ID int `xml:"id"`
+	}
+	resp, err := client.HTTP.DoRequest("PUT", endpoint, payload, &response)
+	if err != nil {
+		return fmt.Errorf("failed to update membership of group ID %s: %v", groupID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+func deltaMembers(ids []int) []deltaMember {
+	out := make([]deltaMember, len(ids))
+	for i, id := range ids {
+		out[i] = deltaMember{ID: id}
+	}
+	return out
+}
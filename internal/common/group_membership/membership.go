@@ -0,0 +1,206 @@
+// common/group_membership/membership.go
+// Description: This file contains the schema, filtering and paging shared by the smart group
+// membership data sources.
+package group_membership
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Member is a single device in a group membership.
+type Member struct {
+	ID           int
+	Name         string
+	SerialNumber string
+	UDID         string
+	MacAddress   string
+}
+
+// Options controls which members of a group are returned.
+type Options struct {
+	NameRegex         string
+	SerialNumberRegex string
+	Page              int
+	PageSize          int
+}
+
+// GetSchema returns the data source schema shared by the membership data sources. deviceLabel is
+// used in descriptions, e.g. "computer".
+func GetSchema(deviceLabel string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ExactlyOneOf: []string{"id", "name"},
+			Description:  fmt.Sprintf("The Jamf Pro ID of the %s group.", deviceLabel),
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: fmt.Sprintf("The name of the %s group.", deviceLabel),
+		},
+		"is_smart": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the group is a smart group.",
+		},
+		"name_regex": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsValidRegExp,
+			Description:  fmt.Sprintf("Only return members whose %s name matches this regular expression.", deviceLabel),
+		},
+		"serial_number_regex": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsValidRegExp,
+			Description:  "Only return members whose serial number matches this regular expression.",
+		},
+		"page": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      0,
+			ValidateFunc: validation.IntAtLeast(0),
+			Description:  "The zero-based page of members to return. Only used when page_size is set.",
+		},
+		"page_size": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      0,
+			ValidateFunc: validation.IntAtLeast(0),
+			Description:  "The number of members per page. When 0, all matching members are returned.",
+		},
+		"total_count": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The number of members matching the filters, across all pages.",
+		},
+		"members": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: fmt.Sprintf("The %ss in the group, ordered by ID.", deviceLabel),
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: fmt.Sprintf("The Jamf Pro ID of the %s.", deviceLabel),
+					},
+					"name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: fmt.Sprintf("The name of the %s.", deviceLabel),
+					},
+					"serial_number": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The serial number of the device.",
+					},
+					"udid": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The UDID of the device.",
+					},
+					"mac_address": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The primary MAC address of the device.",
+					},
+				},
+			},
+		},
+	}
+}
+
+// GetOptions reads the filtering and paging options from the data source configuration.
+func GetOptions(d *schema.ResourceData) Options {
+	return Options{
+		NameRegex:         d.Get("name_regex").(string),
+		SerialNumberRegex: d.Get("serial_number_regex").(string),
+		Page:              d.Get("page").(int),
+		PageSize:          d.Get("page_size").(int),
+	}
+}
+
+// Select orders members by ID, applies the filters and returns the requested page together with
+// the number of members matching the filters.
+func Select(members []Member, opts Options) ([]Member, int, error) {
+	nameRe, err := compileOptional(opts.NameRegex)
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid name_regex: %v", err)
+	}
+	serialRe, err := compileOptional(opts.SerialNumberRegex)
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid serial_number_regex: %v", err)
+	}
+
+	var matched []Member
+	for _, m := range members {
+		if nameRe != nil && !nameRe.MatchString(m.Name) {
+			continue
+		}
+		if serialRe != nil && !serialRe.MatchString(m.SerialNumber) {
+			continue
+		}
+		matched = append(matched, m)
+	}
+
+	sort.SliceStable(matched, func(i, j int) bool { return matched[i].ID < matched[j].ID })
+
+	total := len(matched)
+	if opts.PageSize <= 0 {
+		return matched, total, nil
+	}
+
+	start := opts.Page * opts.PageSize
+	if start >= total {
+		return []Member{}, total, nil
+	}
+	end := min(start+opts.PageSize, total)
+
+	return matched[start:end], total, nil
+}
+
+// SetState sets the membership attributes of the data source.
+func SetState(d *schema.ResourceData, id int, name string, isSmart bool, members []Member, total int) error {
+	list := make([]any, 0, len(members))
+	for _, m := range members {
+		list = append(list, map[string]any{
+			"id":            m.ID,
+			"name":          m.Name,
+			"serial_number": m.SerialNumber,
+			"udid":          m.UDID,
+			"mac_address":   m.MacAddress,
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%d", id))
+
+	fields := map[string]any{
+		"name":        name,
+		"is_smart":    isSmart,
+		"total_count": total,
+		"members":     list,
+	}
+	for k, v := range fields {
+		if err := d.Set(k, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func compileOptional(expr string) (*regexp.Regexp, error) {
+	if expr == "" {
+		return nil, nil
+	}
+	return regexp.Compile(expr)
+}
@@ -0,0 +1,65 @@
+package group_membership
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelect(t *testing.T) {
+	members := []Member{
+		{ID: 3, Name: "LAB-03", SerialNumber: "C02C"},
+		{ID: 1, Name: "LAB-01", SerialNumber: "C02A"},
+		{ID: 2, Name: "STAFF-01", SerialNumber: "F9B"},
+		{ID: 4, Name: "LAB-04", SerialNumber: "C02D"},
+	}
+
+	ids := func(ms []Member) []int {
+		out := []int{}
+		for _, m := range ms {
+			out = append(out, m.ID)
+		}
+		return out
+	}
+
+	tests := []struct {
+		name     string
+		opts     Options
+		expected []int
+		total    int
+	}{
+		{name: "All members ordered by ID", opts: Options{}, expected: []int{1, 2, 3, 4}, total: 4},
+		{name: "Name filter", opts: Options{NameRegex: "^LAB-"}, expected: []int{1, 3, 4}, total: 3},
+		{name: "Serial number filter", opts: Options{SerialNumberRegex: "^C02[AC]$"}, expected: []int{1, 3}, total: 2},
+		{name: "First page", opts: Options{NameRegex: "^LAB-", PageSize: 2}, expected: []int{1, 3}, total: 3},
+		{name: "Last partial page", opts: Options{NameRegex: "^LAB-", Page: 1, PageSize: 2}, expected: []int{4}, total: 3},
+		{name: "Page past the end", opts: Options{Page: 5, PageSize: 2}, expected: []int{}, total: 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page, total, err := Select(members, tt.opts)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, ids(page))
+			assert.Equal(t, tt.total, total)
+		})
+	}
+}
+
+func TestSelectInvalidRegex(t *testing.T) {
+	_, _, err := Select(nil, Options{NameRegex: "("})
+	assert.Error(t, err)
+}
+
+func TestParseMemberID(t *testing.T) {
+	groupID, deviceID, err := ParseMemberID(MemberID("12", 345))
+	require.NoError(t, err)
+	assert.Equal(t, "12", groupID)
+	assert.Equal(t, 345, deviceID)
+
+	for _, id := range []string{"12", ":345", "12:abc"} {
+		_, _, err := ParseMemberID(id)
+		assert.Error(t, err, id)
+	}
+}
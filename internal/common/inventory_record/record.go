@@ -0,0 +1,299 @@
+// common/inventory_record/record.go
+// Description: This file contains the device independent inventory record and patch types, and
+// their conversion to and from Terraform state.
+package inventory_record
+
+import (
+	"slices"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// UserAndLocation is the assigned user and location of a device.
+type UserAndLocation struct {
+	Username     string
+	RealName     string
+	EmailAddress string
+	Position     string
+	PhoneNumber  string
+	Room         string
+}
+
+// Purchasing is the purchasing details of a device.
+type Purchasing struct {
+	Purchased         bool
+	Leased            bool
+	PoNumber          string
+	PoDate            string
+	Vendor            string
+	AppleCareID       string
+	PurchasePrice     string
+	PurchasingAccount string
+	PurchasingContact string
+	WarrantyDate      string
+	LeaseDate         string
+	LifeExpectancy    int
+}
+
+// ExtensionAttribute is the value of an extension attribute.
+type ExtensionAttribute struct {
+	ID     string
+	Values []string
+}
+
+// Record is the managed part of a device inventory record as read from Jamf Pro.
+type Record struct {
+	ID                  string
+	SerialNumber        string
+	UDID                string
+	Name                string
+	AssetTag            string
+	SiteID              string
+	BuildingID          string
+	DepartmentID        string
+	UserAndLocation     UserAndLocation
+	Purchasing          Purchasing
+	ExtensionAttributes []ExtensionAttribute
+}
+
+// UserAndLocationPatch holds the user and location fields to change. Nil fields are left untouched.
+type UserAndLocationPatch struct {
+	Username     *string
+	RealName     *string
+	EmailAddress *string
+	Position     *string
+	PhoneNumber  *string
+	Room         *string
+}
+
+// PurchasingPatch holds the purchasing fields to change. Nil fields are left untouched.
+type PurchasingPatch struct {
+	Purchased         *bool
+	Leased            *bool
+	PoNumber          *string
+	PoDate            *string
+	Vendor            *string
+	AppleCareID       *string
+	PurchasePrice     *string
+	PurchasingAccount *string
+	PurchasingContact *string
+	WarrantyDate      *string
+	LeaseDate         *string
+	LifeExpectancy    *int
+}
+
+// Patch holds the inventory fields to change. Nil fields are left untouched.
+type Patch struct {
+	AssetTag            *string
+	SiteID              *string
+	BuildingID          *string
+	DepartmentID        *string
+	UserAndLocation     *UserAndLocationPatch
+	Purchasing          *PurchasingPatch
+	ExtensionAttributes []ExtensionAttribute
+}
+
+// IsEmpty reports whether the patch changes nothing.
+func (p Patch) IsEmpty() bool {
+	return p.AssetTag == nil && p.SiteID == nil && p.BuildingID == nil && p.DepartmentID == nil &&
+		p.UserAndLocation == nil && p.Purchasing == nil && len(p.ExtensionAttributes) == 0
+}
+
+// ExpandPatch returns the fields that differ between the planned and prior state. On create, this
+// is every field set in the configuration. Within the user_and_location and purchasing blocks only
+// the attributes present in the configuration are patched, so the others keep their Jamf Pro value.
+func ExpandPatch(d *schema.ResourceData) Patch {
+	return expandPatch(d, d.GetRawConfig())
+}
+
+func expandPatch(d *schema.ResourceData, rawConfig cty.Value) Patch {
+	var patch Patch
+
+	for key, target := range map[string]**string{
+		"asset_tag":     &patch.AssetTag,
+		"site_id":       &patch.SiteID,
+		"building_id":   &patch.BuildingID,
+		"department_id": &patch.DepartmentID,
+	} {
+		if d.HasChange(key) {
+			value := d.Get(key).(string)
+			*target = &value
+		}
+	}
+
+	if d.HasChange("user_and_location") {
+		block := firstBlock(d.Get("user_and_location"))
+		configured := configuredBlockAttributes(rawConfig, "user_and_location")
+		if block != nil && len(configured) > 0 {
+			patch.UserAndLocation = &UserAndLocationPatch{
+				Username:     configuredString(block, configured, "username"),
+				RealName:     configuredString(block, configured, "real_name"),
+				EmailAddress: configuredString(block, configured, "email_address"),
+				Position:     configuredString(block, configured, "position"),
+				PhoneNumber:  configuredString(block, configured, "phone_number"),
+				Room:         configuredString(block, configured, "room"),
+			}
+		}
+	}
+
+	if d.HasChange("purchasing") {
+		block := firstBlock(d.Get("purchasing"))
+		configured := configuredBlockAttributes(rawConfig, "purchasing")
+		if block != nil && len(configured) > 0 {
+			patch.Purchasing = &PurchasingPatch{
+				Purchased:         configuredValue[bool](block, configured, "purchased"),
+				Leased:            configuredValue[bool](block, configured, "leased"),
+				PoNumber:          configuredString(block, configured, "po_number"),
+				PoDate:            configuredString(block, configured, "po_date"),
+				Vendor:            configuredString(block, configured, "vendor"),
+				AppleCareID:       configuredString(block, configured, "apple_care_id"),
+				PurchasePrice:     configuredString(block, configured, "purchase_price"),
+				PurchasingAccount: configuredString(block, configured, "purchasing_account"),
+				PurchasingContact: configuredString(block, configured, "purchasing_contact"),
+				WarrantyDate:      configuredString(block, configured, "warranty_date"),
+				LeaseDate:         configuredString(block, configured, "lease_date"),
+				LifeExpectancy:    configuredValue[int](block, configured, "life_expectancy"),
+			}
+		}
+	}
+
+	if d.HasChange("extension_attribute") {
+		patch.ExtensionAttributes = configuredExtensionAttributes(d.Get("extension_attribute"))
+	}
+
+	return patch
+}
+
+// SetState writes record to the Terraform state. Only the extension attributes already in the
+// configuration are written, so values managed elsewhere do not show as drift.
+func SetState(d *schema.ResourceData, record Record) error {
+	configured := configuredExtensionAttributes(d.Get("extension_attribute"))
+	var extensionAttributes []any
+	for _, ea := range record.ExtensionAttributes {
+		if !slices.ContainsFunc(configured, func(c ExtensionAttribute) bool { return c.ID == ea.ID }) {
+			continue
+		}
+		values := make([]any, len(ea.Values))
+		for i, v := range ea.Values {
+			values[i] = v
+		}
+		extensionAttributes = append(extensionAttributes, map[string]any{
+			"id":     ea.ID,
+			"values": values,
+		})
+	}
+
+	u := record.UserAndLocation
+	p := record.Purchasing
+	state := map[string]any{
+		"serial_number": record.SerialNumber,
+		"udid":          record.UDID,
+		"name":          record.Name,
+		"asset_tag":     record.AssetTag,
+		"site_id":       record.SiteID,
+		"building_id":   record.BuildingID,
+		"department_id": record.DepartmentID,
+		"user_and_location": []any{map[string]any{
+			"username":      u.Username,
+			"real_name":     u.RealName,
+			"email_address": u.EmailAddress,
+			"position":      u.Position,
+			"phone_number":  u.PhoneNumber,
+			"room":          u.Room,
+		}},
+		"purchasing": []any{map[string]any{
+			"purchased":          p.Purchased,
+			"leased":             p.Leased,
+			"po_number":          p.PoNumber,
+			"po_date":            p.PoDate,
+			"vendor":             p.Vendor,
+			"apple_care_id":      p.AppleCareID,
+			"purchase_price":     p.PurchasePrice,
+			"purchasing_account": p.PurchasingAccount,
+			"purchasing_contact": p.PurchasingContact,
+			"warranty_date":      p.WarrantyDate,
+			"lease_date":         p.LeaseDate,
+			"life_expectancy":    p.LifeExpectancy,
+		}},
+		"extension_attribute": extensionAttributes,
+	}
+
+	for key, value := range state {
+		if err := d.Set(key, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func configuredExtensionAttributes(value any) []ExtensionAttribute {
+	set, ok := value.(*schema.Set)
+	if !ok {
+		return nil
+	}
+
+	var out []ExtensionAttribute
+	for _, item := range set.List() {
+		block := item.(map[string]any)
+		ea := ExtensionAttribute{ID: block["id"].(string)}
+		for _, v := range block["values"].([]any) {
+			s, _ := v.(string)
+			ea.Values = append(ea.Values, s)
+		}
+		out = append(out, ea)
+	}
+	return out
+}
+
+func firstBlock(value any) map[string]any {
+	list, ok := value.([]any)
+	if !ok || len(list) == 0 || list[0] == nil {
+		return nil
+	}
+	block, _ := list[0].(map[string]any)
+	return block
+}
+
+// configuredBlockAttributes returns the attributes set in the configuration of the first element of
+// a nested block.
+func configuredBlockAttributes(rawConfig cty.Value, name string) map[string]bool {
+	if rawConfig.IsNull() || !rawConfig.IsKnown() || !rawConfig.Type().IsObjectType() || !rawConfig.Type().HasAttribute(name) {
+		return nil
+	}
+
+	list := rawConfig.GetAttr(name)
+	if list.IsNull() || !list.IsKnown() || !list.CanIterateElements() || list.LengthInt() == 0 {
+		return nil
+	}
+
+	block := list.Index(cty.NumberIntVal(0))
+	if block.IsNull() || !block.IsKnown() || !block.Type().IsObjectType() {
+		return nil
+	}
+
+	configured := map[string]bool{}
+	for attribute := range block.Type().AttributeTypes() {
+		if !block.GetAttr(attribute).IsNull() {
+			configured[attribute] = true
+		}
+	}
+	return configured
+}
+
+// configuredValue returns a pointer to the block attribute when it is set in the configuration.
+func configuredValue[T any](block map[string]any, configured map[string]bool, key string) *T {
+	if !configured[key] {
+		return nil
+	}
+	value, ok := block[key].(T)
+	if !ok {
+		return nil
+	}
+	return &value
+}
+
+func configuredString(block map[string]any, configured map[string]bool, key string) *string {
+	return configuredValue[string](block, configured, key)
+}
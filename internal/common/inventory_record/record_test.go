@@ -0,0 +1,115 @@
+package inventory_record
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testResourceData(t *testing.T, raw map[string]any) *schema.ResourceData {
+	t.Helper()
+	return schema.TestResourceDataRaw(t, GetSchema("computer"), raw)
+}
+
+func TestExpandPatchOnlyConfiguredFields(t *testing.T) {
+	d := testResourceData(t, map[string]any{
+		"serial_number": "C02ABC",
+		"asset_tag":     "IT-0042",
+		"building_id":   "3",
+		"extension_attribute": []any{
+			map[string]any{"id": "12", "values": []any{"CC-100"}},
+		},
+	})
+
+	patch := ExpandPatch(d)
+
+	require.NotNil(t, patch.AssetTag)
+	assert.Equal(t, "IT-0042", *patch.AssetTag)
+	require.NotNil(t, patch.BuildingID)
+	assert.Equal(t, "3", *patch.BuildingID)
+	assert.Nil(t, patch.SiteID)
+	assert.Nil(t, patch.DepartmentID)
+	assert.Nil(t, patch.UserAndLocation)
+	assert.Nil(t, patch.Purchasing)
+	assert.Equal(t, []ExtensionAttribute{{ID: "12", Values: []string{"CC-100"}}}, patch.ExtensionAttributes)
+	assert.False(t, patch.IsEmpty())
+}
+
+func TestExpandPatchBlocksOnlyConfiguredAttributes(t *testing.T) {
+	d := testResourceData(t, map[string]any{
+		"serial_number": "C02ABC",
+		"user_and_location": []any{map[string]any{
+			"username": "alice",
+			"room":     "4.01",
+		}},
+		"purchasing": []any{map[string]any{
+			"po_number": "PO-1",
+		}},
+	})
+	rawConfig := cty.ObjectVal(map[string]cty.Value{
+		"serial_number": cty.StringVal("C02ABC"),
+		"user_and_location": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+			"username":  cty.StringVal("alice"),
+			"real_name": cty.NullVal(cty.String),
+			"room":      cty.StringVal("4.01"),
+		})}),
+		"purchasing": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+			"purchased":       cty.NullVal(cty.Bool),
+			"po_number":       cty.StringVal("PO-1"),
+			"life_expectancy": cty.NullVal(cty.Number),
+		})}),
+	})
+
+	patch := expandPatch(d, rawConfig)
+
+	require.NotNil(t, patch.UserAndLocation)
+	assert.Equal(t, &UserAndLocationPatch{Username: ptr("alice"), Room: ptr("4.01")}, patch.UserAndLocation)
+	require.NotNil(t, patch.Purchasing)
+	assert.Equal(t, &PurchasingPatch{PoNumber: ptr("PO-1")}, patch.Purchasing)
+	assert.Nil(t, patch.AssetTag)
+}
+
+func TestExpandPatchBlocksWithoutConfig(t *testing.T) {
+	d := testResourceData(t, map[string]any{
+		"serial_number": "C02ABC",
+		"purchasing":    []any{map[string]any{"po_number": "PO-1"}},
+	})
+
+	assert.Nil(t, expandPatch(d, cty.NullVal(cty.DynamicPseudoType)).Purchasing)
+}
+
+func TestExpandPatchEmpty(t *testing.T) {
+	d := testResourceData(t, map[string]any{"serial_number": "C02ABC"})
+	assert.True(t, ExpandPatch(d).IsEmpty())
+}
+
+func TestSetStateKeepsOnlyConfiguredExtensionAttributes(t *testing.T) {
+	d := testResourceData(t, map[string]any{
+		"serial_number": "C02ABC",
+		"extension_attribute": []any{
+			map[string]any{"id": "12", "values": []any{"CC-100"}},
+		},
+	})
+
+	require.NoError(t, SetState(d, Record{
+		SerialNumber: "C02ABC",
+		AssetTag:     "IT-0042",
+		ExtensionAttributes: []ExtensionAttribute{
+			{ID: "12", Values: []string{"CC-200"}},
+			{ID: "13", Values: []string{"unmanaged"}},
+		},
+	}))
+
+	assert.Equal(t, "IT-0042", d.Get("asset_tag"))
+	eas := d.Get("extension_attribute").(*schema.Set).List()
+	require.Len(t, eas, 1)
+	assert.Equal(t, "12", eas[0].(map[string]any)["id"])
+	assert.Equal(t, []any{"CC-200"}, eas[0].(map[string]any)["values"])
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,149 @@
+// common/inventory_record/schema.go
+// Description: This file contains the schema shared by the computer and mobile device inventory
+// record resources, which patch selected mutable inventory fields of an existing device.
+package inventory_record
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// GetSchema returns the inventory record schema for a device type, e.g. "computer".
+//
+// Every inventory field is optional and computed: fields left out of the configuration are not
+// managed and keep whatever value Jamf Pro holds.
+func GetSchema(deviceLabel string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("The Jamf Pro ID of the %s.", deviceLabel),
+		},
+		"serial_number": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: fmt.Sprintf("The serial number of the %s whose inventory record is managed. The %s must already exist in Jamf Pro.", deviceLabel, deviceLabel),
+		},
+		"udid": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("The UDID of the %s.", deviceLabel),
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("The name of the %s.", deviceLabel),
+		},
+		"asset_tag": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "The asset tag.",
+		},
+		"site_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "The ID of the site. Use '-1' for no site.",
+		},
+		"building_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "The ID of the building.",
+		},
+		"department_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "The ID of the department.",
+		},
+		"user_and_location": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Computed:    true,
+			MaxItems:    1,
+			Description: "The assigned user and location.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"username":      optionalString("The username of the assigned user."),
+					"real_name":     optionalString("The full name of the assigned user."),
+					"email_address": optionalString("The email address of the assigned user."),
+					"position":      optionalString("The position of the assigned user."),
+					"phone_number":  optionalString("The phone number of the assigned user."),
+					"room":          optionalString("The room."),
+				},
+			},
+		},
+		"purchasing": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Computed:    true,
+			MaxItems:    1,
+			Description: "The purchasing details.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"purchased": {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Computed:    true,
+						Description: "Whether the device was purchased.",
+					},
+					"leased": {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Computed:    true,
+						Description: "Whether the device is leased.",
+					},
+					"po_number":          optionalString("The purchase order number."),
+					"po_date":            optionalString("The purchase order date, e.g. '2024-01-31'."),
+					"vendor":             optionalString("The vendor."),
+					"apple_care_id":      optionalString("The AppleCare ID."),
+					"purchase_price":     optionalString("The purchase price."),
+					"purchasing_account": optionalString("The purchasing account."),
+					"purchasing_contact": optionalString("The purchasing contact."),
+					"warranty_date":      optionalString("The warranty expiry date, e.g. '2027-01-31'."),
+					"lease_date":         optionalString("The lease expiry date, e.g. '2027-01-31'."),
+					"life_expectancy": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The life expectancy in years.",
+					},
+				},
+			},
+		},
+		"extension_attribute": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Description: "Extension attribute values to set. Only the extension attributes listed here are managed; " +
+				"other extension attribute values of the device are left untouched.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "The ID of the extension attribute.",
+					},
+					"values": {
+						Type:        schema.TypeList,
+						Required:    true,
+						Description: "The values of the extension attribute. Most extension attributes take a single value.",
+						Elem:        &schema.Schema{Type: schema.TypeString},
+					},
+				},
+			},
+		},
+	}
+}
+
+func optionalString(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		Description: description,
+	}
+}
@@ -0,0 +1,112 @@
+// certificates.go
+// This package contains shared helpers for working with X.509 certificates, such as parsing
+// the Jamf Pro built-in certificate authority chain and embedding CA certificates into
+// com.apple.security.root configuration profile payloads.
+package pki
+
+import (
+	"crypto/sha1" //nolint:gosec // SHA-1 fingerprints are reported for display only
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// RootPayloadType is the payload type of a configuration profile payload installing a trusted
+// root or intermediate certificate.
+const RootPayloadType = "com.apple.security.root"
+
+// Certificate describes a single certificate of a PEM encoded chain.
+type Certificate struct {
+	Subject           string
+	CommonName        string
+	Issuer            string
+	SerialNumber      string
+	NotBefore         time.Time
+	NotAfter          time.Time
+	IsCA              bool
+	SHA1Fingerprint   string
+	SHA256Fingerprint string
+	PEM               string
+	DERBase64         string
+}
+
+var fileNameUnsafeChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// ParsePEMChain parses all CERTIFICATE blocks of a PEM encoded chain in the order they appear.
+// Blocks of any other type are ignored.
+func ParsePEMChain(chain string) ([]Certificate, error) {
+	var certificates []Certificate
+
+	rest := []byte(chain)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse certificate %d of chain: %v", len(certificates)+1, err)
+		}
+
+		sha1Sum := sha1.Sum(cert.Raw) //nolint:gosec
+		sha256Sum := sha256.Sum256(cert.Raw)
+
+		certificates = append(certificates, Certificate{
+			Subject:           cert.Subject.String(),
+			CommonName:        cert.Subject.CommonName,
+			Issuer:            cert.Issuer.String(),
+			SerialNumber:      cert.SerialNumber.Text(16),
+			NotBefore:         cert.NotBefore.UTC(),
+			NotAfter:          cert.NotAfter.UTC(),
+			IsCA:              cert.IsCA,
+			SHA1Fingerprint:   fmt.Sprintf("%X", sha1Sum),
+			SHA256Fingerprint: fmt.Sprintf("%X", sha256Sum),
+			PEM:               string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})),
+			DERBase64:         base64.StdEncoding.EncodeToString(cert.Raw),
+		})
+	}
+
+	if len(certificates) == 0 {
+		return nil, fmt.Errorf("no certificates found in PEM chain")
+	}
+
+	return certificates, nil
+}
+
+// FileName returns the certificate file name shown for the certificate in a configuration
+// profile, derived from the common name of the certificate.
+func (c Certificate) FileName() string {
+	name := strings.Trim(fileNameUnsafeChars.ReplaceAllString(c.CommonName, "_"), "_")
+	if name == "" {
+		name = c.SHA256Fingerprint[:16]
+	}
+	return name + ".cer"
+}
+
+// RootPayloadSettings returns the payload content of a com.apple.security.root payload installing
+// the certificate, in the shape of the plist generator 'setting' blocks. The certificate is
+// embedded as DER encoded data.
+func (c Certificate) RootPayloadSettings() []map[string]any {
+	return []map[string]any{
+		{
+			"key":        "PayloadCertificateFileName",
+			"value":      c.FileName(),
+			"value_type": "string",
+		},
+		{
+			"key":        "PayloadContent",
+			"value":      c.DERBase64,
+			"value_type": "data",
+		},
+	}
+}
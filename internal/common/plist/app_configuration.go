@@ -0,0 +1,190 @@
+// common/configurationprofiles/plist/app_configuration.go
+// Description: This file contains helpers for managed app configuration dictionaries of mobile
+// device applications, which Jamf Pro stores as a bare plist <dict> fragment.
+package plist
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"howett.net/plist"
+)
+
+// appConfigurationVariables are the Jamf Pro payload variables substituted in managed app
+// configuration. $EXTENSIONATTRIBUTE_<id> is matched separately.
+var appConfigurationVariables = []string{
+	"$ASSET_TAG",
+	"$BUILDING",
+	"$DEPARTMENT",
+	"$DEVICENAME",
+	"$EMAIL",
+	"$FULLNAME",
+	"$JPS_URL",
+	"$JSSID",
+	"$MACADDRESS",
+	"$MANAGEMENTID",
+	"$MOBILEDEVICEAPPINVITE",
+	"$PHONE",
+	"$POSITION",
+	"$PROFILEJSSID",
+	"$REALNAME",
+	"$ROOM",
+	"$SERIALNUMBER",
+	"$SITEID",
+	"$SITENAME",
+	"$UDID",
+	"$USERNAME",
+}
+
+var (
+	appConfigurationVariablePattern    = regexp.MustCompile(`\$[A-Z][A-Z0-9_]*`)
+	appConfigurationExtensionAttribute = regexp.MustCompile(`^\$EXTENSIONATTRIBUTE_[0-9]+$`)
+)
+
+const (
+	plistDocumentHeader = `<?xml version="1.0" encoding="UTF-8"?>` + "\n" + `<plist version="1.0">` + "\n"
+	plistDocumentFooter = "</plist>"
+)
+
+// AppConfigurationVariables returns the Jamf Pro payload variables supported in managed app
+// configuration, excluding the $EXTENSIONATTRIBUTE_<id> family.
+func AppConfigurationVariables() []string {
+	return append([]string(nil), appConfigurationVariables...)
+}
+
+// ParseAppConfiguration decodes a managed app configuration, given either as the <dict> fragment
+// stored by Jamf Pro or as a complete plist document. The root element must be a dictionary.
+func ParseAppConfiguration(preferences string) (map[string]any, error) {
+	document := strings.TrimSpace(preferences)
+	if !strings.Contains(document, "<plist") {
+		document = plistDocumentHeader + document + "\n" + plistDocumentFooter
+	}
+
+	var decoded any
+	format, err := plist.Unmarshal([]byte(document), &decoded)
+	if err != nil {
+		return nil, fmt.Errorf("app configuration is not a valid plist: %v", err)
+	}
+	if format != plist.XMLFormat {
+		return nil, fmt.Errorf("app configuration must be an XML plist")
+	}
+
+	settings, ok := decoded.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("app configuration root element must be a <dict>, got %s", TypeOfValue(decoded))
+	}
+
+	return settings, nil
+}
+
+// EncodeAppConfiguration renders settings as the <dict> fragment Jamf Pro stores for a managed
+// app configuration.
+func EncodeAppConfiguration(settings map[string]any) (string, error) {
+	var buffer bytes.Buffer
+	encoder := plist.NewEncoder(&buffer)
+	encoder.Indent("\t")
+
+	if err := encoder.Encode(settings); err != nil {
+		return "", fmt.Errorf("failed to encode app configuration: %v", err)
+	}
+
+	document := buffer.String()
+	start := strings.Index(document, "<dict")
+	end := strings.LastIndex(document, plistDocumentFooter)
+	if start < 0 || end < start {
+		return "", fmt.Errorf("failed to encode app configuration: unexpected plist document")
+	}
+
+	return strings.TrimSpace(document[start:end]), nil
+}
+
+// ValidateAppConfigurationVariables checks that every $VARIABLE used in the keys and string
+// values of an app configuration is a payload variable supported by Jamf Pro.
+func ValidateAppConfigurationVariables(settings map[string]any) error {
+	unsupported := map[string]struct{}{}
+	collectAppConfigurationVariables(settings, unsupported)
+
+	if len(unsupported) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(unsupported))
+	for name := range unsupported {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return fmt.Errorf("unsupported app configuration variables %s; supported variables are %s and $EXTENSIONATTRIBUTE_<id>",
+		strings.Join(names, ", "), strings.Join(appConfigurationVariables, ", "))
+}
+
+// collectAppConfigurationVariables records the unsupported variables found in value.
+func collectAppConfigurationVariables(value any, unsupported map[string]struct{}) {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			collectAppConfigurationVariables(key, unsupported)
+			collectAppConfigurationVariables(item, unsupported)
+		}
+	case []any:
+		for _, item := range v {
+			collectAppConfigurationVariables(item, unsupported)
+		}
+	case string:
+		for _, name := range appConfigurationVariablePattern.FindAllString(v, -1) {
+			if !isAppConfigurationVariable(name) {
+				unsupported[name] = struct{}{}
+			}
+		}
+	}
+}
+
+// isAppConfigurationVariable reports whether name is a supported payload variable.
+func isAppConfigurationVariable(name string) bool {
+	for _, variable := range appConfigurationVariables {
+		if name == variable {
+			return true
+		}
+	}
+	return appConfigurationExtensionAttribute.MatchString(name)
+}
+
+// AppConfigurationsEquivalent reports whether two app configurations decode to the same settings,
+// ignoring formatting, key order and integer or real representation differences.
+func AppConfigurationsEquivalent(a, b string) bool {
+	settingsA, errA := ParseAppConfiguration(a)
+	settingsB, errB := ParseAppConfiguration(b)
+	if errA != nil || errB != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(CanonicalizeValue(settingsA), CanonicalizeValue(settingsB))
+}
+
+// DecodeAppConfigurationMapValue converts a 'preferences_map' value into a plist value. JSON
+// objects, arrays and quoted strings are decoded with DecodeJSONValue, any other value is inferred
+// with InferScalarValue.
+func DecodeAppConfigurationMapValue(value string) (any, error) {
+	if strings.HasPrefix(value, "{") || strings.HasPrefix(value, "[") || strings.HasPrefix(value, `"`) {
+		return DecodeJSONValue(value)
+	}
+	return InferScalarValue(value), nil
+}
+
+// EncodeAppConfigurationMapValue renders a plist value as a 'preferences_map' value, the inverse
+// of DecodeAppConfigurationMapValue.
+func EncodeAppConfigurationMapValue(value any) (string, error) {
+	switch v := value.(type) {
+	case bool, int, int32, int64, uint, uint32, uint64:
+		return FormatScalarValue(v), nil
+	case string:
+		if decoded, err := DecodeAppConfigurationMapValue(v); err == nil && decoded == v {
+			return v, nil
+		}
+	}
+	return EncodeJSONValue(value)
+}
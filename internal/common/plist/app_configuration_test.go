@@ -0,0 +1,112 @@
+package plist
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testAppConfiguration = `<dict>
+	<key>serial</key>
+	<string>$SERIALNUMBER</string>
+	<key>email</key>
+	<string>$EMAIL</string>
+	<key>port</key>
+	<integer>8443</integer>
+	<key>tags</key>
+	<array>
+		<string>$EXTENSIONATTRIBUTE_12</string>
+	</array>
+</dict>`
+
+func TestParseAppConfiguration(t *testing.T) {
+	settings, err := ParseAppConfiguration(testAppConfiguration)
+	require.NoError(t, err)
+	assert.Equal(t, "$SERIALNUMBER", settings["serial"])
+	assert.EqualValues(t, 8443, settings["port"])
+
+	document := `<?xml version="1.0" encoding="UTF-8"?><plist version="1.0">` + testAppConfiguration + `</plist>`
+	fromDocument, err := ParseAppConfiguration(document)
+	require.NoError(t, err)
+	assert.Equal(t, settings, fromDocument)
+
+	invalid := []string{
+		`<dict><key>a</key></dict>`,
+		`<dict><key>a</key><string>b</string>`,
+		`<array><string>a</string></array>`,
+		`{ a = b; }`,
+	}
+	for _, preferences := range invalid {
+		_, err := ParseAppConfiguration(preferences)
+		assert.Error(t, err, preferences)
+	}
+}
+
+func TestValidateAppConfigurationVariables(t *testing.T) {
+	settings, err := ParseAppConfiguration(testAppConfiguration)
+	require.NoError(t, err)
+	assert.NoError(t, ValidateAppConfigurationVariables(settings))
+
+	err = ValidateAppConfigurationVariables(map[string]any{
+		"user":   "$USERNAME",
+		"serial": "$SERIAL_NUMBER",
+		"nested": map[string]any{"$DEVICEUDID": []any{"$EXTENSIONATTRIBUTE_X"}},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "$DEVICEUDID, $EXTENSIONATTRIBUTE_X, $SERIAL_NUMBER;")
+}
+
+func TestEncodeAppConfigurationRoundTrip(t *testing.T) {
+	settings := map[string]any{
+		"enabled": true,
+		"server":  "https://example.com",
+		"port":    443,
+		"domains": []any{"example.com", "example.org"},
+	}
+
+	encoded, err := EncodeAppConfiguration(settings)
+	require.NoError(t, err)
+	assert.True(t, len(encoded) > 0 && encoded[:6] == "<dict>")
+	assert.NotContains(t, encoded, "<plist")
+
+	decoded, err := ParseAppConfiguration(encoded)
+	require.NoError(t, err)
+	assert.Equal(t, CanonicalizeValue(settings), CanonicalizeValue(decoded))
+}
+
+func TestAppConfigurationsEquivalent(t *testing.T) {
+	reformatted := `<dict><key>tags</key><array><string>$EXTENSIONATTRIBUTE_12</string></array>` +
+		`<key>port</key><integer>8443</integer><key>email</key><string>$EMAIL</string>` +
+		`<key>serial</key><string>$SERIALNUMBER</string></dict>`
+
+	assert.True(t, AppConfigurationsEquivalent(testAppConfiguration, reformatted))
+	assert.False(t, AppConfigurationsEquivalent(testAppConfiguration, `<dict><key>port</key><integer>8443</integer></dict>`))
+	assert.False(t, AppConfigurationsEquivalent(testAppConfiguration, "not a plist"))
+}
+
+func TestAppConfigurationMapValueRoundTrip(t *testing.T) {
+	tests := []struct {
+		value   string
+		decoded any
+	}{
+		{value: "true", decoded: true},
+		{value: "443", decoded: 443},
+		{value: "$EMAIL", decoded: "$EMAIL"},
+		{value: `"443"`, decoded: "443"},
+		{value: `["a","b"]`, decoded: []any{"a", "b"}},
+		{value: `{"host":"example.com"}`, decoded: map[string]any{"host": "example.com"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			decoded, err := DecodeAppConfigurationMapValue(tt.value)
+			require.NoError(t, err)
+			assert.Equal(t, tt.decoded, decoded)
+
+			encoded, err := EncodeAppConfigurationMapValue(decoded)
+			require.NoError(t, err)
+			assert.Equal(t, tt.value, encoded)
+		})
+	}
+}
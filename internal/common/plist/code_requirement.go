@@ -0,0 +1,422 @@
+// common/configurationprofiles/plist/code_requirement.go
+// Description: This file contains a syntax validator for the Apple code signing requirement language
+// used by the CodeRequirement keys of Privacy Preferences Policy Control (PPPC) payloads.
+package plist
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// codeRequirementTokenKind identifies the lexical class of a code requirement token.
+type codeRequirementTokenKind int
+
+const (
+	crTokenEOF codeRequirementTokenKind = iota
+	crTokenWord
+	crTokenString
+	crTokenHash
+	crTokenOperator
+	crTokenNot
+	crTokenLeftParen
+	crTokenRightParen
+	crTokenLeftBracket
+	crTokenRightBracket
+)
+
+// codeRequirementToken is a single lexical token and its character offset within the requirement.
+type codeRequirementToken struct {
+	kind  codeRequirementTokenKind
+	value string
+	pos   int
+}
+
+func (t codeRequirementToken) String() string {
+	switch t.kind {
+	case crTokenEOF:
+		return "end of requirement"
+	case crTokenString:
+		return fmt.Sprintf("\"%s\"", t.value)
+	case crTokenHash:
+		return fmt.Sprintf("H\"%s\"", t.value)
+	default:
+		return fmt.Sprintf("'%s'", t.value)
+	}
+}
+
+// codeRequirementParser is a recursive descent parser over the tokenised requirement.
+type codeRequirementParser struct {
+	tokens []codeRequirementToken
+	index  int
+}
+
+// ValidateCodeRequirement checks that requirement is syntactically valid in the Apple code signing
+// requirement language, as accepted by 'csreq'. It validates structure only; it does not evaluate
+// the requirement against any signed code. Supported constructs are the 'and', 'or' and '!'
+// operators with parentheses, 'identifier', 'cdhash', 'anchor', 'certificate'/'cert',
+// 'info[...]', 'entitlement[...]', 'platform', 'notarized', 'legacy', 'always' and 'never'.
+func ValidateCodeRequirement(requirement string) error {
+	if strings.TrimSpace(requirement) == "" {
+		return fmt.Errorf("code requirement must not be empty")
+	}
+
+	tokens, err := tokenizeCodeRequirement(requirement)
+	if err != nil {
+		return err
+	}
+
+	p := &codeRequirementParser{tokens: tokens}
+	if err := p.parseOr(); err != nil {
+		return err
+	}
+	if tok := p.peek(); tok.kind != crTokenEOF {
+		return p.errorf(tok, "unexpected %s", tok)
+	}
+
+	return nil
+}
+
+// tokenizeCodeRequirement splits a requirement into tokens, skipping whitespace and /* */ comments.
+func tokenizeCodeRequirement(input string) ([]codeRequirementToken, error) {
+	var tokens []codeRequirementToken
+	runes := []rune(input)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '/' && i+1 < len(runes) && runes[i+1] == '*':
+			end := i + 2
+			for end+1 < len(runes) && (runes[end] != '*' || runes[end+1] != '/') {
+				end++
+			}
+			if end+1 >= len(runes) {
+				return nil, fmt.Errorf("invalid code requirement at position %d: unterminated comment", i)
+			}
+			i = end + 2
+		case r == '(':
+			tokens = append(tokens, codeRequirementToken{kind: crTokenLeftParen, value: "(", pos: i})
+			i++
+		case r == ')':
+			tokens = append(tokens, codeRequirementToken{kind: crTokenRightParen, value: ")", pos: i})
+			i++
+		case r == '[':
+			tokens = append(tokens, codeRequirementToken{kind: crTokenLeftBracket, value: "[", pos: i})
+			i++
+		case r == ']':
+			tokens = append(tokens, codeRequirementToken{kind: crTokenRightBracket, value: "]", pos: i})
+			i++
+		case r == '!':
+			tokens = append(tokens, codeRequirementToken{kind: crTokenNot, value: "!", pos: i})
+			i++
+		case r == '=' || r == '<' || r == '>':
+			op := string(r)
+			if (r == '<' || r == '>') && i+1 < len(runes) && runes[i+1] == '=' {
+				op += "="
+			}
+			tokens = append(tokens, codeRequirementToken{kind: crTokenOperator, value: op, pos: i})
+			i += len(op)
+		case r == '"':
+			value, next, err := readCodeRequirementString(runes, i)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, codeRequirementToken{kind: crTokenString, value: value, pos: i})
+			i = next
+		case r == 'H' && i+1 < len(runes) && runes[i+1] == '"':
+			value, next, err := readCodeRequirementString(runes, i+1)
+			if err != nil {
+				return nil, err
+			}
+			for _, c := range value {
+				if !strings.ContainsRune("0123456789abcdefABCDEF", c) {
+					return nil, fmt.Errorf("invalid code requirement at position %d: hash H\"%s\" must only contain hexadecimal digits", i, value)
+				}
+			}
+			tokens = append(tokens, codeRequirementToken{kind: crTokenHash, value: value, pos: i})
+			i = next
+		case isCodeRequirementWordRune(r):
+			start := i
+			for i < len(runes) && isCodeRequirementWordRune(runes[i]) {
+				i++
+			}
+			tokens = append(tokens, codeRequirementToken{kind: crTokenWord, value: string(runes[start:i]), pos: start})
+		default:
+			return nil, fmt.Errorf("invalid code requirement at position %d: unexpected character '%c'", i, r)
+		}
+	}
+
+	return append(tokens, codeRequirementToken{kind: crTokenEOF, pos: len(runes)}), nil
+}
+
+// readCodeRequirementString reads a double quoted string starting at the opening quote, honouring
+// backslash escapes. It returns the unquoted value and the index following the closing quote.
+func readCodeRequirementString(runes []rune, start int) (string, int, error) {
+	var sb strings.Builder
+	for i := start + 1; i < len(runes); i++ {
+		switch runes[i] {
+		case '\\':
+			if i+1 >= len(runes) {
+				return "", 0, fmt.Errorf("invalid code requirement at position %d: unterminated string", start)
+			}
+			i++
+			sb.WriteRune(runes[i])
+		case '"':
+			return sb.String(), i + 1, nil
+		default:
+			sb.WriteRune(runes[i])
+		}
+	}
+	return "", 0, fmt.Errorf("invalid code requirement at position %d: unterminated string", start)
+}
+
+// isCodeRequirementWordRune reports whether r may appear in an unquoted word such as a keyword,
+// bundle identifier, OID or wildcard pattern.
+func isCodeRequirementWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || strings.ContainsRune("._-*/$+:", r)
+}
+
+func (p *codeRequirementParser) peek() codeRequirementToken {
+	return p.tokens[p.index]
+}
+
+func (p *codeRequirementParser) next() codeRequirementToken {
+	tok := p.tokens[p.index]
+	if tok.kind != crTokenEOF {
+		p.index++
+	}
+	return tok
+}
+
+func (p *codeRequirementParser) errorf(tok codeRequirementToken, format string, args ...any) error {
+	return fmt.Errorf("invalid code requirement at position %d: %s", tok.pos, fmt.Sprintf(format, args...))
+}
+
+// isKeyword reports whether tok is the unquoted word keyword.
+func (tok codeRequirementToken) isKeyword(keyword string) bool {
+	return tok.kind == crTokenWord && tok.value == keyword
+}
+
+// parseOr parses: and-expr ( 'or' and-expr )*
+func (p *codeRequirementParser) parseOr() error {
+	if err := p.parseAnd(); err != nil {
+		return err
+	}
+	for p.peek().isKeyword("or") {
+		p.next()
+		if err := p.parseAnd(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseAnd parses: unary ( 'and' unary )*
+func (p *codeRequirementParser) parseAnd() error {
+	if err := p.parseUnary(); err != nil {
+		return err
+	}
+	for p.peek().isKeyword("and") {
+		p.next()
+		if err := p.parseUnary(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseUnary parses: '!' unary | '(' or-expr ')' | primary
+func (p *codeRequirementParser) parseUnary() error {
+	tok := p.peek()
+	switch tok.kind {
+	case crTokenNot:
+		p.next()
+		return p.parseUnary()
+	case crTokenLeftParen:
+		p.next()
+		if err := p.parseOr(); err != nil {
+			return err
+		}
+		if closing := p.next(); closing.kind != crTokenRightParen {
+			return p.errorf(closing, "expected ')' but found %s", closing)
+		}
+		return nil
+	default:
+		return p.parsePrimary()
+	}
+}
+
+// parsePrimary parses a single requirement clause.
+func (p *codeRequirementParser) parsePrimary() error {
+	tok := p.next()
+	if tok.kind != crTokenWord {
+		return p.errorf(tok, "expected a requirement clause but found %s", tok)
+	}
+
+	switch tok.value {
+	case "always", "true", "never", "false", "notarized", "legacy":
+		return nil
+	case "identifier":
+		p.skipOperator("=")
+		return p.expectValue("identifier")
+	case "cdhash":
+		p.skipOperator("=")
+		return p.expectHashOrString("cdhash")
+	case "platform":
+		p.skipOperator("=")
+		return p.expectNumber("platform")
+	case "anchor":
+		return p.parseAnchor(tok)
+	case "certificate", "cert":
+		return p.parseCertificate()
+	case "info", "entitlement":
+		if err := p.parseBracketKey(tok.value); err != nil {
+			return err
+		}
+		return p.parseMatch(tok.value)
+	default:
+		return p.errorf(tok, "unknown requirement clause '%s'", tok.value)
+	}
+}
+
+// parseAnchor parses: 'anchor' ( 'apple' ['generic'] | 'trusted' | '=' hash-or-path | cert-slot ... )
+func (p *codeRequirementParser) parseAnchor(anchor codeRequirementToken) error {
+	tok := p.peek()
+	switch {
+	case tok.isKeyword("apple"):
+		p.next()
+		if p.peek().isKeyword("generic") {
+			p.next()
+		}
+		return nil
+	case tok.isKeyword("trusted"):
+		p.next()
+		return nil
+	case tok.kind == crTokenOperator && tok.value == "=":
+		p.next()
+		return p.expectHashOrString("anchor")
+	case tok.kind == crTokenLeftBracket:
+		// 'anchor' is shorthand for 'certificate root'
+		return p.parseCertificateField()
+	default:
+		return p.errorf(anchor, "'anchor' must be followed by 'apple', 'apple generic', 'trusted', '=' or a certificate field")
+	}
+}
+
+// parseCertificate parses: ('certificate'|'cert') slot ( 'trusted' | '=' hash | '[' field ']' match )
+func (p *codeRequirementParser) parseCertificate() error {
+	slot := p.next()
+	if slot.kind != crTokenWord || !isCertificateSlot(slot.value) {
+		return p.errorf(slot, "expected a certificate slot ('leaf', 'root', 'anchor' or a position) but found %s", slot)
+	}
+
+	tok := p.peek()
+	switch {
+	case tok.isKeyword("trusted"):
+		p.next()
+		return nil
+	case tok.kind == crTokenOperator && tok.value == "=":
+		p.next()
+		return p.expectHashOrString("certificate")
+	case tok.kind == crTokenLeftBracket:
+		return p.parseCertificateField()
+	default:
+		return p.errorf(tok, "certificate %s must be followed by 'trusted', '=' or a certificate field", slot.value)
+	}
+}
+
+func (p *codeRequirementParser) parseCertificateField() error {
+	if err := p.parseBracketKey("certificate"); err != nil {
+		return err
+	}
+	return p.parseMatch("certificate field")
+}
+
+// parseBracketKey parses: '[' key ']'
+func (p *codeRequirementParser) parseBracketKey(clause string) error {
+	if open := p.next(); open.kind != crTokenLeftBracket {
+		return p.errorf(open, "expected '[' after '%s' but found %s", clause, open)
+	}
+	if key := p.next(); key.kind != crTokenWord && key.kind != crTokenString {
+		return p.errorf(key, "expected a key within '%s[...]' but found %s", clause, key)
+	}
+	if closing := p.next(); closing.kind != crTokenRightBracket {
+		return p.errorf(closing, "expected ']' but found %s", closing)
+	}
+	return nil
+}
+
+// parseMatch parses: [ 'exists' | 'absent' | ( '=' | '<' | '>' | '<=' | '>=' ) value ]
+// An omitted match is an implicit 'exists', which is how 'codesign -r-' renders certificate fields.
+func (p *codeRequirementParser) parseMatch(clause string) error {
+	tok := p.peek()
+	if tok.isKeyword("exists") || tok.isKeyword("absent") {
+		p.next()
+		return nil
+	}
+	if tok.kind == crTokenOperator {
+		p.next()
+		return p.expectValue(clause)
+	}
+	return nil
+}
+
+func (p *codeRequirementParser) skipOperator(op string) {
+	if tok := p.peek(); tok.kind == crTokenOperator && tok.value == op {
+		p.next()
+	}
+}
+
+func (p *codeRequirementParser) expectValue(clause string) error {
+	tok := p.next()
+	if tok.kind == crTokenString || (tok.kind == crTokenWord && !isCodeRequirementOperatorWord(tok.value)) {
+		return nil
+	}
+	return p.errorf(tok, "expected a value for '%s' but found %s", clause, tok)
+}
+
+func (p *codeRequirementParser) expectHashOrString(clause string) error {
+	tok := p.next()
+	if tok.kind == crTokenHash || tok.kind == crTokenString {
+		return nil
+	}
+	return p.errorf(tok, "expected a H\"...\" hash or quoted string for '%s' but found %s", clause, tok)
+}
+
+func (p *codeRequirementParser) expectNumber(clause string) error {
+	tok := p.next()
+	if tok.kind == crTokenWord && isCodeRequirementNumber(tok.value) {
+		return nil
+	}
+	return p.errorf(tok, "expected a number for '%s' but found %s", clause, tok)
+}
+
+// isCertificateSlot reports whether value names a certificate chain position.
+func isCertificateSlot(value string) bool {
+	switch value {
+	case "leaf", "root", "anchor":
+		return true
+	}
+	return isCodeRequirementNumber(strings.TrimPrefix(value, "-"))
+}
+
+func isCodeRequirementNumber(value string) bool {
+	if value == "" {
+		return false
+	}
+	for _, r := range value {
+		if !unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// isCodeRequirementOperatorWord reports whether value is a boolean operator that can never be
+// used as a bare value.
+func isCodeRequirementOperatorWord(value string) bool {
+	return value == "and" || value == "or"
+}
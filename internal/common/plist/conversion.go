@@ -21,7 +21,11 @@ import (
 // ConvertHCLToPlist builds a plist from the Terraform HCL schema data
 // Used by plist generator resource to convert HCL data to plist
 func ConvertHCLToPlist(d *schema.ResourceData) (string, error) {
-	profile := mapSchemaToProfile(d)
+	profile, err := mapSchemaToProfile(d)
+	if err != nil {
+		return "", fmt.Errorf("failed to map payloads to plist: %w", err)
+	}
+
 	plistData, err := MarshalPayload(profile)
 	if err != nil {
 		return "", fmt.Errorf("failed to marshal plist: %w", err)
@@ -39,7 +43,7 @@ func ConvertHCLToPlist(d *schema.ResourceData) (string, error) {
 }
 
 // mapSchemaToProfile maps the Terraform schema data to the ConfigurationProfile struct
-func mapSchemaToProfile(d *schema.ResourceData) *ConfigurationProfile {
+func mapSchemaToProfile(d *schema.ResourceData) (*ConfigurationProfile, error) {
 	uuidStr := uuid.New().String()
 
 	// Root Level
@@ -58,7 +62,7 @@ func mapSchemaToProfile(d *schema.ResourceData) *ConfigurationProfile {
 
 	// Contents
 	payloadContents := d.Get("payloads.0.payload_content").([]any)
-	for _, v := range payloadContents {
+	for i, v := range payloadContents {
 		val := v.(map[string]any)
 		payloadContentStruct := PayloadContent{
 			PayloadDescription:  val["payload_description"].(string),
@@ -71,51 +75,83 @@ func mapSchemaToProfile(d *schema.ResourceData) *ConfigurationProfile {
 			PayloadVersion:      val["payload_version"].(int),
 		}
 
-		settings := val["setting"].([]any)
-		if len(settings) == 0 {
-			return out
-		}
-
-		payloadContentStruct.ConfigurationItems = make(map[string]any, 0)
-		for _, s := range settings {
-			settingMap := s.(map[string]any)
-			dictionary := parseNestedDictionary(settingMap["dictionary"])
-			payloadContent := map[string]any{
-				"key":        settingMap["key"].(string),
-				"value":      GetTypedValue(settingMap["value"]),
-				"dictionary": dictionary,
-			}
-			payloadContentStruct.ConfigurationItems[settingMap["key"].(string)] = payloadContent
+		items, err := ConvertSettingsToDictionary(val["setting"].([]any))
+		if err != nil {
+			return nil, fmt.Errorf("payload_content[%d]: %w", i, err)
 		}
+		payloadContentStruct.ConfigurationItems = items
 
 		out.PayloadContent = append(out.PayloadContent, payloadContentStruct)
 	}
 
-	return out
+	return out, nil
+}
+
+// ConvertSettingsToDictionary converts a list of HCL 'setting' or 'dictionary' entries into a plist
+// dictionary, resolving each entry to its typed plist value.
+func ConvertSettingsToDictionary(settings []any) (map[string]any, error) {
+	result := make(map[string]any, len(settings))
+	for _, item := range settings {
+		entry, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		key, _ := entry["key"].(string)
+		value, err := parseSettingValue(entry)
+		if err != nil {
+			return nil, fmt.Errorf("key '%s': %w", key, err)
+		}
+		result[key] = value
+	}
+
+	return result, nil
+}
+
+// parseSettingValue resolves a single HCL setting entry to its plist value. 'value_json' takes
+// precedence, followed by nested 'dictionary' entries and finally the scalar 'value'.
+func parseSettingValue(entry map[string]any) (any, error) {
+	valueType, _ := entry["value_type"].(string)
+	valueJSON, _ := entry["value_json"].(string)
+	dictionary, _ := entry["dictionary"].([]any)
+	value, _ := entry["value"].(string)
+
+	switch {
+	case valueJSON != "":
+		decoded, err := DecodeJSONValue(valueJSON)
+		if err != nil {
+			return nil, err
+		}
+		if valueType != "" && TypeOfValue(decoded) != valueType {
+			return nil, fmt.Errorf("value_json holds a %s but value_type is '%s'", TypeOfValue(decoded), valueType)
+		}
+		return decoded, nil
+	case len(dictionary) > 0:
+		if valueType != "" && valueType != ValueTypeDict {
+			return nil, fmt.Errorf("dictionary entries require value_type 'dict', got '%s'", valueType)
+		}
+		return parseNestedDictionary(dictionary)
+	default:
+		return GetTypedValueOfType(value, valueType)
+	}
 }
 
 // parseNestedDictionary recursively parses the nested dictionary structure
-func parseNestedDictionary(dict any) map[string]any {
+func parseNestedDictionary(dict any) (map[string]any, error) {
 	if dict == nil {
-		return nil
+		return nil, nil
 	}
 
-	result := make(map[string]any)
-	dictionary := dict.([]any)
-	for _, item := range dictionary {
-		entry := item.(map[string]any)
-		key := entry["key"].(string)
-		value := GetTypedValue(entry["value"])
-		if nestedDict, ok := entry["dictionary"].([]any); ok {
-			value = parseNestedDictionary(nestedDict)
-		}
-		result[key] = value
+	dictionary, ok := dict.([]any)
+	if !ok {
+		return nil, nil
 	}
 
-	return result
+	return ConvertSettingsToDictionary(dictionary)
 }
 
 // GetTypedValue converts the value from the HCL always stored as string into the appropriate type for plist serialization.
+// Any strconv.ParseBool spelling ('true', '1', 'TRUE', 't', ...) becomes a boolean and other whole numbers become
+// integers, as in earlier releases; an explicit 'value_type' selects another type.
 func GetTypedValue(value any) any {
 	strValue := fmt.Sprintf("%v", value)
 	if boolValue, err := strconv.ParseBool(strValue); err == nil {
@@ -128,8 +164,9 @@ func GetTypedValue(value any) any {
 }
 
 // ConvertPlistToHCL converts a plist XML string to Terraform HCL schema data
-// Used by plist generator resource
-func ConvertPlistToHCL(plistXML string) ([]any, error) {
+// Used by plist generator resource. The prior 'payloads' value, when available, is used to keep
+// the setting order and the user's choice of 'value_json' or 'dictionary' representation stable.
+func ConvertPlistToHCL(plistXML string, prior []any) ([]any, error) {
 	profile, err := UnmarshalPayload(plistXML)
 	if err != nil {
 		return nil, fmt.Errorf("failed to unmarshal plist: %w", err)
@@ -142,19 +179,7 @@ func ConvertPlistToHCL(plistXML string) ([]any, error) {
 	}
 	log.Printf("[DEBUG] Unmarshaled profile: %s", string(profileData))
 
-	if profile.PayloadContent != nil {
-		for i, content := range profile.PayloadContent {
-			contentData, err := json.MarshalIndent(content, "", "  ")
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal content to JSON: %w", err)
-			}
-			log.Printf("[DEBUG] PayloadContent %d: %s", i, string(contentData))
-		}
-	} else {
-		log.Printf("[DEBUG] PayloadContent is nil")
-	}
-
-	payloadsList, err := mapProfileToSchema(profile)
+	payloadsList, err := mapProfileToSchema(profile, prior)
 	if err != nil {
 		return nil, fmt.Errorf("failed to map profile to schema: %w", err)
 	}
@@ -171,7 +196,7 @@ func ConvertPlistToHCL(plistXML string) ([]any, error) {
 }
 
 // mapProfileToSchema maps the ConfigurationProfile struct data to the Terraform schema
-func mapProfileToSchema(profile *ConfigurationProfile) ([]any, error) {
+func mapProfileToSchema(profile *ConfigurationProfile, prior []any) ([]any, error) {
 	payloadHeader := map[string]any{
 		"payload_description_header":        profile.PayloadDescription,
 		"payload_display_name_header":       profile.PayloadDisplayName,
@@ -185,8 +210,15 @@ func mapProfileToSchema(profile *ConfigurationProfile) ([]any, error) {
 		"payload_version_header":            profile.PayloadVersion,
 	}
 
+	var priorContents []any
+	if len(prior) > 0 {
+		if priorHeader, ok := prior[0].(map[string]any); ok {
+			priorContents, _ = priorHeader["payload_content"].([]any)
+		}
+	}
+
 	payloadContentList := []any{}
-	for _, content := range profile.PayloadContent {
+	for i, content := range profile.PayloadContent {
 		payloadContent := map[string]any{
 			"payload_description":  content.PayloadDescription,
 			"payload_display_name": content.PayloadDisplayName,
@@ -198,10 +230,17 @@ func mapProfileToSchema(profile *ConfigurationProfile) ([]any, error) {
 			"payload_version":      content.PayloadVersion,
 		}
 
-		log.Printf("[DEBUG] ConfigurationItems being passed: %v", content.ConfigurationItems)
-		settingsList := []any{}
-		extractNestedConfigurationSettings(content.ConfigurationItems, &settingsList)
-		log.Printf("[DEBUG] Final settingsList: %v", settingsList)
+		var priorSettings []any
+		if i < len(priorContents) {
+			if priorContent, ok := priorContents[i].(map[string]any); ok {
+				priorSettings, _ = priorContent["setting"].([]any)
+			}
+		}
+
+		settingsList, err := extractNestedConfigurationSettings(content.ConfigurationItems, priorSettings, MaxDictionaryNestingDepth+1)
+		if err != nil {
+			return nil, err
+		}
 
 		payloadContent["setting"] = settingsList
 		payloadContentList = append(payloadContentList, payloadContent)
@@ -212,45 +251,110 @@ func mapProfileToSchema(profile *ConfigurationProfile) ([]any, error) {
 	return []any{payloadHeader}, nil
 }
 
-// extractNestedConfigurationSettings recursively extracts key-value pairs from nested dictionaries and appends them to settingsList
-func extractNestedConfigurationSettings(items map[string]any, settingsList *[]any) {
-	log.Printf("[DEBUG] Raw data being processed: %v", items)
-	for key, value := range items {
-		log.Printf("[DEBUG] Processing configuration item key: %s, value: %v", key, value)
-		settingMap := map[string]any{
-			"key": key,
+// extractNestedConfigurationSettings recursively converts a plist dictionary into a list of HCL
+// setting entries. Keys present in the prior entries keep their position and representation, new
+// keys are appended alphabetically. depth is the number of 'dictionary' levels still available
+// in the schema; dictionaries beyond that depth are rendered with 'value_json'.
+func extractNestedConfigurationSettings(items map[string]any, prior []any, depth int) ([]any, error) {
+	priorByKey := make(map[string]map[string]any, len(prior))
+	orderedKeys := make([]string, 0, len(items))
+	for _, p := range prior {
+		entry, ok := p.(map[string]any)
+		if !ok {
+			continue
+		}
+		key, _ := entry["key"].(string)
+		if _, exists := items[key]; exists {
+			if _, seen := priorByKey[key]; !seen {
+				orderedKeys = append(orderedKeys, key)
+			}
+			priorByKey[key] = entry
+		}
+	}
+	for _, key := range sortedKeys(items) {
+		if _, seen := priorByKey[key]; !seen {
+			orderedKeys = append(orderedKeys, key)
+		}
+	}
+
+	settingsList := make([]any, 0, len(orderedKeys))
+	for _, key := range orderedKeys {
+		setting, err := buildSettingEntry(key, items[key], priorByKey[key], depth)
+		if err != nil {
+			return nil, err
 		}
+		settingsList = append(settingsList, setting)
+	}
+
+	return settingsList, nil
+}
 
-		switch v := value.(type) {
-		case map[string]any:
-			if len(v) > 0 {
-				nestedSettings := []any{}
-				extractNestedConfigurationSettings(v, &nestedSettings)
-				settingMap["dictionary"] = nestedSettings
-			} else {
-				settingMap["value"] = "{}"
+// buildSettingEntry renders a single plist key and value as a HCL setting entry.
+func buildSettingEntry(key string, value any, prior map[string]any, depth int) (map[string]any, error) {
+	priorType, _ := prior["value_type"].(string)
+	priorJSON, _ := prior["value_json"].(string)
+	priorDictionary, _ := prior["dictionary"].([]any)
+	actualType := TypeOfValue(value)
+
+	setting := map[string]any{
+		"key":        key,
+		"value":      "",
+		"value_type": "",
+		"value_json": "",
+	}
+	if depth > 0 {
+		setting["dictionary"] = []any{}
+	}
+	if priorType != "" {
+		setting["value_type"] = actualType
+	}
+
+	switch v := value.(type) {
+	case map[string]any:
+		if priorJSON != "" || depth <= 0 {
+			encoded, err := EncodeJSONValue(v)
+			if err != nil {
+				return nil, fmt.Errorf("key '%s': %w", key, err)
 			}
-		case []any:
-			if len(v) > 0 {
-				var nestedSettings []any
-				for _, item := range v {
-					if nestedItem, ok := item.(map[string]any); ok {
-						nestedSettings = append(nestedSettings, nestedItem)
-					}
-				}
-				settingMap["dictionary"] = nestedSettings
-			} else {
-				settingMap["value"] = "[]"
+			setting["value_json"] = encoded
+			break
+		}
+		if len(v) == 0 {
+			setting["value_type"] = ValueTypeDict
+			break
+		}
+		nested, err := extractNestedConfigurationSettings(v, priorDictionary, depth-1)
+		if err != nil {
+			return nil, err
+		}
+		setting["dictionary"] = nested
+	case []any:
+		if len(v) == 0 && priorJSON == "" {
+			setting["value_type"] = ValueTypeArray
+			break
+		}
+		encoded, err := EncodeJSONValue(v)
+		if err != nil {
+			return nil, fmt.Errorf("key '%s': %w", key, err)
+		}
+		setting["value_json"] = encoded
+	default:
+		if priorJSON != "" {
+			encoded, err := EncodeJSONValue(v)
+			if err != nil {
+				return nil, fmt.Errorf("key '%s': %w", key, err)
 			}
-		case bool, int, float64, string:
-			settingMap["value"] = fmt.Sprintf("%v", v)
-		default:
-			settingMap["value"] = v
+			setting["value_json"] = encoded
+			break
+		}
+		formatted := FormatScalarValue(v)
+		setting["value"] = formatted
+		if priorType == "" && TypeOfValue(GetTypedValue(formatted)) != actualType {
+			setting["value_type"] = actualType
 		}
-
-		log.Printf("[DEBUG] Adding settingMap: %v", settingMap)
-		*settingsList = append(*settingsList, settingMap)
 	}
+
+	return setting, nil
 }
 
 // UnmarshalPayload unmarshals a plist payload into a ConfigurationProfile struct using mapstructure.
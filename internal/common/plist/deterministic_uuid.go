@@ -0,0 +1,97 @@
+// common/configurationprofiles/plist/deterministic_uuid.go
+// Description: This file contains helpers for deriving stable configuration profile PayloadUUID and
+// PayloadIdentifier values, so a profile keeps the same identifiers when it is replaced on the same
+// Jamf Pro tenant.
+package plist
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"howett.net/plist"
+)
+
+// PayloadUUIDMode values supported by profile resources.
+const (
+	PayloadUUIDModeRandom        = "random"
+	PayloadUUIDModeDeterministic = "deterministic"
+)
+
+// payloadUUIDNamespace is the UUIDv5 namespace under which deterministic payload UUIDs are derived.
+var payloadUUIDNamespace = uuid.NewSHA1(uuid.NameSpaceURL, []byte("https://registry.terraform.io/providers/deploymenttheory/jamfpro/payload-uuid"))
+
+// DeterministicPayloadUUID derives a UUIDv5 from the Jamf Pro tenant FQDN, a stable resource key
+// (conventionally the Terraform resource address) and a payload index. Index 0 is the profile root
+// and index n is the nth entry of the root PayloadContent array.
+func DeterministicPayloadUUID(fqdn string, key string, index int) string {
+	name := fmt.Sprintf("%s/%s/%d", strings.ToLower(strings.TrimSuffix(fqdn, "/")), key, index)
+	return strings.ToUpper(uuid.NewSHA1(payloadUUIDNamespace, []byte(name)).String())
+}
+
+// DeterministicPayloadIdentity derives the identifiers of a single payload profile. The root
+// identifier equals the root UUID and the payload identifier is the payload type suffixed with the
+// payload UUID, matching NewPayloadIdentity.
+func DeterministicPayloadIdentity(fqdn string, key string, payloadType string) PayloadIdentity {
+	rootUUID := DeterministicPayloadUUID(fqdn, key, 0)
+	payloadUUID := DeterministicPayloadUUID(fqdn, key, 1)
+
+	return PayloadIdentity{
+		RootUUID:          rootUUID,
+		RootIdentifier:    rootUUID,
+		PayloadUUID:       payloadUUID,
+		PayloadIdentifier: fmt.Sprintf("%s.%s", payloadType, payloadUUID),
+	}
+}
+
+// ApplyDeterministicPayloadUUIDs rewrites the PayloadUUID of a decoded profile and of each payload in
+// its PayloadContent array with deterministic values. A PayloadIdentifier that embeds the previous
+// PayloadUUID, as Jamf Pro and most profile editors generate them, has that UUID replaced so the
+// identifier stays consistent; identifiers that do not embed the UUID are left untouched. When
+// includeRoot is false the root PayloadUUID and PayloadIdentifier are preserved.
+func ApplyDeterministicPayloadUUIDs(profile map[string]any, fqdn string, key string, includeRoot bool) {
+	if includeRoot {
+		rewritePayloadUUID(profile, DeterministicPayloadUUID(fqdn, key, 0))
+	}
+
+	contents, _ := profile["PayloadContent"].([]any)
+	for i, c := range contents {
+		if payload, ok := c.(map[string]any); ok {
+			rewritePayloadUUID(payload, DeterministicPayloadUUID(fqdn, key, i+1))
+		}
+	}
+}
+
+// ApplyDeterministicPayloadUUIDsToPlist applies ApplyDeterministicPayloadUUIDs, including the root,
+// to a plist XML profile and returns the re-encoded profile.
+func ApplyDeterministicPayloadUUIDsToPlist(plistXML string, fqdn string, key string) (string, error) {
+	var profile map[string]any
+	if _, err := plist.Unmarshal([]byte(plistXML), &profile); err != nil {
+		return "", fmt.Errorf("failed to decode plist for deterministic PayloadUUID generation: %v", err)
+	}
+
+	ApplyDeterministicPayloadUUIDs(profile, fqdn, key, true)
+
+	out, err := plist.MarshalIndent(profile, plist.XMLFormat, "\t")
+	if err != nil {
+		return "", fmt.Errorf("failed to encode plist with deterministic PayloadUUID and PayloadIdentifier: %v", err)
+	}
+
+	return string(out), nil
+}
+
+// rewritePayloadUUID sets the PayloadUUID of a payload dictionary and carries the new value into its
+// PayloadIdentifier.
+func rewritePayloadUUID(payload map[string]any, newUUID string) {
+	oldUUID, _ := payload["PayloadUUID"].(string)
+	payload["PayloadUUID"] = newUUID
+
+	identifier, ok := payload["PayloadIdentifier"].(string)
+	if !ok || oldUUID == "" {
+		return
+	}
+
+	if idx := strings.Index(strings.ToUpper(identifier), strings.ToUpper(oldUUID)); idx >= 0 {
+		payload["PayloadIdentifier"] = identifier[:idx] + newUUID + identifier[idx+len(oldUUID):]
+	}
+}
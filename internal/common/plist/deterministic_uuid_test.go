@@ -0,0 +1,94 @@
+package plist
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeterministicPayloadUUID(t *testing.T) {
+	a := DeterministicPayloadUUID("example.jamfcloud.com", "jamfpro_macos_configuration_profile_plist.wifi", 1)
+
+	assert.Equal(t, a, DeterministicPayloadUUID("EXAMPLE.jamfcloud.com/", "jamfpro_macos_configuration_profile_plist.wifi", 1), "FQDN case and trailing slash should not change the UUID")
+	assert.NotEqual(t, a, DeterministicPayloadUUID("other.jamfcloud.com", "jamfpro_macos_configuration_profile_plist.wifi", 1))
+	assert.NotEqual(t, a, DeterministicPayloadUUID("example.jamfcloud.com", "jamfpro_macos_configuration_profile_plist.vpn", 1))
+	assert.NotEqual(t, a, DeterministicPayloadUUID("example.jamfcloud.com", "jamfpro_macos_configuration_profile_plist.wifi", 2))
+	assert.Regexp(t, `^[0-9A-F]{8}-[0-9A-F]{4}-5[0-9A-F]{3}-[89AB][0-9A-F]{3}-[0-9A-F]{12}$`, a)
+}
+
+func TestApplyDeterministicPayloadUUIDs(t *testing.T) {
+	newProfile := func() map[string]any {
+		return map[string]any{
+			"PayloadUUID":       "11111111-1111-1111-1111-111111111111",
+			"PayloadIdentifier": "11111111-1111-1111-1111-111111111111",
+			"PayloadContent": []any{
+				map[string]any{
+					"PayloadUUID":       "22222222-2222-2222-2222-222222222222",
+					"PayloadIdentifier": "com.apple.wifi.managed.22222222-2222-2222-2222-222222222222",
+				},
+				map[string]any{
+					"PayloadUUID":       "33333333-3333-3333-3333-333333333333",
+					"PayloadIdentifier": "com.example.custom",
+				},
+			},
+		}
+	}
+
+	fqdn, key := "example.jamfcloud.com", "jamfpro_macos_configuration_profile_plist.wifi"
+
+	profile := newProfile()
+	ApplyDeterministicPayloadUUIDs(profile, fqdn, key, true)
+
+	rootUUID := DeterministicPayloadUUID(fqdn, key, 0)
+	assert.Equal(t, rootUUID, profile["PayloadUUID"])
+	assert.Equal(t, rootUUID, profile["PayloadIdentifier"])
+
+	contents := profile["PayloadContent"].([]any)
+	first := contents[0].(map[string]any)
+	assert.Equal(t, DeterministicPayloadUUID(fqdn, key, 1), first["PayloadUUID"])
+	assert.Equal(t, "com.apple.wifi.managed."+DeterministicPayloadUUID(fqdn, key, 1), first["PayloadIdentifier"])
+
+	second := contents[1].(map[string]any)
+	assert.Equal(t, DeterministicPayloadUUID(fqdn, key, 2), second["PayloadUUID"])
+	assert.Equal(t, "com.example.custom", second["PayloadIdentifier"], "identifiers not derived from the UUID are preserved")
+
+	again := newProfile()
+	ApplyDeterministicPayloadUUIDs(again, fqdn, key, true)
+	assert.Equal(t, profile, again, "rewriting should be repeatable")
+
+	preserved := newProfile()
+	ApplyDeterministicPayloadUUIDs(preserved, fqdn, key, false)
+	assert.Equal(t, "11111111-1111-1111-1111-111111111111", preserved["PayloadUUID"])
+	assert.Equal(t, "11111111-1111-1111-1111-111111111111", preserved["PayloadIdentifier"])
+}
+
+func TestDeterministicPayloadIdentity(t *testing.T) {
+	identity := DeterministicPayloadIdentity("example.jamfcloud.com", "jamfpro_macos_pppc_profile.zoom", PPPCPayloadType)
+
+	assert.Equal(t, identity, DeterministicPayloadIdentity("example.jamfcloud.com", "jamfpro_macos_pppc_profile.zoom", PPPCPayloadType))
+	assert.Equal(t, identity.RootUUID, identity.RootIdentifier)
+	assert.Equal(t, PPPCPayloadType+"."+identity.PayloadUUID, identity.PayloadIdentifier)
+}
+
+func TestApplyDeterministicPayloadUUIDsToPlist(t *testing.T) {
+	profile := func(rootUUID string) string {
+		return `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0"><dict>
+<key>PayloadContent</key><array><dict><key>PayloadType</key><string>com.example</string><key>PayloadUUID</key><string></string></dict></array>
+<key>PayloadIdentifier</key><string>` + rootUUID + `</string>
+<key>PayloadUUID</key><string>` + rootUUID + `</string>
+</dict></plist>`
+	}
+
+	fqdn, key := "example.jamfcloud.com", "jamfpro_macos_configuration_profile_plist_generator.example"
+
+	first, err := ApplyDeterministicPayloadUUIDsToPlist(profile("11111111-1111-1111-1111-111111111111"), fqdn, key)
+	assert.NoError(t, err)
+	second, err := ApplyDeterministicPayloadUUIDsToPlist(profile("22222222-2222-2222-2222-222222222222"), fqdn, key)
+	assert.NoError(t, err)
+
+	assert.Equal(t, first, second, "random root UUIDs should be replaced by the same derived values")
+	assert.Contains(t, first, DeterministicPayloadUUID(fqdn, key, 0))
+	assert.Contains(t, first, DeterministicPayloadUUID(fqdn, key, 1))
+}
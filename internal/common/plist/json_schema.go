@@ -0,0 +1,245 @@
+// common/configurationprofiles/plist/json_schema.go
+// Description: This file contains a validator for preference values against the JSON schema
+// manifests used by Jamf Pro's "Application & Custom Settings" payload.
+package plist
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
+// jamfSchema is the subset of JSON Schema used by Jamf Pro custom settings manifests.
+type jamfSchema struct {
+	Title                string                 `json:"title"`
+	Type                 any                    `json:"type"`
+	Properties           map[string]*jamfSchema `json:"properties"`
+	Required             []string               `json:"required"`
+	AdditionalProperties *bool                  `json:"additionalProperties"`
+	Items                *jamfSchema            `json:"items"`
+	Enum                 []any                  `json:"enum"`
+	AnyOf                []*jamfSchema          `json:"anyOf"`
+	OneOf                []*jamfSchema          `json:"oneOf"`
+	Minimum              *float64               `json:"minimum"`
+	Maximum              *float64               `json:"maximum"`
+	MinLength            *int                   `json:"minLength"`
+	MaxLength            *int                   `json:"maxLength"`
+	MinItems             *int                   `json:"minItems"`
+	MaxItems             *int                   `json:"maxItems"`
+	Pattern              string                 `json:"pattern"`
+}
+
+// parseJamfJSONSchema parses a Jamf JSON schema manifest. The manifest must describe an object.
+func parseJamfJSONSchema(manifest string) (*jamfSchema, error) {
+	var s jamfSchema
+	if err := json.Unmarshal([]byte(manifest), &s); err != nil {
+		return nil, fmt.Errorf("invalid JSON schema manifest: %v", err)
+	}
+	if types := s.types(); len(types) > 0 && !slices.Contains(types, "object") {
+		return nil, fmt.Errorf("JSON schema manifest must describe an object, got type %v", types)
+	}
+	return &s, nil
+}
+
+// ValidateAgainstJamfJSONSchema validates preference settings, as decoded by DecodeJSONValue or
+// DecodePlist, against a Jamf JSON schema manifest. Every violation is returned, ordered by path.
+func ValidateAgainstJamfJSONSchema(settings map[string]any, manifest string) []error {
+	s, err := parseJamfJSONSchema(manifest)
+	if err != nil {
+		return []error{err}
+	}
+
+	var violations []string
+	s.validate("", settings, &violations)
+	sort.Strings(violations)
+
+	errs := make([]error, 0, len(violations))
+	for _, v := range violations {
+		errs = append(errs, fmt.Errorf("%s", v))
+	}
+	return errs
+}
+
+// types returns the schema 'type' keyword as a list, supporting both string and array forms.
+func (s *jamfSchema) types() []string {
+	switch t := s.Type.(type) {
+	case string:
+		return []string{t}
+	case []any:
+		out := make([]string, 0, len(t))
+		for _, v := range t {
+			if str, ok := v.(string); ok {
+				out = append(out, str)
+			}
+		}
+		return out
+	}
+	return nil
+}
+
+// validate appends a violation for every way value fails to satisfy s.
+func (s *jamfSchema) validate(path string, value any, violations *[]string) {
+	label := path
+	if label == "" {
+		label = "settings"
+	}
+	add := func(format string, args ...any) {
+		*violations = append(*violations, fmt.Sprintf("%s: %s", label, fmt.Sprintf(format, args...)))
+	}
+
+	if len(s.AnyOf) > 0 && !s.matchesAny(path, value, s.AnyOf) {
+		add("does not match any of the allowed schemas")
+	}
+	if len(s.OneOf) > 0 && !s.matchesAny(path, value, s.OneOf) {
+		add("does not match any of the allowed schemas")
+	}
+
+	if types := s.types(); len(types) > 0 && !slices.ContainsFunc(types, func(t string) bool { return valueMatchesSchemaType(value, t) }) {
+		add("expected %s, got %s", strings.Join(types, " or "), TypeOfValue(value))
+		return
+	}
+
+	if len(s.Enum) > 0 && !slices.ContainsFunc(s.Enum, func(e any) bool { return schemaValuesEqual(e, value) }) {
+		add("value %v is not one of %v", FormatScalarValue(value), s.Enum)
+	}
+
+	switch v := value.(type) {
+	case map[string]any:
+		for _, key := range s.Required {
+			if _, ok := v[key]; !ok {
+				add("missing required key '%s'", key)
+			}
+		}
+		for _, key := range sortedKeys(v) {
+			child, ok := s.Properties[key]
+			if !ok {
+				if s.AdditionalProperties != nil && !*s.AdditionalProperties {
+					add("key '%s' is not defined in the schema", key)
+				}
+				continue
+			}
+			child.validate(joinSchemaPath(path, key), v[key], violations)
+		}
+	case []any:
+		if s.MinItems != nil && len(v) < *s.MinItems {
+			add("must contain at least %d items", *s.MinItems)
+		}
+		if s.MaxItems != nil && len(v) > *s.MaxItems {
+			add("must contain at most %d items", *s.MaxItems)
+		}
+		if s.Items != nil {
+			for i, item := range v {
+				s.Items.validate(fmt.Sprintf("%s[%d]", label, i), item, violations)
+			}
+		}
+	case string:
+		if s.MinLength != nil && len(v) < *s.MinLength {
+			add("must be at least %d characters", *s.MinLength)
+		}
+		if s.MaxLength != nil && len(v) > *s.MaxLength {
+			add("must be at most %d characters", *s.MaxLength)
+		}
+		if s.Pattern != "" {
+			re, err := regexp.Compile(s.Pattern)
+			if err != nil {
+				add("schema pattern '%s' is invalid: %v", s.Pattern, err)
+			} else if !re.MatchString(v) {
+				add("value '%s' does not match pattern '%s'", v, s.Pattern)
+			}
+		}
+	default:
+		if n, ok := schemaNumber(value); ok {
+			if s.Minimum != nil && n < *s.Minimum {
+				add("value %v is less than the minimum %v", FormatScalarValue(value), *s.Minimum)
+			}
+			if s.Maximum != nil && n > *s.Maximum {
+				add("value %v is greater than the maximum %v", FormatScalarValue(value), *s.Maximum)
+			}
+		}
+	}
+}
+
+// matchesAny reports whether value satisfies at least one of the given schemas.
+func (s *jamfSchema) matchesAny(path string, value any, schemas []*jamfSchema) bool {
+	for _, candidate := range schemas {
+		var v []string
+		candidate.validate(path, value, &v)
+		if len(v) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// valueMatchesSchemaType reports whether a decoded plist value satisfies a JSON schema type name.
+func valueMatchesSchemaType(value any, schemaType string) bool {
+	switch schemaType {
+	case "object":
+		_, ok := value.(map[string]any)
+		return ok
+	case "array":
+		_, ok := value.([]any)
+		return ok
+	case "string":
+		switch value.(type) {
+		case string, time.Time, []byte:
+			return true
+		}
+		return false
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "integer":
+		return TypeOfValue(value) == ValueTypeInteger
+	case "number":
+		_, ok := schemaNumber(value)
+		return ok
+	case "null":
+		return value == nil
+	default:
+		return true
+	}
+}
+
+// schemaNumber returns value as a float64 when it is a plist integer or real.
+func schemaNumber(value any) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint:
+		return float64(v), true
+	case uint32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+	return 0, false
+}
+
+// schemaValuesEqual compares an 'enum' member decoded from JSON with a plist value.
+func schemaValuesEqual(enumValue any, value any) bool {
+	if a, ok := schemaNumber(enumValue); ok {
+		b, ok := schemaNumber(value)
+		return ok && a == b
+	}
+	return fmt.Sprintf("%v", enumValue) == FormatScalarValue(value) && TypeOfValue(enumValue) == TypeOfValue(value)
+}
+
+func joinSchemaPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
@@ -0,0 +1,127 @@
+// common/configurationprofiles/plist/managed_preferences.go
+// Description: This file contains helpers for the com.apple.ManagedClient.preferences payload used by
+// Jamf Pro's "Application & Custom Settings" to manage an application's preference domain.
+package plist
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// ManagedPreferencesPayloadType is the PayloadType of a custom settings (managed preferences) payload.
+const ManagedPreferencesPayloadType = "com.apple.ManagedClient.preferences"
+
+// BuildManagedPreferences renders the payload content that forces settings for a preference domain.
+func BuildManagedPreferences(domain string, settings map[string]any) map[string]any {
+	return map[string]any{
+		domain: map[string]any{
+			"Forced": []any{
+				map[string]any{"mcx_preference_settings": settings},
+			},
+		},
+	}
+}
+
+// ParseManagedPreferences reads the preference domain and forced settings from a decoded
+// com.apple.ManagedClient.preferences payload. Payloads managing more than one domain are rejected.
+func ParseManagedPreferences(payload map[string]any) (string, map[string]any, error) {
+	var domains []string
+	for key := range payload {
+		if !strings.HasPrefix(key, "Payload") {
+			domains = append(domains, key)
+		}
+	}
+	if len(domains) != 1 {
+		return "", nil, fmt.Errorf("expected exactly one preference domain in %s payload, found %d", ManagedPreferencesPayloadType, len(domains))
+	}
+
+	domain := domains[0]
+	domainDict, ok := payload[domain].(map[string]any)
+	if !ok {
+		return "", nil, fmt.Errorf("preference domain '%s' is not a dictionary", domain)
+	}
+
+	forced, _ := domainDict["Forced"].([]any)
+	if len(forced) == 0 {
+		return domain, map[string]any{}, nil
+	}
+
+	entry, ok := forced[0].(map[string]any)
+	if !ok {
+		return "", nil, fmt.Errorf("preference domain '%s' has an invalid 'Forced' entry", domain)
+	}
+	settings, _ := entry["mcx_preference_settings"].(map[string]any)
+	if settings == nil {
+		settings = map[string]any{}
+	}
+
+	return domain, settings, nil
+}
+
+// LoadSettingsFile reads preference settings from a .plist or .json file. JSON files may use the
+// "$date", "$data" and "$real" markers supported by DecodeJSONValue.
+func LoadSettingsFile(path string) (map[string]any, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read settings file '%s': %v", path, err)
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".plist":
+		settings, err := DecodePlist(content)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode plist settings file '%s': %v", path, err)
+		}
+		return settings, nil
+	case ".json":
+		decoded, err := DecodeJSONValue(string(content))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode JSON settings file '%s': %v", path, err)
+		}
+		settings, ok := decoded.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("JSON settings file '%s' must contain an object", path)
+		}
+		return settings, nil
+	default:
+		return nil, fmt.Errorf("settings file '%s' must have a .plist or .json extension", path)
+	}
+}
+
+// CanonicalizeValue converts a decoded plist or JSON value into a canonical form so values decoded
+// from different sources can be compared: integers become int64, reals float64 and dates UTC.
+func CanonicalizeValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for key, item := range v {
+			out[key] = CanonicalizeValue(item)
+		}
+		return out
+	case []any:
+		out := make([]any, len(v))
+		for i, item := range v {
+			out[i] = CanonicalizeValue(item)
+		}
+		return out
+	case int:
+		return int64(v)
+	case int32:
+		return int64(v)
+	case uint:
+		return int64(v)
+	case uint32:
+		return int64(v)
+	case uint64:
+		return int64(v)
+	case float32:
+		return float64(v)
+	case time.Time:
+		return v.UTC()
+	default:
+		return v
+	}
+}
@@ -0,0 +1,108 @@
+package plist
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSchemaManifest = `{
+	"title": "Example",
+	"properties": {
+		"HomepageLocation": {"type": "string", "pattern": "^https://"},
+		"IncognitoModeAvailability": {"type": "integer", "enum": [0, 1, 2]},
+		"PasswordManagerEnabled": {"type": "boolean"},
+		"ZoomFactor": {"type": "number", "minimum": 0.25, "maximum": 5},
+		"URLBlocklist": {"type": "array", "items": {"type": "string"}, "maxItems": 2},
+		"Proxy": {"type": "object", "required": ["Mode"], "properties": {"Mode": {"type": "string", "enum": ["direct", "system"]}}}
+	}
+}`
+
+func TestValidateAgainstJamfJSONSchema(t *testing.T) {
+	valid := map[string]any{
+		"HomepageLocation":          "https://example.com",
+		"IncognitoModeAvailability": int64(1),
+		"PasswordManagerEnabled":    false,
+		"ZoomFactor":                1.5,
+		"URLBlocklist":              []any{"example.org"},
+		"Proxy":                     map[string]any{"Mode": "system"},
+		"Unlisted":                  "ignored",
+	}
+	assert.Empty(t, ValidateAgainstJamfJSONSchema(valid, testSchemaManifest))
+
+	invalid := map[string]any{
+		"HomepageLocation":          "http://example.com",
+		"IncognitoModeAvailability": int64(3),
+		"PasswordManagerEnabled":    "yes",
+		"ZoomFactor":                int64(10),
+		"URLBlocklist":              []any{"a", "b", int64(3)},
+		"Proxy":                     map[string]any{},
+	}
+	errs := ValidateAgainstJamfJSONSchema(invalid, testSchemaManifest)
+	var messages []string
+	for _, err := range errs {
+		messages = append(messages, err.Error())
+	}
+	assert.Equal(t, []string{
+		"HomepageLocation: value 'http://example.com' does not match pattern '^https://'",
+		"IncognitoModeAvailability: value 3 is not one of [0 1 2]",
+		"PasswordManagerEnabled: expected boolean, got string",
+		"Proxy: missing required key 'Mode'",
+		"URLBlocklist: must contain at most 2 items",
+		"URLBlocklist[2]: expected string, got integer",
+		"ZoomFactor: value 10 is greater than the maximum 5",
+	}, messages)
+
+	assert.Len(t, ValidateAgainstJamfJSONSchema(valid, `{"type": "array"}`), 1)
+	assert.Len(t, ValidateAgainstJamfJSONSchema(valid, `not json`), 1)
+}
+
+func TestManagedPreferencesRoundTrip(t *testing.T) {
+	settings := map[string]any{"HomepageLocation": "https://example.com", "RestoreOnStartup": int64(4)}
+
+	xml, err := RenderSinglePayloadProfile(SinglePayloadProfile{
+		DisplayName:    "Chrome",
+		Scope:          "System",
+		PayloadType:    ManagedPreferencesPayloadType,
+		PayloadContent: BuildManagedPreferences("com.google.Chrome", settings),
+		Identity:       NewPayloadIdentity(ManagedPreferencesPayloadType),
+	})
+	require.NoError(t, err)
+
+	profile, err := DecodePlist([]byte(xml))
+	require.NoError(t, err)
+	payload, err := FindPayloadOfType(profile, ManagedPreferencesPayloadType)
+	require.NoError(t, err)
+
+	domain, got, err := ParseManagedPreferences(payload)
+	require.NoError(t, err)
+	assert.Equal(t, "com.google.Chrome", domain)
+	assert.Equal(t, settings, CanonicalizeValue(got))
+
+	identity, err := ExtractPayloadIdentity(xml, ManagedPreferencesPayloadType)
+	require.NoError(t, err)
+	assert.Equal(t, profile["PayloadUUID"], identity.RootUUID)
+	assert.Equal(t, payload["PayloadIdentifier"], identity.PayloadIdentifier)
+}
+
+func TestLoadSettingsFile(t *testing.T) {
+	dir := t.TempDir()
+
+	jsonPath := filepath.Join(dir, "settings.json")
+	require.NoError(t, os.WriteFile(jsonPath, []byte(`{"Enabled": true, "Count": 2}`), 0o600))
+	plistPath := filepath.Join(dir, "settings.plist")
+	require.NoError(t, os.WriteFile(plistPath, []byte(`<?xml version="1.0" encoding="UTF-8"?>
+<plist version="1.0"><dict><key>Enabled</key><true/><key>Count</key><integer>2</integer></dict></plist>`), 0o600))
+
+	fromJSON, err := LoadSettingsFile(jsonPath)
+	require.NoError(t, err)
+	fromPlist, err := LoadSettingsFile(plistPath)
+	require.NoError(t, err)
+	assert.Equal(t, CanonicalizeValue(fromJSON), CanonicalizeValue(fromPlist))
+
+	_, err = LoadSettingsFile(filepath.Join(dir, "settings.yaml"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,216 @@
+// common/configurationprofiles/plist/pppc.go
+// Description: This file contains the Privacy Preferences Policy Control (PPPC) payload model used to
+// render and parse com.apple.TCC.configuration-profile-policy payloads.
+package plist
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+)
+
+// PPPCPayloadType is the PayloadType of a Privacy Preferences Policy Control payload.
+const PPPCPayloadType = "com.apple.TCC.configuration-profile-policy"
+
+// PPPC identifier types.
+const (
+	PPPCIdentifierTypeBundleID = "bundleID"
+	PPPCIdentifierTypePath     = "path"
+)
+
+// PPPC authorization values.
+const (
+	PPPCAuthorizationAllow                  = "Allow"
+	PPPCAuthorizationDeny                   = "Deny"
+	PPPCAuthorizationAllowStandardUserToSet = "AllowStandardUserToSetSystemService"
+)
+
+// PPPCServiceAppleEvents is the only service that accepts AppleEvents receiver keys.
+const PPPCServiceAppleEvents = "AppleEvents"
+
+// pppcServiceAuthorizations lists each TCC service supported in PPPC payloads together with the
+// authorization values Apple allows for it.
+var pppcServiceAuthorizations = map[string][]string{
+	"Accessibility":                {PPPCAuthorizationAllow, PPPCAuthorizationDeny},
+	"AddressBook":                  {PPPCAuthorizationAllow, PPPCAuthorizationDeny},
+	PPPCServiceAppleEvents:         {PPPCAuthorizationAllow, PPPCAuthorizationDeny},
+	"BluetoothAlways":              {PPPCAuthorizationAllow, PPPCAuthorizationDeny},
+	"Calendar":                     {PPPCAuthorizationAllow, PPPCAuthorizationDeny},
+	"Camera":                       {PPPCAuthorizationDeny},
+	"FileProviderPresence":         {PPPCAuthorizationAllow, PPPCAuthorizationDeny},
+	"ListenEvent":                  {PPPCAuthorizationDeny, PPPCAuthorizationAllowStandardUserToSet},
+	"MediaLibrary":                 {PPPCAuthorizationAllow, PPPCAuthorizationDeny},
+	"Microphone":                   {PPPCAuthorizationDeny},
+	"Photos":                       {PPPCAuthorizationAllow, PPPCAuthorizationDeny},
+	"PostEvent":                    {PPPCAuthorizationAllow, PPPCAuthorizationDeny},
+	"Reminders":                    {PPPCAuthorizationAllow, PPPCAuthorizationDeny},
+	"ScreenCapture":                {PPPCAuthorizationDeny, PPPCAuthorizationAllowStandardUserToSet},
+	"SpeechRecognition":            {PPPCAuthorizationAllow, PPPCAuthorizationDeny},
+	"SystemPolicyAllFiles":         {PPPCAuthorizationAllow, PPPCAuthorizationDeny},
+	"SystemPolicyAppBundles":       {PPPCAuthorizationAllow, PPPCAuthorizationDeny},
+	"SystemPolicyAppData":          {PPPCAuthorizationAllow, PPPCAuthorizationDeny},
+	"SystemPolicyDesktopFolder":    {PPPCAuthorizationAllow, PPPCAuthorizationDeny},
+	"SystemPolicyDocumentsFolder":  {PPPCAuthorizationAllow, PPPCAuthorizationDeny},
+	"SystemPolicyDownloadsFolder":  {PPPCAuthorizationAllow, PPPCAuthorizationDeny},
+	"SystemPolicyNetworkVolumes":   {PPPCAuthorizationAllow, PPPCAuthorizationDeny},
+	"SystemPolicyRemovableVolumes": {PPPCAuthorizationAllow, PPPCAuthorizationDeny},
+	"SystemPolicySysAdminFiles":    {PPPCAuthorizationAllow, PPPCAuthorizationDeny},
+}
+
+// PPPCEntry is a single application or binary entry within a PPPC service.
+type PPPCEntry struct {
+	Service         string
+	Identifier      string
+	IdentifierType  string
+	CodeRequirement string
+	Authorization   string
+	StaticCode      bool
+	Comment         string
+	// AppleEvents receiver, only valid for the AppleEvents service
+	AEReceiverIdentifier      string
+	AEReceiverIdentifierType  string
+	AEReceiverCodeRequirement string
+}
+
+// PPPCServices returns the supported TCC service names in alphabetical order.
+func PPPCServices() []string {
+	services := make([]string, 0, len(pppcServiceAuthorizations))
+	for service := range pppcServiceAuthorizations {
+		services = append(services, service)
+	}
+	sort.Strings(services)
+	return services
+}
+
+// PPPCAuthorizations returns every authorization value accepted by PPPC payloads.
+func PPPCAuthorizations() []string {
+	return []string{PPPCAuthorizationAllow, PPPCAuthorizationDeny, PPPCAuthorizationAllowStandardUserToSet}
+}
+
+// PPPCIdentifierTypes returns the accepted identifier types.
+func PPPCIdentifierTypes() []string {
+	return []string{PPPCIdentifierTypeBundleID, PPPCIdentifierTypePath}
+}
+
+// ValidatePPPCEntry validates a PPPC entry against Apple's per service rules and checks the syntax
+// of its code requirements.
+func ValidatePPPCEntry(entry PPPCEntry) error {
+	allowed, ok := pppcServiceAuthorizations[entry.Service]
+	if !ok {
+		return fmt.Errorf("unsupported service '%s'", entry.Service)
+	}
+
+	if !slices.Contains(allowed, entry.Authorization) {
+		return fmt.Errorf("service '%s' does not support authorization '%s'; supported values are %v", entry.Service, entry.Authorization, allowed)
+	}
+
+	if err := ValidateCodeRequirement(entry.CodeRequirement); err != nil {
+		return fmt.Errorf("service '%s' identifier '%s': %v", entry.Service, entry.Identifier, err)
+	}
+
+	hasReceiver := entry.AEReceiverIdentifier != "" || entry.AEReceiverIdentifierType != "" || entry.AEReceiverCodeRequirement != ""
+	if entry.Service != PPPCServiceAppleEvents {
+		if hasReceiver {
+			return fmt.Errorf("service '%s' identifier '%s': an apple events receiver is only valid for the '%s' service", entry.Service, entry.Identifier, PPPCServiceAppleEvents)
+		}
+		return nil
+	}
+
+	if entry.AEReceiverIdentifier == "" || entry.AEReceiverIdentifierType == "" || entry.AEReceiverCodeRequirement == "" {
+		return fmt.Errorf("service '%s' identifier '%s': an apple events receiver with identifier, identifier type and code requirement is required", entry.Service, entry.Identifier)
+	}
+
+	if err := ValidateCodeRequirement(entry.AEReceiverCodeRequirement); err != nil {
+		return fmt.Errorf("service '%s' identifier '%s' apple events receiver '%s': %v", entry.Service, entry.Identifier, entry.AEReceiverIdentifier, err)
+	}
+
+	return nil
+}
+
+// BuildPPPCServices renders PPPC entries into the 'Services' dictionary of a PPPC payload. Entries
+// are grouped by service and ordered by identifier so the rendered plist is deterministic.
+func BuildPPPCServices(entries []PPPCEntry) map[string]any {
+	sorted := slices.Clone(entries)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Identifier != sorted[j].Identifier {
+			return sorted[i].Identifier < sorted[j].Identifier
+		}
+		return sorted[i].AEReceiverIdentifier < sorted[j].AEReceiverIdentifier
+	})
+
+	services := make(map[string]any)
+	for _, entry := range sorted {
+		item := map[string]any{
+			"Identifier":      entry.Identifier,
+			"IdentifierType":  entry.IdentifierType,
+			"CodeRequirement": entry.CodeRequirement,
+			"Authorization":   entry.Authorization,
+			"StaticCode":      entry.StaticCode,
+		}
+		if entry.Comment != "" {
+			item["Comment"] = entry.Comment
+		}
+		if entry.Service == PPPCServiceAppleEvents {
+			item["AEReceiverIdentifier"] = entry.AEReceiverIdentifier
+			item["AEReceiverIdentifierType"] = entry.AEReceiverIdentifierType
+			item["AEReceiverCodeRequirement"] = entry.AEReceiverCodeRequirement
+		}
+
+		existing, _ := services[entry.Service].([]any)
+		services[entry.Service] = append(existing, item)
+	}
+
+	return services
+}
+
+// ParsePPPCServices reads the 'Services' dictionary of a PPPC payload back into entries. Legacy
+// entries using the boolean 'Allowed' key are mapped to the equivalent 'Authorization' value.
+func ParsePPPCServices(services map[string]any) ([]PPPCEntry, error) {
+	var entries []PPPCEntry
+
+	for _, service := range sortedKeys(services) {
+		items, ok := services[service].([]any)
+		if !ok {
+			return nil, fmt.Errorf("service '%s' is not an array", service)
+		}
+
+		for i, raw := range items {
+			item, ok := raw.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("service '%s' entry %d is not a dictionary", service, i)
+			}
+
+			entry := PPPCEntry{
+				Service:                   service,
+				Identifier:                stringValue(item["Identifier"]),
+				IdentifierType:            stringValue(item["IdentifierType"]),
+				CodeRequirement:           stringValue(item["CodeRequirement"]),
+				Authorization:             stringValue(item["Authorization"]),
+				Comment:                   stringValue(item["Comment"]),
+				AEReceiverIdentifier:      stringValue(item["AEReceiverIdentifier"]),
+				AEReceiverIdentifierType:  stringValue(item["AEReceiverIdentifierType"]),
+				AEReceiverCodeRequirement: stringValue(item["AEReceiverCodeRequirement"]),
+			}
+			entry.StaticCode, _ = item["StaticCode"].(bool)
+
+			if entry.Authorization == "" {
+				if allowed, ok := item["Allowed"].(bool); ok {
+					entry.Authorization = PPPCAuthorizationDeny
+					if allowed {
+						entry.Authorization = PPPCAuthorizationAllow
+					}
+				}
+			}
+
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries, nil
+}
+
+// stringValue returns value as a string, or an empty string when it is absent or not a string.
+func stringValue(value any) string {
+	s, _ := value.(string)
+	return s
+}
@@ -0,0 +1,136 @@
+package plist
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateCodeRequirement(t *testing.T) {
+	tests := []struct {
+		name        string
+		requirement string
+		wantErr     bool
+	}{
+		{name: "Identifier and apple generic anchor", requirement: `identifier "com.example.app" and anchor apple generic`},
+		{name: "Unquoted identifier", requirement: `identifier com.apple.Terminal and anchor apple`},
+		{name: "Developer ID requirement", requirement: `anchor apple generic and identifier "com.microsoft.teams2" and (certificate leaf[field.1.2.840.113635.100.6.1.9] /* exists */ or certificate 1[field.1.2.840.113635.100.6.2.6] /* exists */ and certificate leaf[field.1.2.840.113635.100.6.1.13] /* exists */ and certificate leaf[subject.OU] = UBF8T346G9)`},
+		{name: "Negation and info key", requirement: `!(info[CFBundleVersion] < "2.0") and entitlement["com.apple.security.app-sandbox"] exists`},
+		{name: "Anchor hash", requirement: `anchor = H"0123456789abcdef0123456789abcdef01234567"`},
+		{name: "Certificate trusted and cdhash", requirement: `certificate root trusted or cdhash H"abcdef"`},
+		{name: "Platform and notarized", requirement: `platform = 1 and notarized`},
+		{name: "Empty requirement", requirement: "  ", wantErr: true},
+		{name: "Unknown clause", requirement: `identifer "com.example.app"`, wantErr: true},
+		{name: "Dangling and", requirement: `identifier "com.example.app" and`, wantErr: true},
+		{name: "Unbalanced parentheses", requirement: `(anchor apple generic`, wantErr: true},
+		{name: "Unterminated string", requirement: `identifier "com.example.app`, wantErr: true},
+		{name: "Missing comparison value", requirement: `certificate leaf[subject.OU] =`, wantErr: true},
+		{name: "Missing certificate slot", requirement: `certificate [subject.OU] = ABC`, wantErr: true},
+		{name: "Invalid hash", requirement: `cdhash H"xyz"`, wantErr: true},
+		{name: "Unterminated comment", requirement: `anchor apple /* generic`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateCodeRequirement(tt.requirement)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidatePPPCEntry(t *testing.T) {
+	base := PPPCEntry{
+		Identifier:      "com.example.app",
+		IdentifierType:  PPPCIdentifierTypeBundleID,
+		CodeRequirement: `identifier "com.example.app" and anchor apple generic`,
+	}
+
+	allow := base
+	allow.Service, allow.Authorization = "SystemPolicyAllFiles", PPPCAuthorizationAllow
+	assert.NoError(t, ValidatePPPCEntry(allow))
+
+	camera := base
+	camera.Service, camera.Authorization = "Camera", PPPCAuthorizationAllow
+	assert.Error(t, ValidatePPPCEntry(camera))
+
+	screen := base
+	screen.Service, screen.Authorization = "ScreenCapture", PPPCAuthorizationAllowStandardUserToSet
+	assert.NoError(t, ValidatePPPCEntry(screen))
+
+	events := base
+	events.Service, events.Authorization = PPPCServiceAppleEvents, PPPCAuthorizationAllow
+	assert.Error(t, ValidatePPPCEntry(events), "apple events without a receiver")
+
+	events.AEReceiverIdentifier = "com.apple.systemevents"
+	events.AEReceiverIdentifierType = PPPCIdentifierTypeBundleID
+	events.AEReceiverCodeRequirement = `identifier "com.apple.systemevents" and anchor apple`
+	assert.NoError(t, ValidatePPPCEntry(events))
+
+	receiver := allow
+	receiver.AEReceiverIdentifier = "com.apple.finder"
+	assert.Error(t, ValidatePPPCEntry(receiver), "receiver on a non apple events service")
+}
+
+func TestPPPCServicesRoundTrip(t *testing.T) {
+	entries := []PPPCEntry{
+		{
+			Service:         "SystemPolicyAllFiles",
+			Identifier:      "com.example.b",
+			IdentifierType:  PPPCIdentifierTypeBundleID,
+			CodeRequirement: `identifier "com.example.b" and anchor apple generic`,
+			Authorization:   PPPCAuthorizationAllow,
+		},
+		{
+			Service:         "SystemPolicyAllFiles",
+			Identifier:      "/usr/local/bin/a",
+			IdentifierType:  PPPCIdentifierTypePath,
+			CodeRequirement: `identifier a and anchor apple generic`,
+			Authorization:   PPPCAuthorizationDeny,
+			Comment:         "blocked",
+		},
+		{
+			Service:                   PPPCServiceAppleEvents,
+			Identifier:                "com.example.b",
+			IdentifierType:            PPPCIdentifierTypeBundleID,
+			CodeRequirement:           `identifier "com.example.b" and anchor apple generic`,
+			Authorization:             PPPCAuthorizationAllow,
+			AEReceiverIdentifier:      "com.apple.systemevents",
+			AEReceiverIdentifierType:  PPPCIdentifierTypeBundleID,
+			AEReceiverCodeRequirement: `identifier "com.apple.systemevents" and anchor apple`,
+		},
+	}
+
+	services := BuildPPPCServices(entries)
+	require.Len(t, services["SystemPolicyAllFiles"], 2)
+	first := services["SystemPolicyAllFiles"].([]any)[0].(map[string]any)
+	assert.Equal(t, "/usr/local/bin/a", first["Identifier"])
+
+	xml, err := MarshalPayload(&ConfigurationProfile{
+		PayloadType:    "Configuration",
+		PayloadContent: []PayloadContent{{PayloadType: PPPCPayloadType, ConfigurationItems: map[string]any{"Services": services}}},
+	})
+	require.NoError(t, err)
+
+	profile, err := UnmarshalPayload(xml)
+	require.NoError(t, err)
+
+	parsed, err := ParsePPPCServices(profile.PayloadContent[0].ConfigurationItems["Services"].(map[string]any))
+	require.NoError(t, err)
+	assert.ElementsMatch(t, entries, parsed)
+}
+
+func TestParsePPPCServicesLegacyAllowed(t *testing.T) {
+	parsed, err := ParsePPPCServices(map[string]any{
+		"Accessibility": []any{map[string]any{"Identifier": "com.example.app", "Allowed": true}},
+		"Photos":        []any{map[string]any{"Identifier": "com.example.app", "Allowed": false}},
+	})
+	require.NoError(t, err)
+	require.Len(t, parsed, 2)
+	assert.Equal(t, PPPCAuthorizationAllow, parsed[0].Authorization)
+	assert.Equal(t, PPPCAuthorizationDeny, parsed[1].Authorization)
+}
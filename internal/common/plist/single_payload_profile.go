@@ -0,0 +1,131 @@
+// common/configurationprofiles/plist/single_payload_profile.go
+// Description: This file contains helpers for rendering and reading configuration profiles that
+// wrap a single typed payload, such as the PPPC and custom settings profile resources.
+package plist
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"howett.net/plist"
+)
+
+// PayloadIdentity holds the PayloadUUID and PayloadIdentifier of a profile and of its single payload.
+type PayloadIdentity struct {
+	RootUUID          string
+	RootIdentifier    string
+	PayloadUUID       string
+	PayloadIdentifier string
+}
+
+// SinglePayloadProfile describes a configuration profile that wraps exactly one payload.
+type SinglePayloadProfile struct {
+	DisplayName        string
+	Description        string
+	Organization       string
+	Scope              string
+	RemovalDisallowed  bool
+	PayloadDisplayName string
+	PayloadType        string
+	PayloadContent     map[string]any
+	Identity           PayloadIdentity
+}
+
+// NewPayloadIdentity generates fresh identifiers for a profile that does not yet exist in Jamf Pro.
+// The payload identifier is the payload type suffixed with the payload UUID.
+func NewPayloadIdentity(payloadType string) PayloadIdentity {
+	rootUUID := uuid.New().String()
+	payloadUUID := uuid.New().String()
+
+	return PayloadIdentity{
+		RootUUID:          rootUUID,
+		RootIdentifier:    rootUUID,
+		PayloadUUID:       payloadUUID,
+		PayloadIdentifier: fmt.Sprintf("%s.%s", payloadType, payloadUUID),
+	}
+}
+
+// ExtractPayloadIdentity reads the root and payload identifiers from a profile plist stored in Jamf
+// Pro, so an update modifies the installed profile rather than replacing it.
+func ExtractPayloadIdentity(payloads string, payloadType string) (PayloadIdentity, error) {
+	profile, err := DecodePlist([]byte(payloads))
+	if err != nil {
+		return PayloadIdentity{}, fmt.Errorf("failed to decode existing profile plist: %v", err)
+	}
+
+	identity := PayloadIdentity{}
+	identity.RootUUID, _ = profile["PayloadUUID"].(string)
+	identity.RootIdentifier, _ = profile["PayloadIdentifier"].(string)
+
+	payload, err := FindPayloadOfType(profile, payloadType)
+	if err != nil {
+		return PayloadIdentity{}, err
+	}
+	identity.PayloadUUID, _ = payload["PayloadUUID"].(string)
+	identity.PayloadIdentifier, _ = payload["PayloadIdentifier"].(string)
+
+	if identity.RootUUID == "" || identity.PayloadUUID == "" {
+		return PayloadIdentity{}, fmt.Errorf("existing profile plist is missing PayloadUUID values")
+	}
+	if identity.RootIdentifier == "" {
+		identity.RootIdentifier = identity.RootUUID
+	}
+	if identity.PayloadIdentifier == "" {
+		identity.PayloadIdentifier = fmt.Sprintf("%s.%s", payloadType, identity.PayloadUUID)
+	}
+
+	return identity, nil
+}
+
+// FindPayloadOfType returns the first payload of payloadType within a decoded profile.
+func FindPayloadOfType(profile map[string]any, payloadType string) (map[string]any, error) {
+	contents, _ := profile["PayloadContent"].([]any)
+	for _, c := range contents {
+		payload, ok := c.(map[string]any)
+		if !ok {
+			continue
+		}
+		if t, _ := payload["PayloadType"].(string); t == payloadType {
+			return payload, nil
+		}
+	}
+
+	return nil, fmt.Errorf("profile does not contain a %s payload", payloadType)
+}
+
+// RenderSinglePayloadProfile renders a complete configuration profile plist holding one payload.
+// PayloadContent keys are merged into the payload dictionary alongside the standard Payload keys.
+func RenderSinglePayloadProfile(p SinglePayloadProfile) (string, error) {
+	payload := make(map[string]any, len(p.PayloadContent)+7)
+	for k, v := range p.PayloadContent {
+		payload[k] = v
+	}
+	payload["PayloadDisplayName"] = p.PayloadDisplayName
+	payload["PayloadEnabled"] = true
+	payload["PayloadIdentifier"] = p.Identity.PayloadIdentifier
+	payload["PayloadOrganization"] = p.Organization
+	payload["PayloadType"] = p.PayloadType
+	payload["PayloadUUID"] = p.Identity.PayloadUUID
+	payload["PayloadVersion"] = 1
+
+	profile := map[string]any{
+		"PayloadContent":           []any{payload},
+		"PayloadDescription":       p.Description,
+		"PayloadDisplayName":       p.DisplayName,
+		"PayloadEnabled":           true,
+		"PayloadIdentifier":        p.Identity.RootIdentifier,
+		"PayloadOrganization":      p.Organization,
+		"PayloadRemovalDisallowed": p.RemovalDisallowed,
+		"PayloadScope":             p.Scope,
+		"PayloadType":              "Configuration",
+		"PayloadUUID":              p.Identity.RootUUID,
+		"PayloadVersion":           1,
+	}
+
+	out, err := plist.MarshalIndent(profile, plist.XMLFormat, "\t")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal %s profile plist: %v", p.PayloadType, err)
+	}
+
+	return string(out), nil
+}
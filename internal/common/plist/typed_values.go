@@ -0,0 +1,292 @@
+// common/configurationprofiles/plist/typed_values.go
+// Description: This file contains the typed value model used by the plist generator to express
+// strings, numbers, booleans, dates, data, dictionaries and arrays in HCL.
+package plist
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Supported plist value types for the generator 'value_type' attribute.
+const (
+	ValueTypeString  = "string"
+	ValueTypeInteger = "integer"
+	ValueTypeReal    = "real"
+	ValueTypeBoolean = "boolean"
+	ValueTypeDate    = "date"
+	ValueTypeData    = "data"
+	ValueTypeDict    = "dict"
+	ValueTypeArray   = "array"
+)
+
+// Markers used within 'value_json' documents to express plist types that have no JSON equivalent.
+const (
+	jsonDateMarker = "$date"
+	jsonDataMarker = "$data"
+	jsonRealMarker = "$real"
+)
+
+// MaxDictionaryNestingDepth is the number of nested 'dictionary' block levels supported by the
+// plist generator schema beneath a top level 'setting' block.
+const MaxDictionaryNestingDepth = 6
+
+// SupportedValueTypes returns the list of accepted 'value_type' values.
+func SupportedValueTypes() []string {
+	return []string{
+		ValueTypeString,
+		ValueTypeInteger,
+		ValueTypeReal,
+		ValueTypeBoolean,
+		ValueTypeDate,
+		ValueTypeData,
+		ValueTypeDict,
+		ValueTypeArray,
+	}
+}
+
+// GetTypedValueOfType converts a HCL string value into the plist type named by valueType.
+// When valueType is empty the type is inferred using GetTypedValue. Scalar values with an explicit
+// valueType must be written in the canonical form the value is read back as, e.g. 'true' rather than '1' for a boolean and
+// '1' rather than '01' for an integer, otherwise the setting would never converge with its state.
+func GetTypedValueOfType(value string, valueType string) (any, error) {
+	var typed any
+	switch valueType {
+	case "":
+		return GetTypedValue(value), nil
+	case ValueTypeString:
+		return value, nil
+	case ValueTypeInteger:
+		i, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("value '%s' is not a valid integer", value)
+		}
+		typed = i
+	case ValueTypeReal:
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("value '%s' is not a valid real", value)
+		}
+		typed = f
+	case ValueTypeBoolean:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("value '%s' is not a valid boolean", value)
+		}
+		typed = b
+	case ValueTypeDate:
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return nil, fmt.Errorf("value '%s' is not a valid RFC 3339 date", value)
+		}
+		typed = t.UTC()
+	case ValueTypeData:
+		b, err := base64.StdEncoding.DecodeString(value)
+		if err != nil {
+			return nil, fmt.Errorf("value '%s' is not valid base64 data", value)
+		}
+		typed = b
+	case ValueTypeDict:
+		return map[string]any{}, nil
+	case ValueTypeArray:
+		return []any{}, nil
+	default:
+		return nil, fmt.Errorf("unsupported value_type '%s'", valueType)
+	}
+
+	if canonical := FormatScalarValue(typed); canonical != value {
+		return nil, fmt.Errorf("%s value '%s' must be written as '%s'", valueType, value, canonical)
+	}
+	return typed, nil
+}
+
+// InferScalarValue converts a string into a boolean for the literals 'true' and 'false', an integer
+// for whole numbers and a string otherwise. Unlike GetTypedValue, '1' and '0' stay integers.
+func InferScalarValue(value string) any {
+	if value == "true" || value == "false" {
+		return value == "true"
+	}
+	if intValue, err := strconv.Atoi(value); err == nil {
+		return intValue
+	}
+	return value
+}
+
+// TypeOfValue returns the generator value type name for a decoded plist value.
+func TypeOfValue(value any) string {
+	switch value.(type) {
+	case bool:
+		return ValueTypeBoolean
+	case int, int32, int64, uint, uint32, uint64:
+		return ValueTypeInteger
+	case float32, float64:
+		return ValueTypeReal
+	case time.Time:
+		return ValueTypeDate
+	case []byte:
+		return ValueTypeData
+	case map[string]any:
+		return ValueTypeDict
+	case []any:
+		return ValueTypeArray
+	default:
+		return ValueTypeString
+	}
+}
+
+// FormatScalarValue renders a decoded plist scalar as the string stored in the HCL 'value' attribute.
+func FormatScalarValue(value any) string {
+	switch v := value.(type) {
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32)
+	case time.Time:
+		return v.UTC().Format(time.RFC3339)
+	case []byte:
+		return base64.StdEncoding.EncodeToString(v)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+// DecodeJSONValue decodes a 'value_json' document into plist ready values. JSON objects become
+// dictionaries, arrays become arrays, whole numbers become integers and fractional numbers reals.
+// Single key objects using the "$date", "$data" or "$real" markers are decoded into the matching
+// plist date, data or real types.
+func DecodeJSONValue(raw string) (any, error) {
+	decoder := json.NewDecoder(strings.NewReader(raw))
+	decoder.UseNumber()
+
+	var decoded any
+	if err := decoder.Decode(&decoded); err != nil {
+		return nil, fmt.Errorf("invalid value_json: %v", err)
+	}
+
+	return fromJSONValue(decoded)
+}
+
+// fromJSONValue recursively converts a decoded JSON tree into plist types.
+func fromJSONValue(value any) (any, error) {
+	switch v := value.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i, nil
+		}
+		f, err := v.Float64()
+		if err != nil {
+			return nil, fmt.Errorf("invalid number '%s' in value_json", v)
+		}
+		return f, nil
+	case map[string]any:
+		if len(v) == 1 {
+			if typed, ok, err := fromJSONMarker(v); ok || err != nil {
+				return typed, err
+			}
+		}
+		dict := make(map[string]any, len(v))
+		for key, item := range v {
+			converted, err := fromJSONValue(item)
+			if err != nil {
+				return nil, err
+			}
+			dict[key] = converted
+		}
+		return dict, nil
+	case []any:
+		array := make([]any, 0, len(v))
+		for _, item := range v {
+			converted, err := fromJSONValue(item)
+			if err != nil {
+				return nil, err
+			}
+			array = append(array, converted)
+		}
+		return array, nil
+	case nil:
+		return nil, fmt.Errorf("null is not a valid plist value")
+	default:
+		return v, nil
+	}
+}
+
+// fromJSONMarker decodes the single key typed marker objects used for plist only types.
+func fromJSONMarker(v map[string]any) (any, bool, error) {
+	if raw, ok := v[jsonDateMarker]; ok {
+		s, _ := raw.(string)
+		typed, err := GetTypedValueOfType(s, ValueTypeDate)
+		return typed, true, err
+	}
+	if raw, ok := v[jsonDataMarker]; ok {
+		s, _ := raw.(string)
+		typed, err := GetTypedValueOfType(s, ValueTypeData)
+		return typed, true, err
+	}
+	if raw, ok := v[jsonRealMarker]; ok {
+		n, ok := raw.(json.Number)
+		if !ok {
+			return nil, true, fmt.Errorf("%s marker must hold a number", jsonRealMarker)
+		}
+		f, err := n.Float64()
+		return f, true, err
+	}
+	return nil, false, nil
+}
+
+// EncodeJSONValue renders a decoded plist value as a compact 'value_json' document, using the
+// typed markers for dates, data and whole number reals so the value round-trips unchanged.
+func EncodeJSONValue(value any) (string, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(toJSONValue(value)); err != nil {
+		return "", fmt.Errorf("failed to encode value_json: %v", err)
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
+
+// toJSONValue recursively converts plist types into a JSON encodable tree.
+func toJSONValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for key, item := range v {
+			out[key] = toJSONValue(item)
+		}
+		return out
+	case []any:
+		out := make([]any, len(v))
+		for i, item := range v {
+			out[i] = toJSONValue(item)
+		}
+		return out
+	case time.Time:
+		return map[string]any{jsonDateMarker: FormatScalarValue(v)}
+	case []byte:
+		return map[string]any{jsonDataMarker: FormatScalarValue(v)}
+	case float64:
+		if v == math.Trunc(v) {
+			return map[string]any{jsonRealMarker: v}
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// sortedKeys returns the keys of a dictionary in alphabetical order.
+func sortedKeys(items map[string]any) []string {
+	keys := make([]string, 0, len(items))
+	for k := range items {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,136 @@
+package plist
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetTypedValueOfType(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     string
+		valueType string
+		want      any
+		wantErr   bool
+	}{
+		{name: "Inferred boolean", value: "true", want: true},
+		{name: "Inferred integer", value: "42", want: 42},
+		{name: "Inferred boolean from 1", value: "1", want: true},
+		{name: "Inferred boolean from 0", value: "0", want: false},
+		{name: "Inferred boolean from TRUE", value: "TRUE", want: true},
+		{name: "Inferred boolean from t", value: "t", want: true},
+		{name: "Explicit integer keeps 1", value: "1", valueType: ValueTypeInteger, want: int64(1)},
+		{name: "Inferred string", value: "abc", want: "abc"},
+		{name: "Explicit string keeps numeric text", value: "1", valueType: ValueTypeString, want: "1"},
+		{name: "Explicit integer", value: "42", valueType: ValueTypeInteger, want: int64(42)},
+		{name: "Explicit real", value: "1.5", valueType: ValueTypeReal, want: 1.5},
+		{name: "Explicit date", value: "2024-01-02T03:04:05Z", valueType: ValueTypeDate, want: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)},
+		{name: "Explicit data", value: "aGVsbG8=", valueType: ValueTypeData, want: []byte("hello")},
+		{name: "Empty dict", valueType: ValueTypeDict, want: map[string]any{}},
+		{name: "Invalid integer", value: "abc", valueType: ValueTypeInteger, wantErr: true},
+		{name: "Invalid date", value: "yesterday", valueType: ValueTypeDate, wantErr: true},
+		{name: "Non-canonical boolean", value: "1", valueType: ValueTypeBoolean, wantErr: true},
+		{name: "Non-canonical integer", value: "01", valueType: ValueTypeInteger, wantErr: true},
+		{name: "Non-canonical real", value: "1.50", valueType: ValueTypeReal, wantErr: true},
+		{name: "Non-canonical date", value: "2024-01-02T05:04:05+02:00", valueType: ValueTypeDate, wantErr: true},
+		{name: "Explicit boolean", value: "false", valueType: ValueTypeBoolean, want: false},
+		{name: "Explicit string keeps boolean spelling", value: "TRUE", valueType: ValueTypeString, want: "TRUE"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GetTypedValueOfType(tt.value, tt.valueType)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestJSONValueRoundTrip(t *testing.T) {
+	raw := `[{"Allowed":true,"CodeRequirement":"identifier \"com.example\"","Created":{"$date":"2024-01-02T03:04:05Z"},"Blob":{"$data":"aGVsbG8="},"Ratio":{"$real":2},"Weight":0.5,"Count":3}]`
+
+	decoded, err := DecodeJSONValue(raw)
+	require.NoError(t, err)
+
+	item := decoded.([]any)[0].(map[string]any)
+	assert.Equal(t, true, item["Allowed"])
+	assert.Equal(t, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), item["Created"])
+	assert.Equal(t, []byte("hello"), item["Blob"])
+	assert.Equal(t, float64(2), item["Ratio"])
+	assert.Equal(t, 0.5, item["Weight"])
+	assert.Equal(t, int64(3), item["Count"])
+
+	encoded, err := EncodeJSONValue(decoded)
+	require.NoError(t, err)
+
+	again, err := DecodeJSONValue(encoded)
+	require.NoError(t, err)
+	assert.Equal(t, decoded, again)
+}
+
+func TestSettingsRoundTripThroughPlist(t *testing.T) {
+	settings := []any{
+		map[string]any{"key": "Enabled", "value": "true", "value_type": "", "value_json": "", "dictionary": []any{}},
+		map[string]any{"key": "Version", "value": "10", "value_type": ValueTypeString, "value_json": "", "dictionary": []any{}},
+		map[string]any{"key": "Services", "value": "", "value_type": "", "value_json": "", "dictionary": []any{
+			map[string]any{"key": "Accessibility", "value": "", "value_type": "", "value_json": `[{"Allowed":true,"Identifier":"com.example.app"}]`, "dictionary": []any{}},
+		}},
+		map[string]any{"key": "Expires", "value": "2024-01-02T03:04:05Z", "value_type": ValueTypeDate, "value_json": "", "dictionary": []any{}},
+		map[string]any{"key": "Empty", "value": "", "value_type": ValueTypeArray, "value_json": "", "dictionary": []any{}},
+	}
+
+	items, err := ConvertSettingsToDictionary(settings)
+	require.NoError(t, err)
+
+	xml, err := MarshalPayload(&ConfigurationProfile{
+		PayloadType:    "Configuration",
+		PayloadContent: []PayloadContent{{PayloadType: "com.example", ConfigurationItems: items}},
+	})
+	require.NoError(t, err)
+
+	profile, err := UnmarshalPayload(xml)
+	require.NoError(t, err)
+
+	got, err := extractNestedConfigurationSettings(profile.PayloadContent[0].ConfigurationItems, settings, MaxDictionaryNestingDepth+1)
+	require.NoError(t, err)
+	require.Len(t, got, len(settings))
+
+	for i, setting := range got {
+		want := settings[i].(map[string]any)
+		entry := setting.(map[string]any)
+		assert.Equal(t, want["key"], entry["key"])
+		assert.Equal(t, want["value"], entry["value"], "value of %s", want["key"])
+		assert.Equal(t, want["value_type"], entry["value_type"], "value_type of %s", want["key"])
+	}
+
+	services := got[2].(map[string]any)["dictionary"].([]any)[0].(map[string]any)
+	assert.JSONEq(t, `[{"Allowed":true,"Identifier":"com.example.app"}]`, services["value_json"].(string))
+}
+
+func TestExtractSettingsWithoutPriorIsDeterministic(t *testing.T) {
+	items := map[string]any{
+		"b": uint64(1),
+		"a": map[string]any{"nested": "x"},
+		"c": []any{"one", "two"},
+		"d": "true",
+	}
+
+	got, err := extractNestedConfigurationSettings(items, nil, MaxDictionaryNestingDepth+1)
+	require.NoError(t, err)
+
+	keys := []string{}
+	for _, s := range got {
+		keys = append(keys, s.(map[string]any)["key"].(string))
+	}
+	assert.Equal(t, []string{"a", "b", "c", "d"}, keys)
+	assert.Equal(t, `["one","two"]`, got[2].(map[string]any)["value_json"])
+	assert.Equal(t, ValueTypeString, got[3].(map[string]any)["value_type"])
+	assert.Equal(t, ValueTypeInteger, got[1].(map[string]any)["value_type"], "1 would be inferred as a boolean")
+}
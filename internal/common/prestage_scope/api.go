@@ -0,0 +1,115 @@
+// common/prestage_scope/api.go
+// Description: This file contains the prestage scope endpoints shared by computer and mobile device
+// prestages. Every change is sent with the scope's current version lock, so a change made by another
+// client between reading and writing the scope is rejected rather than overwritten.
+package prestage_scope
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+// Prestage describes a prestage type whose device scope is managed.
+type Prestage struct {
+	// Label is the name of the prestage type used in messages, e.g. "Computer Prestage".
+	Label string
+	// URI is the collection endpoint of the prestage type, e.g. "/api/v2/computer-prestages".
+	URI string
+}
+
+var (
+	Computers     = Prestage{Label: "Computer Prestage", URI: "/api/v2/computer-prestages"}
+	MobileDevices = Prestage{Label: "Mobile Device Prestage", URI: "/api/v2/mobile-device-prestages"}
+)
+
+// operation is a change to the serial numbers assigned to a prestage.
+type operation struct {
+	name   string
+	method string
+	path   string
+}
+
+var (
+	opAdd     = operation{name: "add", method: "POST", path: "scope"}
+	opRemove  = operation{name: "remove", method: "POST", path: "scope/delete-multiple"}
+	opReplace = operation{name: "replace", method: "PUT", path: "scope"}
+)
+
+// scope is the device scope of a prestage.
+type scope struct {
+	PrestageID  string       `json:"prestageId"`
+	Assignments []assignment `json:"assignments"`
+	VersionLock int          `json:"versionLock"`
+}
+
+type assignment struct {
+	SerialNumber   string `json:"serialNumber"`
+	AssignmentDate string `json:"assignmentDate"`
+	UserAssigned   string `json:"userAssigned"`
+}
+
+type scopeRequest struct {
+	SerialNumbers []string `json:"serialNumbers"`
+	VersionLock   int      `json:"versionLock"`
+}
+
+// serialNumbers returns the serial numbers assigned to the prestage.
+func (s *scope) serialNumbers() []string {
+	out := make([]string, 0, len(s.Assignments))
+	for _, a := range s.Assignments {
+		out = append(out, a.SerialNumber)
+	}
+	return out
+}
+
+// getScope returns the device scope of a prestage.
+func (p Prestage) getScope(client *jamfpro.Client, prestageID string) (*scope, error) {
+	endpoint := fmt.Sprintf("%s/%s/scope", p.URI, prestageID)
+
+	var out scope
+	resp, err := client.HTTP.DoRequest("GET", endpoint, nil, &out)
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read scope of %s ID %s: %v", p.Label, prestageID, err)
+	}
+
+	return &out, nil
+}
+
+// modifyScope reads the current version lock of the prestage scope and applies op to serialNumbers.
+// A version lock conflict is returned as an error for the caller to retry.
+func (p Prestage) modifyScope(client *jamfpro.Client, prestageID string, op operation, serialNumbers []string) error {
+	current, err := p.getScope(client, prestageID)
+	if err != nil {
+		return err
+	}
+
+	endpoint := fmt.Sprintf("%s/%s/%s", p.URI, prestageID, op.path)
+	request := &scopeRequest{
+		SerialNumbers: serialNumbers,
+		VersionLock:   current.VersionLock,
+	}
+
+	var out scope
+	resp, err := client.HTTP.DoRequest(op.method, endpoint, request, &out)
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		if isVersionLockConflict(err) {
+			return fmt.Errorf("scope of %s ID %s was changed while it was being updated (version lock %d): %v", p.Label, prestageID, current.VersionLock, err)
+		}
+		return fmt.Errorf("failed to %s serial numbers in scope of %s ID %s: %v", op.name, p.Label, prestageID, err)
+	}
+
+	return nil
+}
+
+func isVersionLockConflict(err error) bool {
+	msg := err.Error()
+	return strings.Contains(msg, "409") || strings.Contains(msg, "OPTIMISTIC_LOCK_FAILED")
+}
@@ -0,0 +1,212 @@
+// common/prestage_scope/resource.go
+// Description: This file contains the CRUD operations of the prestage scope resources. Authoritative
+// scopes are written with the replace endpoint, non-authoritative scopes with the add and remove
+// endpoints for the serial numbers that changed.
+package prestage_scope
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/errors"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Create assigns the configured serial numbers to the prestage.
+func (p Prestage) Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+	prestageID := d.Get("prestage_id").(string)
+	serialNumbers := stringSet(d.Get("serial_numbers"))
+
+	op := opAdd
+	if d.Get("authoritative").(bool) {
+		op = opReplace
+	}
+
+	if err := p.apply(ctx, d, client, schema.TimeoutCreate, prestageID, op, serialNumbers); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(prestageID)
+
+	return p.read(ctx, d, meta, false)
+}
+
+// Read refreshes the serial numbers assigned to the prestage. A non-authoritative scope only reports
+// the configured serial numbers that are still assigned.
+func (p Prestage) Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return p.read(ctx, d, meta, true)
+}
+
+func (p Prestage) read(ctx context.Context, d *schema.ResourceData, meta any, cleanup bool) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+
+	var current *scope
+	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
+		var apiErr error
+		current, apiErr = p.getScope(client, d.Id())
+		if apiErr != nil {
+			if strings.Contains(apiErr.Error(), "404") {
+				return retry.NonRetryableError(apiErr)
+			}
+			return retry.RetryableError(apiErr)
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.HandleResourceNotFoundError(err, d, cleanup)
+	}
+
+	configured := stringSet(d.Get("serial_numbers"))
+	serialNumbers := reconcile(configured, current.serialNumbers(), d.Get("authoritative").(bool))
+
+	if err := d.Set("prestage_id", d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("serial_numbers", serialNumbers); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// Update replaces an authoritative scope, or adds and removes the serial numbers that changed.
+func (p Prestage) Update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+
+	if d.Get("authoritative").(bool) {
+		if err := p.apply(ctx, d, client, schema.TimeoutUpdate, d.Id(), opReplace, stringSet(d.Get("serial_numbers"))); err != nil {
+			return diag.FromErr(err)
+		}
+		return p.read(ctx, d, meta, false)
+	}
+
+	oldValue, newValue := d.GetChange("serial_numbers")
+	added, removed := difference(stringSet(oldValue), stringSet(newValue))
+
+	if err := p.apply(ctx, d, client, schema.TimeoutUpdate, d.Id(), opRemove, removed); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := p.apply(ctx, d, client, schema.TimeoutUpdate, d.Id(), opAdd, added); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return p.read(ctx, d, meta, false)
+}
+
+// Delete removes the serial numbers in state from the prestage. Devices assigned outside of a
+// non-authoritative scope are left in place.
+func (p Prestage) Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+
+	err := p.apply(ctx, d, client, schema.TimeoutDelete, d.Id(), opRemove, stringSet(d.Get("serial_numbers")))
+	if err != nil && !strings.Contains(err.Error(), "404") {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// Import accepts the prestage ID and imports its scope as authoritative.
+func (p Prestage) Import(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	if !numericID.MatchString(d.Id()) {
+		return nil, fmt.Errorf("invalid import ID '%s', expected the numeric ID of the %s", d.Id(), p.Label)
+	}
+
+	if err := d.Set("prestage_id", d.Id()); err != nil {
+		return nil, err
+	}
+	if err := d.Set("authoritative", true); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// apply runs op with retries. Each attempt reads the current version lock, so a conflicting change
+// by another client is retried against the new scope.
+func (p Prestage) apply(ctx context.Context, d *schema.ResourceData, client *jamfpro.Client, timeout, prestageID string, op operation, serialNumbers []string) error {
+	if len(serialNumbers) == 0 && op != opReplace {
+		return nil
+	}
+
+	log.Printf("[DEBUG] %s serial numbers %v in scope of %s ID %s", op.name, serialNumbers, p.Label, prestageID)
+
+	return retry.RetryContext(ctx, d.Timeout(timeout), func() *retry.RetryError {
+		if err := p.modifyScope(client, prestageID, op, serialNumbers); err != nil {
+			if strings.Contains(err.Error(), "404") {
+				return retry.NonRetryableError(err)
+			}
+			return retry.RetryableError(err)
+		}
+		return nil
+	})
+}
+
+// reconcile returns the serial numbers to state. Assigned serial numbers matching a configured one
+// ignoring case keep the configured spelling. Unless authoritative, only configured serial numbers
+// are returned.
+func reconcile(configured, assigned []string, authoritative bool) []string {
+	byKey := make(map[string]string, len(configured))
+	for _, serial := range configured {
+		byKey[strings.ToUpper(serial)] = serial
+	}
+
+	out := make([]string, 0, len(assigned))
+	for _, serial := range assigned {
+		if value, ok := byKey[strings.ToUpper(serial)]; ok {
+			out = append(out, value)
+		} else if authoritative {
+			out = append(out, serial)
+		}
+	}
+
+	sort.Strings(out)
+	return out
+}
+
+// difference returns the serial numbers added to and removed from oldValues, ignoring case.
+func difference(oldValues, newValues []string) (added, removed []string) {
+	contains := func(values []string, target string) bool {
+		for _, v := range values {
+			if strings.EqualFold(v, target) {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, serial := range newValues {
+		if !contains(oldValues, serial) {
+			added = append(added, serial)
+		}
+	}
+	for _, serial := range oldValues {
+		if !contains(newValues, serial) {
+			removed = append(removed, serial)
+		}
+	}
+
+	return added, removed
+}
+
+func stringSet(value any) []string {
+	set, ok := value.(*schema.Set)
+	if !ok {
+		return nil
+	}
+	out := make([]string, 0, set.Len())
+	for _, v := range set.List() {
+		out = append(out, v.(string))
+	}
+	sort.Strings(out)
+	return out
+}
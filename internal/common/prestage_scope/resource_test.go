@@ -0,0 +1,22 @@
+package prestage_scope
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReconcile(t *testing.T) {
+	configured := []string{"c02abc", "C02DEF"}
+	assigned := []string{"C02ABC", "C02XYZ"}
+
+	assert.Equal(t, []string{"C02XYZ", "c02abc"}, reconcile(configured, assigned, true))
+	assert.Equal(t, []string{"c02abc"}, reconcile(configured, assigned, false))
+}
+
+func TestDifference(t *testing.T) {
+	added, removed := difference([]string{"C02ABC", "C02DEF"}, []string{"c02abc", "C02XYZ"})
+
+	assert.Equal(t, []string{"C02XYZ"}, added)
+	assert.Equal(t, []string{"C02DEF"}, removed)
+}
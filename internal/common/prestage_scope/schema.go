@@ -0,0 +1,46 @@
+// common/prestage_scope/schema.go
+// Description: This file contains the schema shared by the computer and mobile device prestage scope
+// resources, which assign devices to a prestage enrollment by serial number.
+package prestage_scope
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// numericID matches a Jamf Pro numeric resource ID.
+var numericID = regexp.MustCompile(`^[0-9]+$`)
+
+// GetSchema returns the prestage scope schema for a device type, e.g. "computer".
+func GetSchema(deviceLabel string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The ID of the prestage.",
+		},
+		"prestage_id": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringMatch(numericID, "must be a numeric Jamf Pro ID"),
+			Description:  fmt.Sprintf("The ID of the %s prestage enrollment the %ss are assigned to.", deviceLabel, deviceLabel),
+		},
+		"serial_numbers": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: fmt.Sprintf("The serial numbers of the %ss assigned to the prestage. Serial numbers are compared ignoring case.", deviceLabel),
+		},
+		"authoritative": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+			Description: fmt.Sprintf("When true, 'serial_numbers' is the complete scope of the prestage and any other %s assigned to it is removed. "+
+				"When false, only the listed serial numbers are added and removed, so several configurations, or the Jamf Pro GUI, can assign %ss to the same prestage.", deviceLabel, deviceLabel),
+		},
+	}
+}
@@ -0,0 +1,226 @@
+// common/scope_preview/client.go
+// Description: This file contains the Jamf Pro backed inventory snapshot and directory used to
+// evaluate computer scopes, and the conversion of a 'scope' block into a Scope.
+package scope_preview
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/device_identifiers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// LoadComputers returns the inventory snapshot of every computer in Jamf Pro.
+func LoadComputers(client *jamfpro.Client) ([]Computer, error) {
+	params := url.Values{}
+	params.Add("section", "GENERAL")
+	params.Add("section", "HARDWARE")
+	params.Add("section", "USER_AND_LOCATION")
+
+	inventory, err := client.GetComputersInventory(params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch computer inventory: %v", err)
+	}
+
+	computers := make([]Computer, 0, len(inventory.Results))
+	for _, item := range inventory.Results {
+		id, err := strconv.Atoi(item.ID)
+		if err != nil {
+			continue
+		}
+		buildingID, _ := strconv.Atoi(item.UserAndLocation.BuildingId)
+		departmentID, _ := strconv.Atoi(item.UserAndLocation.DepartmentId)
+
+		ip := item.General.LastReportedIp
+		if ip == "" {
+			ip = item.General.LastIpAddress
+		}
+
+		computers = append(computers, Computer{
+			ID:           id,
+			Name:         item.General.Name,
+			SerialNumber: item.Hardware.SerialNumber,
+			UDID:         item.UDID,
+			IPAddress:    ip,
+			Username:     item.UserAndLocation.Username,
+			BuildingID:   buildingID,
+			DepartmentID: departmentID,
+		})
+	}
+
+	return computers, nil
+}
+
+// clientDirectory resolves scope references through the Classic API, caching each lookup.
+type clientDirectory struct {
+	client   *jamfpro.Client
+	groups   map[int][]int
+	segments map[int][2]net.IP
+}
+
+// NewDirectory returns a Directory backed by the Jamf Pro API.
+func NewDirectory(client *jamfpro.Client) Directory {
+	return &clientDirectory{
+		client:   client,
+		groups:   make(map[int][]int),
+		segments: make(map[int][2]net.IP),
+	}
+}
+
+func (c *clientDirectory) ComputerGroupMembers(id int) ([]int, error) {
+	if members, ok := c.groups[id]; ok {
+		return members, nil
+	}
+
+	group, err := c.client.GetComputerGroupByID(strconv.Itoa(id))
+	if err != nil {
+		return nil, err
+	}
+
+	var members []int
+	if group.Computers != nil {
+		for _, computer := range *group.Computers {
+			members = append(members, computer.ID)
+		}
+	}
+	c.groups[id] = members
+
+	return members, nil
+}
+
+func (c *clientDirectory) NetworkSegmentRange(id int) (net.IP, net.IP, error) {
+	if r, ok := c.segments[id]; ok {
+		return r[0], r[1], nil
+	}
+
+	segment, err := c.client.GetNetworkSegmentByID(strconv.Itoa(id))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	start := net.ParseIP(segment.StartingAddress)
+	end := net.ParseIP(segment.EndingAddress)
+	if start == nil || end == nil {
+		return nil, nil, fmt.Errorf("network segment '%s' has an invalid address range '%s' - '%s'", segment.Name, segment.StartingAddress, segment.EndingAddress)
+	}
+	c.segments[id] = [2]net.IP{start, end}
+
+	return start, end, nil
+}
+
+func (c *clientDirectory) JSSUsername(id int) (string, error) {
+	user, err := c.client.GetUserByID(strconv.Itoa(id))
+	if err != nil {
+		return "", err
+	}
+	return user.Name, nil
+}
+
+func (c *clientDirectory) JSSUserGroupUsernames(id int) ([]string, error) {
+	group, err := c.client.GetUserGroupByID(strconv.Itoa(id))
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(group.Users))
+	for _, user := range group.Users {
+		names = append(names, user.Username)
+	}
+	return names, nil
+}
+
+// ScopeFromMap converts a 'scope' block defined with GetSharedmacOSComputerSchemaScope into a
+// Scope. Computers referenced by serial number or UDID are resolved to IDs.
+func ScopeFromMap(client *jamfpro.Client, scopeData map[string]any) (Scope, error) {
+	scope := Scope{AllComputers: boolValue(scopeData["all_computers"])}
+
+	targets, err := targetsFromMap(client, scopeData)
+	if err != nil {
+		return Scope{}, fmt.Errorf("invalid scope targets: %v", err)
+	}
+	scope.Targets = targets
+
+	if limitations := firstBlock(scopeData["limitations"]); limitations != nil {
+		scope.Limitations = Limitations{
+			NetworkSegmentIDs: intValues(limitations["network_segment_ids"]),
+			Usernames:         stringValues(limitations["directory_service_or_local_usernames"]),
+			DirectoryGroupIDs: intValues(limitations["directory_service_usergroup_ids"]),
+			IBeaconIDs:        intValues(limitations["ibeacon_ids"]),
+		}
+	}
+
+	if exclusions := firstBlock(scopeData["exclusions"]); exclusions != nil {
+		excluded, err := targetsFromMap(client, exclusions)
+		if err != nil {
+			return Scope{}, fmt.Errorf("invalid scope exclusions: %v", err)
+		}
+		scope.Exclusions = excluded
+	}
+
+	return scope, nil
+}
+
+func targetsFromMap(client *jamfpro.Client, block map[string]any) (Targets, error) {
+	computerIDs := intValues(block["computer_ids"])
+
+	resolved, err := device_identifiers.ResolveIDs(client, device_identifiers.Computers,
+		stringValues(block["computer_serial_numbers"]), stringValues(block["computer_udids"]))
+	if err != nil {
+		return Targets{}, err
+	}
+
+	return Targets{
+		ComputerIDs:       append(computerIDs, resolved...),
+		ComputerGroupIDs:  intValues(block["computer_group_ids"]),
+		BuildingIDs:       intValues(block["building_ids"]),
+		DepartmentIDs:     intValues(block["department_ids"]),
+		JSSUserIDs:        intValues(block["jss_user_ids"]),
+		JSSUserGroupIDs:   intValues(block["jss_user_group_ids"]),
+		NetworkSegmentIDs: intValues(block["network_segment_ids"]),
+		Usernames:         stringValues(block["directory_service_or_local_usernames"]),
+		DirectoryGroupIDs: intValues(block["directory_service_usergroup_ids"]),
+		IBeaconIDs:        intValues(block["ibeacon_ids"]),
+	}, nil
+}
+
+func firstBlock(value any) map[string]any {
+	list, ok := value.([]any)
+	if !ok || len(list) == 0 {
+		return nil
+	}
+	block, _ := list[0].(map[string]any)
+	return block
+}
+
+func boolValue(value any) bool {
+	b, _ := value.(bool)
+	return b
+}
+
+func intValues(value any) []int {
+	set, ok := value.(*schema.Set)
+	if !ok {
+		return nil
+	}
+	var out []int
+	for _, v := range set.List() {
+		out = append(out, v.(int))
+	}
+	return out
+}
+
+func stringValues(value any) []string {
+	set, ok := value.(*schema.Set)
+	if !ok {
+		return nil
+	}
+	var out []string
+	for _, v := range set.List() {
+		out = append(out, v.(string))
+	}
+	return out
+}
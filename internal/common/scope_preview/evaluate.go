@@ -0,0 +1,270 @@
+// common/scope_preview/evaluate.go
+// Description: This file contains the evaluation of a computer scope (targets, limitations and
+// exclusions) against a snapshot of the computer inventory, producing the effective device set a
+// policy or configuration profile with that scope would apply to.
+package scope_preview
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// Computer is the inventory data of a computer used to evaluate scope.
+type Computer struct {
+	ID           int
+	Name         string
+	SerialNumber string
+	UDID         string
+	IPAddress    string
+	Username     string
+	BuildingID   int
+	DepartmentID int
+}
+
+// Targets are the scope entries that add computers, also used for exclusions.
+type Targets struct {
+	ComputerIDs       []int
+	ComputerGroupIDs  []int
+	BuildingIDs       []int
+	DepartmentIDs     []int
+	JSSUserIDs        []int
+	JSSUserGroupIDs   []int
+	NetworkSegmentIDs []int
+	Usernames         []string
+	DirectoryGroupIDs []int
+	IBeaconIDs        []int
+}
+
+// Limitations are the scope entries that restrict targeted computers.
+type Limitations struct {
+	NetworkSegmentIDs []int
+	Usernames         []string
+	DirectoryGroupIDs []int
+	IBeaconIDs        []int
+}
+
+// Scope is a computer scope as defined by GetSharedmacOSComputerSchemaScope.
+type Scope struct {
+	AllComputers bool
+	Targets      Targets
+	Limitations  Limitations
+	Exclusions   Targets
+}
+
+// Directory resolves the scope entries that reference other Jamf Pro objects.
+type Directory interface {
+	// ComputerGroupMembers returns the IDs of the computers in a computer group.
+	ComputerGroupMembers(id int) ([]int, error)
+	// NetworkSegmentRange returns the first and last address of a network segment.
+	NetworkSegmentRange(id int) (net.IP, net.IP, error)
+	// JSSUsername returns the username of a Jamf Pro user.
+	JSSUsername(id int) (string, error)
+	// JSSUserGroupUsernames returns the usernames of the members of a Jamf Pro user group.
+	JSSUserGroupUsernames(id int) ([]string, error)
+}
+
+// Result is the outcome of evaluating a scope.
+type Result struct {
+	// Targeted are the computers matched by the targets, before limitations and exclusions.
+	Targeted []Computer
+	// Excluded are the targeted computers removed by limitations or exclusions.
+	Excluded []Computer
+	// Effective are the computers the scope applies to.
+	Effective []Computer
+	// Warnings lists scope entries that cannot be evaluated from inventory and were ignored.
+	Warnings []string
+}
+
+// Evaluate computes the effective computer set of scope over computers.
+func Evaluate(computers []Computer, scope Scope, dir Directory) (*Result, error) {
+	e := &evaluator{dir: dir}
+
+	targeted, err := e.matching(computers, scope.Targets, "targets")
+	if err != nil {
+		return nil, err
+	}
+	if scope.AllComputers {
+		targeted = make(map[int]bool, len(computers))
+		for _, c := range computers {
+			targeted[c.ID] = true
+		}
+	}
+
+	limited, err := e.limited(computers, scope.Limitations)
+	if err != nil {
+		return nil, err
+	}
+
+	excluded, err := e.matching(computers, scope.Exclusions, "exclusions")
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{}
+	for _, c := range computers {
+		if !targeted[c.ID] {
+			continue
+		}
+		result.Targeted = append(result.Targeted, c)
+		if (limited != nil && !limited[c.ID]) || excluded[c.ID] {
+			result.Excluded = append(result.Excluded, c)
+			continue
+		}
+		result.Effective = append(result.Effective, c)
+	}
+
+	for _, list := range [][]Computer{result.Targeted, result.Excluded, result.Effective} {
+		sort.Slice(list, func(i, j int) bool { return list[i].ID < list[j].ID })
+	}
+	result.Warnings = e.warnings
+
+	return result, nil
+}
+
+type evaluator struct {
+	dir      Directory
+	warnings []string
+}
+
+// matching returns the IDs of the computers matched by any entry of targets.
+func (e *evaluator) matching(computers []Computer, targets Targets, section string) (map[int]bool, error) {
+	out := make(map[int]bool)
+	for _, id := range targets.ComputerIDs {
+		out[id] = true
+	}
+
+	for _, groupID := range targets.ComputerGroupIDs {
+		members, err := e.dir.ComputerGroupMembers(groupID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read members of computer group ID %d: %v", groupID, err)
+		}
+		for _, id := range members {
+			out[id] = true
+		}
+	}
+
+	usernames, err := e.usernames(targets.JSSUserIDs, targets.JSSUserGroupIDs)
+	if err != nil {
+		return nil, err
+	}
+	usernames = append(usernames, targets.Usernames...)
+
+	segments, err := e.segments(targets.NetworkSegmentIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, c := range computers {
+		if slices.Contains(targets.BuildingIDs, c.BuildingID) ||
+			slices.Contains(targets.DepartmentIDs, c.DepartmentID) ||
+			containsFold(usernames, c.Username) ||
+			inSegments(segments, c.IPAddress) {
+			out[c.ID] = true
+		}
+	}
+
+	e.unsupported(section, "directory service user groups", len(targets.DirectoryGroupIDs))
+	e.unsupported(section, "iBeacons", len(targets.IBeaconIDs))
+
+	return out, nil
+}
+
+// limited returns the IDs of the computers meeting every limitation set, or nil when there are no
+// limitations that can be evaluated.
+func (e *evaluator) limited(computers []Computer, limitations Limitations) (map[int]bool, error) {
+	e.unsupported("limitations", "directory service user groups", len(limitations.DirectoryGroupIDs))
+	e.unsupported("limitations", "iBeacons", len(limitations.IBeaconIDs))
+
+	if len(limitations.NetworkSegmentIDs) == 0 && len(limitations.Usernames) == 0 {
+		return nil, nil
+	}
+
+	segments, err := e.segments(limitations.NetworkSegmentIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(map[int]bool)
+	for _, c := range computers {
+		if len(segments) > 0 && !inSegments(segments, c.IPAddress) {
+			continue
+		}
+		if len(limitations.Usernames) > 0 && !containsFold(limitations.Usernames, c.Username) {
+			continue
+		}
+		out[c.ID] = true
+	}
+
+	return out, nil
+}
+
+func (e *evaluator) usernames(userIDs, userGroupIDs []int) ([]string, error) {
+	var out []string
+	for _, id := range userIDs {
+		name, err := e.dir.JSSUsername(id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read user ID %d: %v", id, err)
+		}
+		out = append(out, name)
+	}
+	for _, id := range userGroupIDs {
+		names, err := e.dir.JSSUserGroupUsernames(id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read user group ID %d: %v", id, err)
+		}
+		out = append(out, names...)
+	}
+	return out, nil
+}
+
+type ipRange struct {
+	start net.IP
+	end   net.IP
+}
+
+func (e *evaluator) segments(ids []int) ([]ipRange, error) {
+	var out []ipRange
+	for _, id := range ids {
+		start, end, err := e.dir.NetworkSegmentRange(id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read network segment ID %d: %v", id, err)
+		}
+		out = append(out, ipRange{start: start.To16(), end: end.To16()})
+	}
+	return out, nil
+}
+
+func (e *evaluator) unsupported(section, what string, count int) {
+	if count > 0 {
+		e.warnings = append(e.warnings, fmt.Sprintf("%d %s in %s cannot be evaluated from inventory and were ignored", count, what, section))
+	}
+}
+
+func inSegments(segments []ipRange, address string) bool {
+	ip := net.ParseIP(address).To16()
+	if ip == nil {
+		return false
+	}
+	for _, r := range segments {
+		if bytes.Compare(ip, r.start) >= 0 && bytes.Compare(ip, r.end) <= 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func containsFold(values []string, target string) bool {
+	if target == "" {
+		return false
+	}
+	for _, v := range values {
+		if strings.EqualFold(v, target) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,158 @@
+package scope_preview
+
+import (
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeDirectory struct {
+	groups     map[int][]int
+	segments   map[int][2]string
+	users      map[int]string
+	userGroups map[int][]string
+}
+
+func (f fakeDirectory) ComputerGroupMembers(id int) ([]int, error) {
+	members, ok := f.groups[id]
+	if !ok {
+		return nil, fmt.Errorf("not found")
+	}
+	return members, nil
+}
+
+func (f fakeDirectory) NetworkSegmentRange(id int) (net.IP, net.IP, error) {
+	r, ok := f.segments[id]
+	if !ok {
+		return nil, nil, fmt.Errorf("not found")
+	}
+	return net.ParseIP(r[0]), net.ParseIP(r[1]), nil
+}
+
+func (f fakeDirectory) JSSUsername(id int) (string, error) {
+	return f.users[id], nil
+}
+
+func (f fakeDirectory) JSSUserGroupUsernames(id int) ([]string, error) {
+	return f.userGroups[id], nil
+}
+
+var testComputers = []Computer{
+	{ID: 1, Name: "mac-1", IPAddress: "10.0.0.10", Username: "alice", BuildingID: 1, DepartmentID: 10},
+	{ID: 2, Name: "mac-2", IPAddress: "10.0.1.10", Username: "bob", BuildingID: 1, DepartmentID: 20},
+	{ID: 3, Name: "mac-3", IPAddress: "192.168.1.5", Username: "carol", BuildingID: 2, DepartmentID: 10},
+	{ID: 4, Name: "mac-4", IPAddress: "10.0.0.20", Username: "dave", BuildingID: 2, DepartmentID: 20},
+}
+
+var testDirectory = fakeDirectory{
+	groups:     map[int][]int{5: {2, 3}},
+	segments:   map[int][2]string{7: {"10.0.0.0", "10.0.0.255"}},
+	users:      map[int]string{8: "Dave"},
+	userGroups: map[int][]string{9: {"carol"}},
+}
+
+func effectiveIDs(r *Result) []int {
+	var ids []int
+	for _, c := range r.Effective {
+		ids = append(ids, c.ID)
+	}
+	return ids
+}
+
+func TestEvaluate(t *testing.T) {
+	tests := []struct {
+		name     string
+		scope    Scope
+		expected []int
+		excluded int
+	}{
+		{
+			name:  "empty scope",
+			scope: Scope{},
+		},
+		{
+			name:     "all computers",
+			scope:    Scope{AllComputers: true},
+			expected: []int{1, 2, 3, 4},
+		},
+		{
+			name:     "computer IDs and group members",
+			scope:    Scope{Targets: Targets{ComputerIDs: []int{1}, ComputerGroupIDs: []int{5}}},
+			expected: []int{1, 2, 3},
+		},
+		{
+			name:     "buildings and departments",
+			scope:    Scope{Targets: Targets{BuildingIDs: []int{2}, DepartmentIDs: []int{20}}},
+			expected: []int{2, 3, 4},
+		},
+		{
+			name:     "users and user groups",
+			scope:    Scope{Targets: Targets{JSSUserIDs: []int{8}, JSSUserGroupIDs: []int{9}}},
+			expected: []int{3, 4},
+		},
+		{
+			name:     "network segment target",
+			scope:    Scope{Targets: Targets{NetworkSegmentIDs: []int{7}}},
+			expected: []int{1, 4},
+		},
+		{
+			name: "network segment limitation",
+			scope: Scope{
+				AllComputers: true,
+				Limitations:  Limitations{NetworkSegmentIDs: []int{7}},
+			},
+			expected: []int{1, 4},
+			excluded: 2,
+		},
+		{
+			name: "limitations are combined",
+			scope: Scope{
+				AllComputers: true,
+				Limitations:  Limitations{NetworkSegmentIDs: []int{7}, Usernames: []string{"ALICE"}},
+			},
+			expected: []int{1},
+			excluded: 3,
+		},
+		{
+			name: "exclusions",
+			scope: Scope{
+				Targets:    Targets{BuildingIDs: []int{1, 2}},
+				Exclusions: Targets{ComputerGroupIDs: []int{5}, DepartmentIDs: []int{20}},
+			},
+			expected: []int{1},
+			excluded: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Evaluate(testComputers, tt.scope, testDirectory)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, effectiveIDs(result))
+			assert.Len(t, result.Excluded, tt.excluded)
+			assert.Len(t, result.Targeted, len(tt.expected)+tt.excluded)
+			assert.Empty(t, result.Warnings)
+		})
+	}
+}
+
+func TestEvaluateWarnsOnUnsupportedEntries(t *testing.T) {
+	scope := Scope{
+		AllComputers: true,
+		Limitations:  Limitations{IBeaconIDs: []int{1}},
+		Exclusions:   Targets{DirectoryGroupIDs: []int{2, 3}},
+	}
+
+	result, err := Evaluate(testComputers, scope, testDirectory)
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3, 4}, effectiveIDs(result))
+	assert.Len(t, result.Warnings, 2)
+}
+
+func TestEvaluateLookupError(t *testing.T) {
+	_, err := Evaluate(testComputers, Scope{Targets: Targets{ComputerGroupIDs: []int{99}}}, testDirectory)
+	assert.ErrorContains(t, err, "computer group ID 99")
+}
@@ -2,6 +2,7 @@ package common
 
 import (
 	"context"
+	stderrors "errors"
 	"fmt"
 	"reflect"
 
@@ -197,6 +198,10 @@ func Delete(ctx context.Context, d *schema.ResourceData, meta any, serverOutcome
 	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *retry.RetryError {
 		apiErr := serverOutcomeFunc(resourceID)
 		if apiErr != nil {
+			var inUseErr *errors.ResourceInUseError
+			if stderrors.As(apiErr, &inUseErr) {
+				return retry.NonRetryableError(apiErr)
+			}
 			return retry.RetryableError(apiErr)
 		}
 		return nil
@@ -24,6 +24,18 @@ func GetSharedmacOSComputerSchemaScope() *schema.Resource {
 				Optional:    true,
 				Elem:        &schema.Schema{Type: schema.TypeInt},
 			},
+			"computer_serial_numbers": {
+				Type:        schema.TypeSet,
+				Description: "The computers to which the configuration profile is scoped by serial number. Resolved to Jamf IDs at apply time.",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"computer_udids": {
+				Type:        schema.TypeSet,
+				Description: "The computers to which the configuration profile is scoped by UDID. Resolved to Jamf IDs at apply time.",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 			"computer_group_ids": {
 				Type:        schema.TypeSet,
 				Description: "The computer groups to which the configuration profile is scoped by Jamf ID.",
@@ -101,6 +113,18 @@ func GetSharedmacOSComputerSchemaScope() *schema.Resource {
 							Optional:    true,
 							Elem:        &schema.Schema{Type: schema.TypeInt},
 						},
+						"computer_serial_numbers": {
+							Type:        schema.TypeSet,
+							Description: "Computers excluded from scope by serial number. Resolved to Jamf IDs at apply time.",
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"computer_udids": {
+							Type:        schema.TypeSet,
+							Description: "Computers excluded from scope by UDID. Resolved to Jamf IDs at apply time.",
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
 						"computer_group_ids": {
 							Type:        schema.TypeSet,
 							Description: "Computer Groups excluded from scope by Jamf ID.",
@@ -0,0 +1,161 @@
+// sharedschemas/macos_configuration_profile_scope.go
+package sharedschemas
+
+import (
+	"log"
+	"sort"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/constructors"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ConstructMacOSConfigurationProfileScope builds the macOS configuration profile scope from a
+// 'scope' block defined with GetSharedmacOSComputerSchemaScope.
+func ConstructMacOSConfigurationProfileScope(d *schema.ResourceData) jamfpro.MacOSConfigurationProfileSubsetScope {
+	scope := jamfpro.MacOSConfigurationProfileSubsetScope{}
+
+	scopeData := d.Get("scope").([]any)[0].(map[string]any)
+
+	scope.AllComputers = scopeData["all_computers"].(bool)
+	scope.AllJSSUsers = scopeData["all_jss_users"].(bool)
+
+	mapProfileScopeIDs("scope.0.computer_ids", d, &scope.Computers)
+	mapProfileScopeIDs("scope.0.computer_group_ids", d, &scope.ComputerGroups)
+	mapProfileScopeIDs("scope.0.building_ids", d, &scope.Buildings)
+	mapProfileScopeIDs("scope.0.department_ids", d, &scope.Departments)
+	mapProfileScopeIDs("scope.0.jss_user_ids", d, &scope.JSSUsers)
+	mapProfileScopeIDs("scope.0.jss_user_group_ids", d, &scope.JSSUserGroups)
+
+	if _, ok := d.GetOk("scope.0.limitations"); ok {
+		limitations := jamfpro.MacOSConfigurationProfileSubsetLimitations{}
+		mapProfileScopeNames("scope.0.limitations.0.directory_service_or_local_usernames", d, &limitations.Users)
+		mapProfileScopeIDs("scope.0.limitations.0.directory_service_usergroup_ids", d, &limitations.UserGroups)
+		mapProfileScopeIDs("scope.0.limitations.0.network_segment_ids", d, &limitations.NetworkSegments)
+		mapProfileScopeIDs("scope.0.limitations.0.ibeacon_ids", d, &limitations.IBeacons)
+		scope.Limitations = limitations
+	}
+
+	if _, ok := d.GetOk("scope.0.exclusions"); ok {
+		exclusions := jamfpro.MacOSConfigurationProfileSubsetExclusions{}
+		mapProfileScopeIDs("scope.0.exclusions.0.computer_ids", d, &exclusions.Computers)
+		mapProfileScopeIDs("scope.0.exclusions.0.computer_group_ids", d, &exclusions.ComputerGroups)
+		mapProfileScopeIDs("scope.0.exclusions.0.jss_user_ids", d, &exclusions.JSSUsers)
+		mapProfileScopeIDs("scope.0.exclusions.0.jss_user_group_ids", d, &exclusions.JSSUserGroups)
+		mapProfileScopeIDs("scope.0.exclusions.0.building_ids", d, &exclusions.Buildings)
+		mapProfileScopeIDs("scope.0.exclusions.0.department_ids", d, &exclusions.Departments)
+		mapProfileScopeIDs("scope.0.exclusions.0.network_segment_ids", d, &exclusions.NetworkSegments)
+		mapProfileScopeNames("scope.0.exclusions.0.directory_service_or_local_usernames", d, &exclusions.Users)
+		mapProfileScopeIDs("scope.0.exclusions.0.directory_service_usergroup_ids", d, &exclusions.UserGroups)
+		mapProfileScopeIDs("scope.0.exclusions.0.ibeacon_ids", d, &exclusions.IBeacons)
+		scope.Exclusions = exclusions
+	}
+
+	return scope
+}
+
+// FlattenMacOSConfigurationProfileScope converts a macOS configuration profile scope into the
+// 'scope' block layout of GetSharedmacOSComputerSchemaScope.
+func FlattenMacOSConfigurationProfileScope(scope jamfpro.MacOSConfigurationProfileSubsetScope) map[string]any {
+	scopeData := map[string]any{
+		"all_computers":      scope.AllComputers,
+		"all_jss_users":      scope.AllJSSUsers,
+		"computer_ids":       flattenProfileComputerIDs(scope.Computers),
+		"computer_group_ids": flattenProfileEntityIDs(scope.ComputerGroups),
+		"jss_user_ids":       flattenProfileEntityIDs(scope.JSSUsers),
+		"jss_user_group_ids": flattenProfileEntityIDs(scope.JSSUserGroups),
+		"building_ids":       flattenProfileEntityIDs(scope.Buildings),
+		"department_ids":     flattenProfileEntityIDs(scope.Departments),
+	}
+
+	limitations := map[string]any{}
+	setIfNotEmpty(limitations, "network_segment_ids", flattenProfileNetworkSegmentIDs(scope.Limitations.NetworkSegments))
+	setIfNotEmpty(limitations, "ibeacon_ids", flattenProfileEntityIDs(scope.Limitations.IBeacons))
+	setIfNotEmpty(limitations, "directory_service_or_local_usernames", flattenProfileEntityNames(scope.Limitations.Users))
+	setIfNotEmpty(limitations, "directory_service_usergroup_ids", flattenProfileEntityIDs(scope.Limitations.UserGroups))
+	if len(limitations) > 0 {
+		scopeData["limitations"] = []map[string]any{limitations}
+	}
+
+	exclusions := map[string]any{}
+	setIfNotEmpty(exclusions, "computer_ids", flattenProfileComputerIDs(scope.Exclusions.Computers))
+	setIfNotEmpty(exclusions, "computer_group_ids", flattenProfileEntityIDs(scope.Exclusions.ComputerGroups))
+	setIfNotEmpty(exclusions, "building_ids", flattenProfileEntityIDs(scope.Exclusions.Buildings))
+	setIfNotEmpty(exclusions, "jss_user_ids", flattenProfileEntityIDs(scope.Exclusions.JSSUsers))
+	setIfNotEmpty(exclusions, "jss_user_group_ids", flattenProfileEntityIDs(scope.Exclusions.JSSUserGroups))
+	setIfNotEmpty(exclusions, "department_ids", flattenProfileEntityIDs(scope.Exclusions.Departments))
+	setIfNotEmpty(exclusions, "network_segment_ids", flattenProfileNetworkSegmentIDs(scope.Exclusions.NetworkSegments))
+	setIfNotEmpty(exclusions, "directory_service_or_local_usernames", flattenProfileEntityNames(scope.Exclusions.Users))
+	setIfNotEmpty(exclusions, "directory_service_usergroup_ids", flattenProfileEntityIDs(scope.Exclusions.UserGroups))
+	setIfNotEmpty(exclusions, "ibeacon_ids", flattenProfileEntityIDs(scope.Exclusions.IBeacons))
+	if len(exclusions) > 0 {
+		scopeData["exclusions"] = []map[string]any{exclusions}
+	}
+
+	return scopeData
+}
+
+// mapProfileScopeIDs maps a set of IDs at path into scope structs with an 'ID' field.
+func mapProfileScopeIDs[T any](path string, d *schema.ResourceData, out *[]T) {
+	if err := constructors.MapSetToStructs[T, int](path, "ID", d, out); err != nil {
+		log.Printf("[WARN] Error mapping %s: %v", path, err)
+	}
+}
+
+// mapProfileScopeNames maps a set of names at path into scope structs with a 'Name' field.
+func mapProfileScopeNames[T any](path string, d *schema.ResourceData, out *[]T) {
+	if err := constructors.MapSetToStructs[T, string](path, "Name", d, out); err != nil {
+		log.Printf("[WARN] Error mapping %s: %v", path, err)
+	}
+}
+
+// setIfNotEmpty only adds non empty id or name lists to a flattened scope block.
+func setIfNotEmpty[T any](block map[string]any, key string, values []T) {
+	if len(values) > 0 {
+		block[key] = values
+	}
+}
+
+func flattenProfileEntityIDs(entities []jamfpro.MacOSConfigurationProfileSubsetScopeEntity) []int {
+	var ids []int
+	for _, entity := range entities {
+		if entity.ID != 0 {
+			ids = append(ids, entity.ID)
+		}
+	}
+	sort.Ints(ids)
+	return ids
+}
+
+func flattenProfileEntityNames(entities []jamfpro.MacOSConfigurationProfileSubsetScopeEntity) []string {
+	var names []string
+	for _, entity := range entities {
+		if entity.Name != "" {
+			names = append(names, entity.Name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func flattenProfileComputerIDs(computers []jamfpro.MacOSConfigurationProfileSubsetComputer) []int {
+	var ids []int
+	for _, computer := range computers {
+		if computer.ID != 0 {
+			ids = append(ids, computer.ID)
+		}
+	}
+	sort.Ints(ids)
+	return ids
+}
+
+func flattenProfileNetworkSegmentIDs(segments []jamfpro.MacOSConfigurationProfileSubsetNetworkSegment) []int {
+	var ids []int
+	for _, segment := range segments {
+		if segment.ID != 0 {
+			ids = append(ids, segment.ID)
+		}
+	}
+	sort.Ints(ids)
+	return ids
+}
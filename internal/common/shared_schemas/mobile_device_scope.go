@@ -21,6 +21,18 @@ func GetSharedMobileDeviceSchemaScope() *schema.Resource {
 				Description: "A list of mobile device IDs associated with the resource.",
 				Elem:        &schema.Schema{Type: schema.TypeInt},
 			},
+			"mobile_device_serial_numbers": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "A list of mobile device serial numbers associated with the resource. Resolved to Jamf IDs at apply time.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"mobile_device_udids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "A list of mobile device UDIDs associated with the resource. Resolved to Jamf IDs at apply time.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 			"mobile_device_group_ids": {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -98,6 +110,18 @@ func GetSharedMobileDeviceSchemaScope() *schema.Resource {
 							Description: "A list of mobile device IDs for exclusions.",
 							Elem:        &schema.Schema{Type: schema.TypeInt},
 						},
+						"mobile_device_serial_numbers": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "A list of mobile device serial numbers for exclusions. Resolved to Jamf IDs at apply time.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"mobile_device_udids": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "A list of mobile device UDIDs for exclusions. Resolved to Jamf IDs at apply time.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
 						"mobile_device_group_ids": {
 							Type:        schema.TypeSet,
 							Optional:    true,
@@ -0,0 +1,74 @@
+// endpoints/common/sharedschemas/payload_uuid.go
+package sharedschemas
+
+import (
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/plist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// GetSharedSchemaPayloadUUIDMode returns the schema controlling how profile PayloadUUIDs are generated.
+func GetSharedSchemaPayloadUUIDMode() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Default:  plist.PayloadUUIDModeRandom,
+		Description: "How PayloadUUID and PayloadIdentifier values are generated. 'random' (default) uses new random UUIDs " +
+			"when the profile is created. 'deterministic' derives UUIDv5 values from the Jamf Pro instance FQDN, " +
+			"'payload_uuid_key' and the payload index, so a profile replaced on the same tenant keeps stable payload " +
+			"identifiers. Because the FQDN is part of the derivation, the same configuration applied to another tenant " +
+			"deliberately gets different identifiers.",
+		ValidateFunc: validation.StringInSlice([]string{plist.PayloadUUIDModeRandom, plist.PayloadUUIDModeDeterministic}, false),
+	}
+}
+
+// GetSharedSchemaPayloadUUIDKey returns the schema for the stable key used by deterministic payload UUIDs.
+func GetSharedSchemaPayloadUUIDKey() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Description: "Stable key from which deterministic payload UUIDs are derived, conventionally the resource address " +
+			"(e.g. 'jamfpro_macos_configuration_profile_plist.wifi'). Required when 'payload_uuid_mode' is 'deterministic'.",
+		ValidateFunc: validation.StringIsNotWhiteSpace,
+	}
+}
+
+// ValidatePayloadUUIDKey ensures 'payload_uuid_key' is set when 'payload_uuid_mode' is deterministic.
+func ValidatePayloadUUIDKey(diff *schema.ResourceDiff, resourceType string) error {
+	if diff.Get("payload_uuid_mode").(string) != plist.PayloadUUIDModeDeterministic {
+		return nil
+	}
+
+	if raw := diff.GetRawConfig(); !raw.IsNull() && !raw.GetAttr("payload_uuid_key").IsWhollyKnown() {
+		return nil
+	}
+
+	if diff.Get("payload_uuid_key").(string) == "" {
+		return fmt.Errorf("in '%s.%s': 'payload_uuid_key' is required when 'payload_uuid_mode' is '%s'", resourceType, diff.Get("name").(string), plist.PayloadUUIDModeDeterministic)
+	}
+
+	return nil
+}
+
+// GetDeterministicPayloadUUIDSource returns the Jamf Pro instance FQDN and payload UUID key used to
+// derive deterministic payload UUIDs. ok is false when the resource uses random payload UUIDs.
+func GetDeterministicPayloadUUIDSource(d *schema.ResourceData, client *jamfpro.Client) (fqdn string, key string, ok bool, err error) {
+	if d.Get("payload_uuid_mode").(string) != plist.PayloadUUIDModeDeterministic {
+		return "", "", false, nil
+	}
+
+	if client == nil || client.HTTP == nil || client.HTTP.Integration == nil || *client.HTTP.Integration == nil {
+		return "", "", false, fmt.Errorf("unable to determine the Jamf Pro instance FQDN for deterministic payload UUIDs")
+	}
+
+	fqdn = (*client.HTTP.Integration).GetFQDN()
+	key = d.Get("payload_uuid_key").(string)
+	if fqdn == "" || key == "" {
+		return "", "", false, fmt.Errorf("deterministic payload UUIDs require both the Jamf Pro instance FQDN and 'payload_uuid_key'")
+	}
+
+	return fqdn, key, true, nil
+}
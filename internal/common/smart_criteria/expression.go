@@ -0,0 +1,412 @@
+// common/smart_criteria/expression.go
+// Description: This file contains the parser and renderer for criteria expressions, a readable
+// alternative to the Classic API criteria list used by smart groups and advanced searches.
+//
+// Example expression:
+//
+//	("Operating System Version" like "15." or "Model" is "MacBookAir10,1") and not "Computer Group" member of "Lab"
+//
+// Each condition is a quoted criterion name, a search type and a value. Conditions are joined with
+// 'and' / 'or' and may be grouped with one level of parentheses, matching what Jamf Pro can store.
+// 'not' negates a single condition by switching to the opposite search type.
+package smart_criteria
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const (
+	andOperator = "and"
+	orOperator  = "or"
+	notOperator = "not"
+)
+
+// searchTypes lists every search type understood by Jamf Pro smart groups and advanced searches.
+var searchTypes = []string{
+	"is",
+	"is not",
+	"has",
+	"does not have",
+	"member of",
+	"not member of",
+	"before (yyyy-mm-dd)",
+	"after (yyyy-mm-dd)",
+	"more than x days ago",
+	"less than x days ago",
+	"like",
+	"not like",
+	"greater than",
+	"more than",
+	"less than",
+	"greater than or equal",
+	"less than or equal",
+	"matches regex",
+	"does not match regex",
+}
+
+// negatedSearchTypes maps each search type that 'not' can be applied to onto its opposite.
+var negatedSearchTypes = map[string]string{
+	"is":                   "is not",
+	"is not":               "is",
+	"has":                  "does not have",
+	"does not have":        "has",
+	"member of":            "not member of",
+	"not member of":        "member of",
+	"like":                 "not like",
+	"not like":             "like",
+	"matches regex":        "does not match regex",
+	"does not match regex": "matches regex",
+}
+
+// Criterion is a single entry of the Classic API criteria list.
+type Criterion struct {
+	Name         string
+	Priority     int
+	AndOr        string
+	SearchType   string
+	Value        string
+	OpeningParen bool
+	ClosingParen bool
+}
+
+// ParseExpression parses a criteria expression into an ordered criteria list with priorities and
+// parenthesis flags computed from the expression.
+func ParseExpression(expression string) ([]Criterion, error) {
+	p := &expressionParser{input: expression}
+
+	p.skipSpace()
+	if p.eof() {
+		return nil, fmt.Errorf("criteria expression is empty")
+	}
+
+	var criteria []Criterion
+	connective := andOperator
+	for {
+		group, err := p.parseTerm()
+		if err != nil {
+			return nil, err
+		}
+		group[0].AndOr = connective
+		criteria = append(criteria, group...)
+
+		p.skipSpace()
+		if p.eof() {
+			break
+		}
+
+		connective, err = p.parseConnective()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for i := range criteria {
+		criteria[i].Priority = i
+	}
+
+	return criteria, nil
+}
+
+// RenderExpression renders a criteria list as a criteria expression. Criteria are ordered by
+// priority; the 'and_or' of the first criterion is ignored as Jamf Pro does.
+func RenderExpression(criteria []Criterion) string {
+	ordered := make([]Criterion, len(criteria))
+	copy(ordered, criteria)
+	sort.SliceStable(ordered, func(i, j int) bool { return ordered[i].Priority < ordered[j].Priority })
+
+	var b strings.Builder
+	for i, c := range ordered {
+		if i > 0 {
+			andOr := strings.ToLower(c.AndOr)
+			if andOr != orOperator {
+				andOr = andOperator
+			}
+			fmt.Fprintf(&b, " %s ", andOr)
+		}
+		if c.OpeningParen {
+			b.WriteString("(")
+		}
+		fmt.Fprintf(&b, "%s %s %s", quote(c.Name), c.SearchType, quote(c.Value))
+		if c.ClosingParen {
+			b.WriteString(")")
+		}
+	}
+
+	return b.String()
+}
+
+// CanonicalExpression returns the canonical rendering of an expression, so expressions that
+// differ only in spacing, keyword case, quoting, redundant parentheses or 'not' placement compare
+// equal.
+func CanonicalExpression(expression string) (string, error) {
+	criteria, err := ParseExpression(expression)
+	if err != nil {
+		return "", err
+	}
+	return RenderExpression(criteria), nil
+}
+
+// SearchTypes returns the search types accepted in criteria expressions.
+func SearchTypes() []string {
+	out := make([]string, len(searchTypes))
+	copy(out, searchTypes)
+	return out
+}
+
+// expressionParser is a recursive-descent parser over the raw expression text. The input is
+// scanned directly rather than tokenized up front because search types such as
+// 'before (yyyy-mm-dd)' contain parentheses.
+type expressionParser struct {
+	input string
+	pos   int
+}
+
+// parseTerm parses a single condition or a parenthesised group of conditions.
+func (p *expressionParser) parseTerm() ([]Criterion, error) {
+	p.skipSpace()
+	if !p.consume("(") {
+		c, err := p.parseCondition()
+		if err != nil {
+			return nil, err
+		}
+		return []Criterion{c}, nil
+	}
+
+	start := p.pos - 1
+	first, err := p.parseCondition()
+	if err != nil {
+		return nil, err
+	}
+	group := []Criterion{first}
+
+	for {
+		p.skipSpace()
+		if p.consume(")") {
+			break
+		}
+		if p.eof() {
+			return nil, fmt.Errorf("unbalanced parentheses: group opened at position %d is never closed", start+1)
+		}
+
+		connective, err := p.parseConnective()
+		if err != nil {
+			return nil, err
+		}
+
+		p.skipSpace()
+		if p.peek() == '(' {
+			return nil, fmt.Errorf("nested parentheses at position %d are not supported; Jamf Pro criteria support a single level of grouping", p.pos+1)
+		}
+
+		c, err := p.parseCondition()
+		if err != nil {
+			return nil, err
+		}
+		c.AndOr = connective
+		group = append(group, c)
+	}
+
+	// A group of one condition needs no parentheses.
+	if len(group) > 1 {
+		group[0].OpeningParen = true
+		group[len(group)-1].ClosingParen = true
+	}
+
+	return group, nil
+}
+
+// parseCondition parses '[not] "Name" <search type> "Value"'.
+func (p *expressionParser) parseCondition() (Criterion, error) {
+	p.skipSpace()
+
+	negate := false
+	if p.consumeKeyword(notOperator) {
+		negate = true
+		p.skipSpace()
+		if p.peek() == '(' {
+			return Criterion{}, fmt.Errorf("'not' at position %d can only be applied to a single condition, not a group", p.pos+1)
+		}
+	}
+
+	if p.peek() != '"' {
+		return Criterion{}, p.errorf("expected a quoted criterion name")
+	}
+	name, err := p.parseQuoted()
+	if err != nil {
+		return Criterion{}, err
+	}
+	if strings.TrimSpace(name) == "" {
+		return Criterion{}, p.errorf("criterion name must not be empty")
+	}
+
+	p.skipSpace()
+	searchType, err := p.parseSearchType()
+	if err != nil {
+		return Criterion{}, err
+	}
+
+	p.skipSpace()
+	value, err := p.parseValue()
+	if err != nil {
+		return Criterion{}, err
+	}
+
+	if negate {
+		negated, ok := negatedSearchTypes[searchType]
+		if !ok {
+			return Criterion{}, fmt.Errorf("'not' cannot be applied to search type '%s' of criterion '%s'", searchType, name)
+		}
+		searchType = negated
+	}
+
+	return Criterion{Name: name, SearchType: searchType, Value: value}, nil
+}
+
+// parseSearchType matches the longest known search type at the current position.
+func (p *expressionParser) parseSearchType() (string, error) {
+	rest := strings.ToLower(p.input[p.pos:])
+
+	best := ""
+	for _, st := range searchTypes {
+		if len(st) > len(best) && strings.HasPrefix(rest, st) && p.isBoundary(p.pos+len(st)) {
+			best = st
+		}
+	}
+	if best == "" {
+		return "", p.errorf("expected a search type, one of %s", strings.Join(searchTypes, ", "))
+	}
+
+	p.pos += len(best)
+	return best, nil
+}
+
+// parseValue parses a quoted value, or a bare value running to the next space or parenthesis.
+func (p *expressionParser) parseValue() (string, error) {
+	if p.peek() == '"' {
+		return p.parseQuoted()
+	}
+
+	start := p.pos
+	for !p.eof() {
+		r := rune(p.input[p.pos])
+		if unicode.IsSpace(r) || r == '(' || r == ')' {
+			break
+		}
+		p.pos++
+	}
+	if p.pos == start {
+		return "", p.errorf("expected a value")
+	}
+
+	return p.input[start:p.pos], nil
+}
+
+// parseQuoted parses a double quoted string supporting \" and \\ escapes.
+func (p *expressionParser) parseQuoted() (string, error) {
+	start := p.pos
+	p.pos++
+
+	var b strings.Builder
+	for !p.eof() {
+		ch := p.input[p.pos]
+		switch ch {
+		case '\\':
+			if p.pos+1 < len(p.input) {
+				b.WriteByte(p.input[p.pos+1])
+				p.pos += 2
+				continue
+			}
+		case '"':
+			p.pos++
+			return b.String(), nil
+		}
+		b.WriteByte(ch)
+		p.pos++
+	}
+
+	return "", fmt.Errorf("unterminated string starting at position %d", start+1)
+}
+
+// parseConnective parses 'and' or 'or'.
+func (p *expressionParser) parseConnective() (string, error) {
+	p.skipSpace()
+	if p.consumeKeyword(andOperator) {
+		return andOperator, nil
+	}
+	if p.consumeKeyword(orOperator) {
+		return orOperator, nil
+	}
+	if p.peek() == ')' {
+		return "", p.errorf("unbalanced parentheses: unexpected ')'")
+	}
+	return "", p.errorf("expected 'and' or 'or'")
+}
+
+// consumeKeyword consumes a case-insensitive keyword followed by a word boundary.
+func (p *expressionParser) consumeKeyword(keyword string) bool {
+	end := p.pos + len(keyword)
+	if end > len(p.input) || !strings.EqualFold(p.input[p.pos:end], keyword) || !p.isBoundary(end) {
+		return false
+	}
+	p.pos = end
+	return true
+}
+
+// isBoundary reports whether the character at pos ends a word.
+func (p *expressionParser) isBoundary(pos int) bool {
+	if pos >= len(p.input) {
+		return true
+	}
+	ch := rune(p.input[pos])
+	return unicode.IsSpace(ch) || ch == '"' || ch == '(' || ch == ')'
+}
+
+func (p *expressionParser) consume(s string) bool {
+	if strings.HasPrefix(p.input[p.pos:], s) {
+		p.pos += len(s)
+		return true
+	}
+	return false
+}
+
+func (p *expressionParser) peek() byte {
+	if p.eof() {
+		return 0
+	}
+	return p.input[p.pos]
+}
+
+func (p *expressionParser) skipSpace() {
+	for !p.eof() && unicode.IsSpace(rune(p.input[p.pos])) {
+		p.pos++
+	}
+}
+
+func (p *expressionParser) eof() bool {
+	return p.pos >= len(p.input)
+}
+
+func (p *expressionParser) errorf(format string, args ...any) error {
+	found := "end of expression"
+	if !p.eof() {
+		found = fmt.Sprintf("'%s'", truncate(p.input[p.pos:], 20))
+	}
+	return fmt.Errorf("%s at position %d, found %s", fmt.Sprintf(format, args...), p.pos+1, found)
+}
+
+// quote renders s as a double quoted string.
+func quote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	return `"` + s + `"`
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n] + "..."
+}
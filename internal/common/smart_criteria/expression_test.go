@@ -0,0 +1,95 @@
+package smart_criteria
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseExpression(t *testing.T) {
+	criteria, err := ParseExpression(`("Operating System Version" like "15." or "Model" is "MacBookAir10,1") and not "Computer Group" member of "Lab"`)
+	require.NoError(t, err)
+
+	assert.Equal(t, []Criterion{
+		{Name: "Operating System Version", Priority: 0, AndOr: "and", SearchType: "like", Value: "15.", OpeningParen: true},
+		{Name: "Model", Priority: 1, AndOr: "or", SearchType: "is", Value: "MacBookAir10,1", ClosingParen: true},
+		{Name: "Computer Group", Priority: 2, AndOr: "and", SearchType: "not member of", Value: "Lab"},
+	}, criteria)
+}
+
+func TestParseExpressionSearchTypes(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		searchType string
+		value      string
+	}{
+		{name: "Longest search type wins", expression: `"Total RAM MB" greater than or equal 8192`, searchType: "greater than or equal", value: "8192"},
+		{name: "Search type containing parentheses", expression: `"Last Check-in" before (yyyy-mm-dd) "2025-01-01"`, searchType: "before (yyyy-mm-dd)", value: "2025-01-01"},
+		{name: "Case insensitive search type", expression: `"Model" IS NOT "iMac"`, searchType: "is not", value: "iMac"},
+		{name: "Value starting like a keyword", expression: `"Model" is notarized`, searchType: "is", value: "notarized"},
+		{name: "Escaped quotes", expression: `"Computer Name" like "Bob\"s Mac"`, searchType: "like", value: `Bob"s Mac`},
+		{name: "Empty value", expression: `"Department" is ""`, searchType: "is", value: ""},
+		{name: "Negated regex", expression: `not "Computer Name" matches regex "^LAB-"`, searchType: "does not match regex", value: "^LAB-"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			criteria, err := ParseExpression(tt.expression)
+			require.NoError(t, err)
+			require.Len(t, criteria, 1)
+			assert.Equal(t, tt.searchType, criteria[0].SearchType)
+			assert.Equal(t, tt.value, criteria[0].Value)
+		})
+	}
+}
+
+func TestParseExpressionErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+	}{
+		{name: "Empty", expression: "  "},
+		{name: "Unquoted name", expression: `Model is "iMac"`},
+		{name: "Unknown search type", expression: `"Model" equals "iMac"`},
+		{name: "Missing value", expression: `"Model" is`},
+		{name: "Dangling connective", expression: `"Model" is "iMac" and`},
+		{name: "Missing connective", expression: `"Model" is "iMac" "Building" is "HQ"`},
+		{name: "Unclosed group", expression: `("Model" is "iMac" or "Model" is "Mac mini"`},
+		{name: "Unexpected closing parenthesis", expression: `"Model" is "iMac")`},
+		{name: "Nested groups", expression: `("Model" is "iMac" or ("Building" is "HQ" and "Room" is "1"))`},
+		{name: "Negated group", expression: `not ("Model" is "iMac" or "Model" is "Mac mini")`},
+		{name: "Negation without opposite", expression: `not "Last Check-in" more than x days ago "7"`},
+		{name: "Unterminated string", expression: `"Model is "iMac`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseExpression(tt.expression)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestRenderExpressionRoundTrip(t *testing.T) {
+	expression := `("Operating System Version" like "15." or "Model" is "MacBookAir10,1") and "Computer Group" not member of "Lab"`
+
+	criteria, err := ParseExpression(expression)
+	require.NoError(t, err)
+	assert.Equal(t, expression, RenderExpression(criteria))
+
+	// Criteria returned by Jamf Pro may be out of priority order.
+	criteria[0], criteria[2] = criteria[2], criteria[0]
+	assert.Equal(t, expression, RenderExpression(criteria))
+}
+
+func TestCanonicalExpression(t *testing.T) {
+	a, err := CanonicalExpression(`("Model" is "iMac")   AND not "Building" is HQ`)
+	require.NoError(t, err)
+
+	b, err := CanonicalExpression(`"Model" is "iMac" and "Building" is not "HQ"`)
+	require.NoError(t, err)
+
+	assert.Equal(t, a, b)
+}
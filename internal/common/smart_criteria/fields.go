@@ -0,0 +1,318 @@
+// common/smart_criteria/fields.go
+// Description: This file contains the built-in criterion fields of computer and mobile device smart
+// groups, the search types valid for each field data type and the lookup of the tenant's extension
+// attributes used for plan time criteria validation.
+package smart_criteria
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+// Criterion field data types.
+const (
+	DataTypeString  = "string"
+	DataTypeVersion = "version"
+	DataTypeInteger = "integer"
+	DataTypeDate    = "date"
+	DataTypeGroup   = "group"
+)
+
+// searchTypesByDataType lists the search types Jamf Pro offers for each field data type.
+var searchTypesByDataType = map[string][]string{
+	DataTypeString: {
+		"is", "is not", "like", "not like", "has", "does not have", "matches regex", "does not match regex",
+	},
+	DataTypeVersion: {
+		"is", "is not", "like", "not like", "has", "does not have", "greater than", "less than",
+		"greater than or equal", "less than or equal", "matches regex", "does not match regex",
+	},
+	DataTypeInteger: {
+		"is", "is not", "like", "not like", "more than", "less than", "greater than", "greater than or equal",
+		"less than or equal", "matches regex", "does not match regex",
+	},
+	DataTypeDate: {
+		"is", "is not", "like", "not like", "before (yyyy-mm-dd)", "after (yyyy-mm-dd)", "more than x days ago",
+		"less than x days ago", "matches regex", "does not match regex",
+	},
+	DataTypeGroup: {
+		"member of", "not member of",
+	},
+}
+
+// purchasingFields are the purchasing criteria shared by computers and mobile devices.
+var purchasingFields = map[string]string{
+	"AppleCare ID":        DataTypeString,
+	"Lease Expiration":    DataTypeDate,
+	"Life Expectancy":     DataTypeInteger,
+	"PO Date":             DataTypeDate,
+	"PO Number":           DataTypeString,
+	"Purchase Price":      DataTypeString,
+	"Purchased or Leased": DataTypeString,
+	"Purchasing Account":  DataTypeString,
+	"Purchasing Contact":  DataTypeString,
+	"Vendor":              DataTypeString,
+	"Warranty Expiration": DataTypeDate,
+}
+
+// userAndLocationFields are the user and location criteria shared by computers and mobile devices.
+var userAndLocationFields = map[string]string{
+	"Building":      DataTypeString,
+	"Department":    DataTypeString,
+	"Email Address": DataTypeString,
+	"Full Name":     DataTypeString,
+	"Phone Number":  DataTypeString,
+	"Position":      DataTypeString,
+	"Room":          DataTypeString,
+	"Username":      DataTypeString,
+}
+
+// computerFields are the built-in smart computer group criteria.
+var computerFields = map[string]string{
+	"Activation Lock Enabled":                           DataTypeString,
+	"Active Directory Status":                           DataTypeString,
+	"Alternate MAC Address":                             DataTypeString,
+	"Apple Silicon":                                     DataTypeString,
+	"Application Bundle ID":                             DataTypeString,
+	"Application Title":                                 DataTypeString,
+	"Application Version":                               DataTypeVersion,
+	"Architecture Type":                                 DataTypeString,
+	"Asset Tag":                                         DataTypeString,
+	"Automatic Login User":                              DataTypeString,
+	"Available SWUs":                                    DataTypeString,
+	"Bar Code":                                          DataTypeString,
+	"Battery Capacity":                                  DataTypeInteger,
+	"Bluetooth Low Energy Capability":                   DataTypeString,
+	"Boot Drive Available MB":                           DataTypeInteger,
+	"Boot Drive Percentage Full":                        DataTypeInteger,
+	"Boot ROM":                                          DataTypeString,
+	"Bootstrap Token Allowed":                           DataTypeString,
+	"Bootstrap Token Escrowed":                          DataTypeString,
+	"Bus Speed MHz":                                     DataTypeInteger,
+	"Cached Packages":                                   DataTypeString,
+	"Certificate Expiration Date":                       DataTypeDate,
+	"Certificate Issuer":                                DataTypeString,
+	"Certificate Name":                                  DataTypeString,
+	"Certificate Subject":                               DataTypeString,
+	"Computer Group":                                    DataTypeGroup,
+	"Computer Name":                                     DataTypeString,
+	"Declarative Device Management Enabled":             DataTypeString,
+	"Disk Encryption Configuration":                     DataTypeString,
+	"Drive Capacity MB":                                 DataTypeInteger,
+	"Enrolled via Automated Device Enrollment":          DataTypeString,
+	"Enrollment Method: PreStage enrollment":            DataTypeString,
+	"Enrollment Method: User-initiated - invitation":    DataTypeString,
+	"Enrollment Method: User-initiated - no invitation": DataTypeString,
+	"External Boot Level":                               DataTypeString,
+	"FileVault 2 Individual Key Validation":             DataTypeString,
+	"FileVault 2 Partition Encryption State":            DataTypeString,
+	"FileVault 2 Status":                                DataTypeString,
+	"Firewall Enabled":                                  DataTypeString,
+	"Font Title":                                        DataTypeString,
+	"Gatekeeper":                                        DataTypeString,
+	"Home Directory":                                    DataTypeString,
+	"Home Directory Size MB":                            DataTypeInteger,
+	"IP Address":                                        DataTypeString,
+	"Jamf Binary Version":                               DataTypeString,
+	"Last Check-in":                                     DataTypeDate,
+	"Last Enrollment":                                   DataTypeDate,
+	"Last iCloud Backup":                                DataTypeDate,
+	"Last Inventory Update":                             DataTypeDate,
+	"Last Reported IP Address":                          DataTypeString,
+	"Licensed Software":                                 DataTypeString,
+	"Local User Accounts":                               DataTypeString,
+	"MAC Address":                                       DataTypeString,
+	"Mac App Store Apps":                                DataTypeString,
+	"Make":                                              DataTypeString,
+	"Managed":                                           DataTypeString,
+	"Master Password Set":                               DataTypeString,
+	"Model":                                             DataTypeString,
+	"Model Identifier":                                  DataTypeString,
+	"NIC Speed":                                         DataTypeString,
+	"Number of Available Updates":                       DataTypeInteger,
+	"Number of Processors":                              DataTypeInteger,
+	"Operating System":                                  DataTypeString,
+	"Operating System Build":                            DataTypeString,
+	"Operating System Name":                             DataTypeString,
+	"Operating System Rapid Security Response":          DataTypeString,
+	"Operating System Version":                          DataTypeVersion,
+	"Optical Drive":                                     DataTypeString,
+	"Packages Installed By Casper":                      DataTypeString,
+	"Packages Installed By Installer.app/SWU":           DataTypeString,
+	"Platform":                                          DataTypeString,
+	"Platform SSO Status":                               DataTypeString,
+	"Plug-in Title":                                     DataTypeString,
+	"Printer":                                           DataTypeString,
+	"Processor Speed MHz":                               DataTypeInteger,
+	"Processor Type":                                    DataTypeString,
+	"Profile Identifier":                                DataTypeString,
+	"Profile Name":                                      DataTypeString,
+	"Recovery Lock Enabled":                             DataTypeString,
+	"Remote Desktop Enabled":                            DataTypeString,
+	"Reported IP Address":                               DataTypeString,
+	"Secure Boot Level":                                 DataTypeString,
+	"Serial Number":                                     DataTypeString,
+	"Service":                                           DataTypeString,
+	"Site":                                              DataTypeString,
+	"SMC Version":                                       DataTypeString,
+	"Software Update Device ID":                         DataTypeString,
+	"Supervised":                                        DataTypeString,
+	"System Integrity Protection":                       DataTypeString,
+	"Total Number of Cores":                             DataTypeInteger,
+	"Total RAM MB":                                      DataTypeInteger,
+	"UDID":                                              DataTypeString,
+	"User Approved Enrollment":                          DataTypeString,
+	"User Approved MDM":                                 DataTypeString,
+	"XProtect Definitions Version":                      DataTypeString,
+}
+
+// mobileDeviceFields are the built-in smart mobile device group criteria.
+var mobileDeviceFields = map[string]string{
+	"Activation Lock Enabled":                           DataTypeString,
+	"App Identifier":                                    DataTypeString,
+	"App Name":                                          DataTypeString,
+	"App Version":                                       DataTypeVersion,
+	"Asset Tag":                                         DataTypeString,
+	"Available Space MB":                                DataTypeInteger,
+	"Battery Health":                                    DataTypeString,
+	"Battery Level":                                     DataTypeInteger,
+	"Block Level Encryption Capable":                    DataTypeString,
+	"Bluetooth MAC Address":                             DataTypeString,
+	"Capacity MB":                                       DataTypeInteger,
+	"Carrier Settings Version":                          DataTypeString,
+	"Cellular Technology":                               DataTypeString,
+	"Certificate Expiration Date":                       DataTypeDate,
+	"Certificate Name":                                  DataTypeString,
+	"Current Carrier Network":                           DataTypeString,
+	"Data Protection":                                   DataTypeString,
+	"Data Roaming Enabled":                              DataTypeString,
+	"Declarative Device Management Enabled":             DataTypeString,
+	"Device Locator Service Enabled":                    DataTypeString,
+	"Device Name":                                       DataTypeString,
+	"Device Ownership Type":                             DataTypeString,
+	"Diagnostic and Usage Reporting Enabled":            DataTypeString,
+	"Display Name":                                      DataTypeString,
+	"Do Not Disturb Enabled":                            DataTypeString,
+	"Enrollment Method: PreStage enrollment":            DataTypeString,
+	"Enrollment Method: User-initiated - invitation":    DataTypeString,
+	"Enrollment Method: User-initiated - no invitation": DataTypeString,
+	"Exchange Device ID":                                DataTypeString,
+	"File Level Encryption Capable":                     DataTypeString,
+	"Hardware Encryption":                               DataTypeString,
+	"Home Carrier Network":                              DataTypeString,
+	"ICCID":                                             DataTypeString,
+	"iCloud Backup Enabled":                             DataTypeString,
+	"IMEI":                                              DataTypeString,
+	"IP Address":                                        DataTypeString,
+	"iTunes Store Account":                              DataTypeString,
+	"Jailbreak Detected":                                DataTypeString,
+	"Languages":                                         DataTypeString,
+	"Last Backup":                                       DataTypeDate,
+	"Last Enrollment":                                   DataTypeDate,
+	"Last iCloud Backup":                                DataTypeDate,
+	"Last Inventory Update":                             DataTypeDate,
+	"Locales":                                           DataTypeString,
+	"Lost Mode Enabled":                                 DataTypeString,
+	"Managed":                                           DataTypeString,
+	"MEID":                                              DataTypeString,
+	"Mobile Device Group":                               DataTypeGroup,
+	"Model":                                             DataTypeString,
+	"Model Identifier":                                  DataTypeString,
+	"Model Number":                                      DataTypeString,
+	"Modem Firmware Version":                            DataTypeString,
+	"OS Build":                                          DataTypeString,
+	"OS Rapid Security Response":                        DataTypeString,
+	"OS Version":                                        DataTypeVersion,
+	"Passcode Status":                                   DataTypeString,
+	"Percentage of Capacity Used":                       DataTypeInteger,
+	"Personal Hotspot Enabled":                          DataTypeString,
+	"Profile Identifier":                                DataTypeString,
+	"Profile Name":                                      DataTypeString,
+	"Provisioning Profile Name":                         DataTypeString,
+	"Roaming":                                           DataTypeString,
+	"Serial Number":                                     DataTypeString,
+	"Shared iPad":                                       DataTypeString,
+	"Site":                                              DataTypeString,
+	"Software Update Device ID":                         DataTypeString,
+	"Supervised":                                        DataTypeString,
+	"Tethered":                                          DataTypeString,
+	"Time Zone":                                         DataTypeString,
+	"UDID":                                              DataTypeString,
+	"Voice Roaming Enabled":                             DataTypeString,
+	"Wi-Fi MAC Address":                                 DataTypeString,
+}
+
+// BuiltInComputerFields returns the built-in smart computer group criteria mapped to their data type.
+func BuiltInComputerFields() map[string]string {
+	return mergeFields(computerFields, userAndLocationFields, purchasingFields)
+}
+
+// BuiltInMobileDeviceFields returns the built-in smart mobile device group criteria mapped to their
+// data type.
+func BuiltInMobileDeviceFields() map[string]string {
+	return mergeFields(mobileDeviceFields, userAndLocationFields, purchasingFields)
+}
+
+// GetComputerCriteriaFields returns the built-in computer criteria together with the computer
+// extension attributes defined in Jamf Pro.
+func GetComputerCriteriaFields(client *jamfpro.Client) (map[string]string, error) {
+	eas, err := client.GetComputerExtensionAttributes(nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch computer extension attributes: %v", err)
+	}
+
+	fields := BuiltInComputerFields()
+	for _, ea := range eas.Results {
+		fields[ea.Name] = extensionAttributeDataType(ea.DataType)
+	}
+
+	return fields, nil
+}
+
+// GetMobileDeviceCriteriaFields returns the built-in mobile device criteria together with the mobile
+// device extension attributes defined in Jamf Pro.
+func GetMobileDeviceCriteriaFields(client *jamfpro.Client) (map[string]string, error) {
+	eas, err := client.GetMobileDeviceExtensionAttributes(nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch mobile device extension attributes: %v", err)
+	}
+
+	fields := BuiltInMobileDeviceFields()
+	for _, ea := range eas.Results {
+		fields[ea.Name] = extensionAttributeDataType(ea.DataType)
+	}
+
+	return fields, nil
+}
+
+// SearchTypesForDataType returns the search types valid for a field data type.
+func SearchTypesForDataType(dataType string) []string {
+	out := make([]string, len(searchTypesByDataType[dataType]))
+	copy(out, searchTypesByDataType[dataType])
+	return out
+}
+
+// extensionAttributeDataType maps a Jamf Pro API extension attribute dataType onto a criterion
+// field data type.
+func extensionAttributeDataType(dataType string) string {
+	switch strings.ToUpper(dataType) {
+	case "INTEGER":
+		return DataTypeInteger
+	case "DATE":
+		return DataTypeDate
+	default:
+		return DataTypeString
+	}
+}
+
+func mergeFields(sources ...map[string]string) map[string]string {
+	out := make(map[string]string)
+	for _, source := range sources {
+		for name, dataType := range source {
+			out[name] = dataType
+		}
+	}
+	return out
+}
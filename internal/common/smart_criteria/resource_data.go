@@ -0,0 +1,146 @@
+// common/smart_criteria/resource_data.go
+// Description: This file contains helpers that connect the 'criteria_expression' attribute with the
+// 'criteria' list blocks of smart groups and advanced searches.
+package smart_criteria
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// GetSchemaCriteriaExpression returns the 'criteria_expression' attribute schema, an alternative
+// to hand-numbered 'criteria' blocks.
+func GetSchemaCriteriaExpression() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Description: "Criteria as a boolean expression, an alternative to 'criteria' blocks. Each condition is a quoted " +
+			"criterion name, a search type and a value, joined with 'and' / 'or', e.g. " +
+			"`(\"Operating System Version\" like \"15.\" or \"Model\" is \"MacBookAir10,1\") and not \"Computer Group\" member of \"Lab\"`. " +
+			"Conditions may be grouped with one level of parentheses and 'not' negates a single condition. " +
+			"Priorities and parenthesis flags are computed from the expression.",
+		ConflictsWith:    []string{"criteria"},
+		ValidateFunc:     validateExpression,
+		DiffSuppressFunc: diffSuppressExpression,
+	}
+}
+
+// validateExpression reports criteria expressions that cannot be parsed.
+func validateExpression(val any, key string) (warns []string, errs []error) {
+	expression, ok := val.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("%q must be a string, got: %T", key, val)}
+	}
+
+	if _, err := ParseExpression(expression); err != nil {
+		errs = append(errs, fmt.Errorf("%q is not a valid criteria expression: %v", key, err))
+	}
+
+	return warns, errs
+}
+
+// diffSuppressExpression suppresses differences between expressions that produce the same criteria.
+func diffSuppressExpression(_, old, new string, _ *schema.ResourceData) bool {
+	oldCanonical, err := CanonicalExpression(old)
+	if err != nil {
+		return false
+	}
+	newCanonical, err := CanonicalExpression(new)
+	if err != nil {
+		return false
+	}
+	return oldCanonical == newCanonical
+}
+
+// resourceGetter is satisfied by both *schema.ResourceData and *schema.ResourceDiff.
+type resourceGetter interface {
+	Get(key string) any
+}
+
+// GetCriteriaList returns the criteria of a resource as 'criteria' block maps, parsing
+// 'criteria_expression' when it is set.
+func GetCriteriaList(d resourceGetter) ([]any, error) {
+	expression := d.Get("criteria_expression").(string)
+	if expression == "" {
+		return d.Get("criteria").([]any), nil
+	}
+
+	criteria, err := ParseExpression(expression)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse 'criteria_expression': %v", err)
+	}
+
+	out := make([]any, len(criteria))
+	for i, c := range criteria {
+		out[i] = map[string]any{
+			"name":          c.Name,
+			"priority":      c.Priority,
+			"and_or":        c.AndOr,
+			"search_type":   c.SearchType,
+			"value":         c.Value,
+			"opening_paren": c.OpeningParen,
+			"closing_paren": c.ClosingParen,
+		}
+	}
+
+	return out, nil
+}
+
+// SetCriteriaState stores criteria read from Jamf Pro, given as 'criteria' block maps. Resources
+// configured with 'criteria_expression' have the criteria rendered back to an expression and the
+// 'criteria' blocks left empty; otherwise the blocks are set as read.
+func SetCriteriaState(d *schema.ResourceData, criteriaList []any) error {
+	if d.Get("criteria_expression").(string) == "" {
+		return d.Set("criteria", criteriaList)
+	}
+
+	criteria := CriteriaFromMaps(criteriaList)
+
+	if err := d.Set("criteria_expression", RenderExpression(criteria)); err != nil {
+		return err
+	}
+	return d.Set("criteria", []any{})
+}
+
+// CriteriaFromMaps converts 'criteria' block maps into criteria.
+func CriteriaFromMaps(criteriaList []any) []Criterion {
+	criteria := make([]Criterion, 0, len(criteriaList))
+	for _, item := range criteriaList {
+		m, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		criteria = append(criteria, Criterion{
+			Name:         stringField(m, "name"),
+			Priority:     intField(m, "priority"),
+			AndOr:        stringField(m, "and_or"),
+			SearchType:   stringField(m, "search_type"),
+			Value:        stringField(m, "value"),
+			OpeningParen: boolField(m, "opening_paren"),
+			ClosingParen: boolField(m, "closing_paren"),
+		})
+	}
+	return criteria
+}
+
+func stringField(m map[string]any, key string) string {
+	v, _ := m[key].(string)
+	return v
+}
+
+func intField(m map[string]any, key string) int {
+	v, _ := m[key].(int)
+	return v
+}
+
+// boolField reads a parenthesis flag, which the Jamf Pro API models as either bool or *bool.
+func boolField(m map[string]any, key string) bool {
+	switch v := m[key].(type) {
+	case bool:
+		return v
+	case *bool:
+		return v != nil && *v
+	}
+	return false
+}
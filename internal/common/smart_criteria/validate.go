@@ -0,0 +1,152 @@
+// common/smart_criteria/validate.go
+// Description: This file contains plan time validation of criterion names and search types against
+// the fields available in Jamf Pro.
+package smart_criteria
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/lithammer/fuzzysearch/fuzzy"
+)
+
+// maxNameSuggestions is the number of "did you mean" suggestions offered for an unknown criterion.
+const maxNameSuggestions = 3
+
+// GetSchemaValidateCriteria returns the 'validate_criteria' attribute schema.
+func GetSchemaValidateCriteria() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  true,
+		Description: "When true, criterion names are checked at plan time against the built-in inventory fields and the " +
+			"extension attributes defined in Jamf Pro, and each search type is checked against the data type of its field, " +
+			"so mistakes are reported before Jamf Pro rejects the group. The built-in field list may lag behind Jamf Pro; set " +
+			"this to false to use criteria the provider does not yet know about.",
+	}
+}
+
+// ValidateCriteriaFields checks every criterion name against fields, a map of valid field names to
+// data types, and every search type against the data type of its field. All problems are returned
+// together, with suggestions for unknown names.
+func ValidateCriteriaFields(criteria []Criterion, fields map[string]string) error {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var problems []string
+	for _, c := range criteria {
+		dataType, ok := fields[c.Name]
+		if !ok {
+			msg := fmt.Sprintf("- criterion '%s' is not a built-in field or an extension attribute in Jamf Pro", c.Name)
+			if similar := FindSimilarNames(c.Name, names); len(similar) > 0 {
+				msg += fmt.Sprintf("; did you mean '%s'?", strings.Join(similar, "', '"))
+			}
+			problems = append(problems, msg)
+			continue
+		}
+
+		if allowed := searchTypesByDataType[dataType]; !slices.Contains(allowed, c.SearchType) {
+			problems = append(problems, fmt.Sprintf("- search type '%s' is not valid for %s criterion '%s'; valid search types are '%s'",
+				c.SearchType, dataType, c.Name, strings.Join(allowed, "', '")))
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("invalid criteria:\n%s", strings.Join(problems, "\n"))
+	}
+
+	return nil
+}
+
+// FindSimilarNames suggests the valid names closest to an unknown one. Names containing the unknown
+// name, or contained by it, rank first; the rest are ranked by Levenshtein distance and only kept when
+// reasonably close.
+func FindSimilarNames(invalid string, validNames []string) []string {
+	type candidate struct {
+		name string
+		dist int
+	}
+
+	needle := strings.ToLower(invalid)
+	maxDist := max(3, len(needle)/3)
+
+	var candidates []candidate
+	for _, name := range validNames {
+		lower := strings.ToLower(name)
+		dist := fuzzy.LevenshteinDistance(needle, lower)
+		if strings.Contains(lower, needle) || strings.Contains(needle, lower) {
+			dist = 0
+		}
+		if dist <= maxDist {
+			candidates = append(candidates, candidate{name: name, dist: dist})
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].dist < candidates[j].dist
+	})
+
+	if len(candidates) > maxNameSuggestions {
+		candidates = candidates[:maxNameSuggestions]
+	}
+
+	suggestions := make([]string, 0, len(candidates))
+	for _, c := range candidates {
+		suggestions = append(suggestions, c.name)
+	}
+
+	log.Printf("[DEBUG] FindSimilarNames: invalid='%s', suggestions=%v", invalid, suggestions)
+
+	return suggestions
+}
+
+// ValidateCriteriaDiff validates the configured criteria of a smart group, from either 'criteria'
+// blocks or 'criteria_expression', against the fields returned by getFields. Validation is skipped
+// when disabled with 'validate_criteria' or when the criteria are not yet known.
+func ValidateCriteriaDiff(_ context.Context, diff *schema.ResourceDiff, meta any, resourceType string, getFields func(*jamfpro.Client) (map[string]string, error)) error {
+	if !diff.Get("validate_criteria").(bool) {
+		return nil
+	}
+
+	if raw := diff.GetRawConfig(); !raw.IsNull() {
+		if !raw.GetAttr("criteria").IsWhollyKnown() || !raw.GetAttr("criteria_expression").IsWhollyKnown() {
+			return nil
+		}
+	}
+
+	resourceName := diff.Get("name").(string)
+
+	criteriaList, err := GetCriteriaList(diff)
+	if err != nil {
+		return fmt.Errorf("in '%s.%s': %v", resourceType, resourceName, err)
+	}
+	criteria := CriteriaFromMaps(criteriaList)
+	if len(criteria) == 0 {
+		return nil
+	}
+
+	client, ok := meta.(*jamfpro.Client)
+	if !ok {
+		return nil
+	}
+
+	fields, err := getFields(client)
+	if err != nil {
+		return fmt.Errorf("in '%s.%s': unable to validate criteria: %v", resourceType, resourceName, err)
+	}
+
+	if err := ValidateCriteriaFields(criteria, fields); err != nil {
+		return fmt.Errorf("in '%s.%s': %v", resourceType, resourceName, err)
+	}
+
+	return nil
+}
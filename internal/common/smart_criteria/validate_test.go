@@ -0,0 +1,56 @@
+package smart_criteria
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateCriteriaFields(t *testing.T) {
+	fields := BuiltInComputerFields()
+	fields["Department Code"] = extensionAttributeDataType("STRING")
+	fields["Days Since Reboot"] = extensionAttributeDataType("INTEGER")
+
+	t.Run("Valid criteria", func(t *testing.T) {
+		criteria, err := ParseExpression(`"Operating System Version" like "15." and "Last Check-in" more than x days ago "30" and "Computer Group" not member of "Lab" and "Days Since Reboot" more than 7`)
+		require.NoError(t, err)
+		assert.NoError(t, ValidateCriteriaFields(criteria, fields))
+	})
+
+	t.Run("Version comparison on version criterion", func(t *testing.T) {
+		criteria, err := ParseExpression(`"Operating System Version" greater than or equal "15.2" and "Operating System Version" less than "16"`)
+		require.NoError(t, err)
+		assert.NoError(t, ValidateCriteriaFields(criteria, fields))
+	})
+
+	t.Run("Unknown name with suggestion", func(t *testing.T) {
+		err := ValidateCriteriaFields([]Criterion{{Name: "Operating Sytem Version", SearchType: "like", Value: "15."}}, fields)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "did you mean 'Operating System Version'")
+	})
+
+	t.Run("Search type invalid for data type", func(t *testing.T) {
+		err := ValidateCriteriaFields([]Criterion{
+			{Name: "Model", SearchType: "more than x days ago", Value: "7"},
+			{Name: "Computer Group", SearchType: "is", Value: "Lab"},
+		}, fields)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "search type 'more than x days ago' is not valid for string criterion 'Model'")
+		assert.Contains(t, err.Error(), "search type 'is' is not valid for group criterion 'Computer Group'")
+	})
+
+	t.Run("Comparison on non-version string criterion", func(t *testing.T) {
+		err := ValidateCriteriaFields([]Criterion{{Name: "Model", SearchType: "greater than", Value: "MacBook"}}, fields)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "search type 'greater than' is not valid for string criterion 'Model'")
+	})
+}
+
+func TestFindSimilarNames(t *testing.T) {
+	names := []string{"Computer Name", "Department", "Department Code", "Model", "Model Identifier", "Serial Number"}
+
+	assert.Equal(t, []string{"Serial Number"}, FindSimilarNames("Serial Numbr", names))
+	assert.Equal(t, []string{"Department", "Department Code"}, FindSimilarNames("department", names))
+	assert.Empty(t, FindSimilarNames("Battery Health", names))
+}
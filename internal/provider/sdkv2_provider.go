@@ -16,6 +16,7 @@ import (
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/account_driven_user_enrollment_settings"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/account_group"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/activation_code"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/adcs_settings"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/advanced_computer_search"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/advanced_mobile_device_search"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/advanced_user_search"
@@ -24,8 +25,11 @@ import (
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/api_role"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/app_installer"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/app_installer_global_settings"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/blueprint"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/building"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/category"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/certificate_authority"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/class"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/client_checkin"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/cloud_distribution_point"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/cloud_idp"
@@ -33,17 +37,23 @@ import (
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/computer_extension_attribute"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/computer_inventory"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/computer_inventory_collection_settings"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/computer_inventory_record"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/computer_prestage_enrollment"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/computer_prestage_scope"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/department"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/device_communication_settings"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/device_enrollment_devices"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/device_enrollments"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/device_enrollments_public_key"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/directory_binding"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/disk_encryption_configuration"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/dock_item"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/ebook"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/engage_settings"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/enrollment_customization"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/file_share_distribution_point"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/group"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/ibeacon"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/icon"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/impact_alert_notification_settings"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/jamf_cloud_distribution_service"
@@ -55,19 +65,30 @@ import (
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/mac_application"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/macos_configuration_profile_plist"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/macos_configuration_profile_plist_generator"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/macos_custom_settings_profile"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/macos_onboarding_settings"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/macos_pppc_profile"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/managed_software_update"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/managed_software_update_feature_toggle"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/mobile_device_application"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/mobile_device_configuration_profile_plist"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/mobile_device_extension_attribute"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/mobile_device_inventory"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/mobile_device_inventory_record"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/mobile_device_prestage_enrollment"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/mobile_device_prestage_scope"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/network_segment"
 	packages "github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/package"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/patch_external_source"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/patch_policy"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/patch_software_title_configuration"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/patch_title_catalog"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/policy"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/printer"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/reenrollment"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/removable_mac_address"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/restricted_software"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/scope_preview"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/script"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/self_service_branding_image"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/self_service_branding_ios"
@@ -76,16 +97,26 @@ import (
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/self_service_settings"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/site"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/smart_computer_group"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/smart_computer_group_membership"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/smart_mobile_device_group"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/smart_mobile_device_group_membership"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/smtp_server"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/software_update_server"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/sso_certificate"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/sso_failover"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/sso_settings"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/static_computer_group"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/static_computer_group_member"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/static_mobile_device_group"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/static_mobile_device_group_member"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/user"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/user_extension_attribute"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/user_group"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/user_initiated_enrollment_settings"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/venafi_settings"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/volume_purchasing_locations"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/volume_purchasing_subscription"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/vpp_license_assignment"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/webhook"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -391,17 +422,25 @@ func Provider() *schema.Provider {
 			"jamfpro_app_installer":                             app_installer.DataSourceJamfProAppInstallers(),
 			"jamfpro_building":                                  building.DataSourceJamfProBuildings(),
 			"jamfpro_category":                                  category.DataSourceJamfProCategories(),
+			"jamfpro_certificate_authority":                     certificate_authority.DataSourceJamfProCertificateAuthority(),
+			"jamfpro_class":                                     class.DataSourceJamfProClasses(),
 			"jamfpro_cloud_distribution_point":                  cloud_distribution_point.DataSourceJamfProCloudDistributionPoint(),
 			"jamfpro_cloud_idp":                                 cloud_idp.DataSourceJamfProCloudIdp(),
+			"jamfpro_cloud_idp_test_search":                     cloud_idp.DataSourceJamfProCloudIdpTestSearch(),
 			"jamfpro_computer_extension_attribute":              computer_extension_attribute.DataSourceJamfProComputerExtensionAttributes(),
 			"jamfpro_computer_inventory":                        computer_inventory.DataSourceJamfProComputerInventory(),
+			"jamfpro_computer_inventories":                      computer_inventory.DataSourceJamfProComputerInventories(),
 			"jamfpro_computer_prestage_enrollment":              computer_prestage_enrollment.DataSourceJamfProComputerPrestageEnrollment(),
 			"jamfpro_department":                                department.DataSourceJamfProDepartments(),
+			"jamfpro_device_enrollment_devices":                 device_enrollment_devices.DataSourceJamfProDeviceEnrollmentDevices(),
 			"jamfpro_device_enrollments":                        device_enrollments.DataSourceJamfProDeviceEnrollments(),
 			"jamfpro_device_enrollments_public_key":             device_enrollments_public_key.DataSourceJamfProDeviceEnrollmentsPublicKey(),
+			"jamfpro_directory_binding":                         directory_binding.DataSourceJamfProDirectoryBindings(),
 			"jamfpro_disk_encryption_configuration":             disk_encryption_configuration.DataSourceJamfProDiskEncryptionConfigurations(),
 			"jamfpro_dock_item":                                 dock_item.DataSourceJamfProDockItems(),
+			"jamfpro_ebook":                                     ebook.DataSourceJamfProEbooks(),
 			"jamfpro_file_share_distribution_point":             file_share_distribution_point.DataSourceJamfProFileShareDistributionPoints(),
+			"jamfpro_ibeacon":                                   ibeacon.DataSourceJamfProIBeacons(),
 			"jamfpro_jamf_cloud_distribution_service":           jamf_cloud_distribution_service.DataSourceJamfProJamfCloudDistributionService(),
 			"jamfpro_jamf_connect":                              jamf_connect.DataSourceJamfConnectConfigProfile(),
 			"jamfpro_jamf_protect_plan":                         jamf_protect_plan.DataSourceJamfProtectPlan(),
@@ -411,20 +450,30 @@ func Provider() *schema.Provider {
 			"jamfpro_macos_configuration_profile_plist":         macos_configuration_profile_plist.DataSourceJamfProMacOSConfigurationProfilesPlist(),
 			"jamfpro_mobile_device_application":                 mobile_device_application.DataSourceJamfProMobileDeviceApplications(),
 			"jamfpro_mobile_device_configuration_profile_plist": mobile_device_configuration_profile_plist.DataSourceJamfProMobileDeviceConfigurationProfilesPlist(),
+			"jamfpro_mobile_device_inventory":                   mobile_device_inventory.DataSourceJamfProMobileDeviceInventory(),
+			"jamfpro_mobile_device_inventories":                 mobile_device_inventory.DataSourceJamfProMobileDeviceInventories(),
 			"jamfpro_mobile_device_prestage_enrollment":         mobile_device_prestage_enrollment.DataSourceJamfProMobileDevicePrestageEnrollment(),
 			"jamfpro_package":                                   packages.DataSourceJamfProPackages(),
+			"jamfpro_patch_title_catalog":                       patch_title_catalog.DataSourceJamfProPatchTitleCatalog(),
 			"jamfpro_policy":                                    policy.DataSourceJamfProPolicies(),
 			"jamfpro_printer":                                   printer.DataSourceJamfProPrinters(),
+			"jamfpro_removable_mac_address":                     removable_mac_address.DataSourceJamfProRemovableMacAddresses(),
+			"jamfpro_scope_preview":                             scope_preview.DataSourceJamfProScopePreview(),
 			"jamfpro_script":                                    script.DataSourceJamfProScripts(),
 			"jamfpro_site":                                      site.DataSourceJamfProSites(),
 			"jamfpro_smart_computer_group":                      smart_computer_group.DataSourceJamfProSmartComputerGroups(),
+			"jamfpro_smart_computer_group_membership":           smart_computer_group_membership.DataSourceJamfProSmartComputerGroupMembership(),
 			"jamfpro_smart_mobile_device_group":                 smart_mobile_device_group.DataSourceJamfProSmartMobileGroups(),
+			"jamfpro_software_update_server":                    software_update_server.DataSourceJamfProSoftwareUpdateServers(),
+			"jamfpro_smart_mobile_device_group_membership":      smart_mobile_device_group_membership.DataSourceJamfProSmartMobileDeviceGroupMembership(),
 			"jamfpro_sso_certificate":                           sso_certificate.DataSourceJamfProSSOCertificate(),
 			"jamfpro_sso_failover":                              sso_failover.DataSourceJamfProSSOFailover(),
 			"jamfpro_static_computer_group":                     static_computer_group.DataSourceJamfProStaticComputerGroups(),
 			"jamfpro_static_mobile_device_group":                static_mobile_device_group.DataSourceJamfProStaticMobileDeviceGroups(),
 			"jamfpro_restricted_software":                       restricted_software.DataSourceJamfProRestrictedSoftwares(),
 			"jamfpro_group":                                     group.DataSourceJamfProGroups(),
+			"jamfpro_user":                                      user.DataSourceJamfProUsers(),
+			"jamfpro_user_extension_attribute":                  user_extension_attribute.DataSourceJamfProUserExtensionAttributes(),
 			"jamfpro_user_group":                                user_group.DataSourceJamfProUserGroups(),
 			"jamfpro_volume_purchasing_locations":               volume_purchasing_locations.DataSourceJamfProVolumePurchasingLocations(),
 			"jamfpro_webhook":                                   webhook.DataSourceJamfProWebhooks(),
@@ -435,6 +484,7 @@ func Provider() *schema.Provider {
 			"jamfpro_account_driven_user_enrollment_settings":     account_driven_user_enrollment_settings.ResourceJamfProAccountDrivenUserEnrollmentSettings(),
 			"jamfpro_account_group":                               account_group.ResourceJamfProAccountGroups(),
 			"jamfpro_activation_code":                             activation_code.ResourceJamfProActivationCode(),
+			"jamfpro_adcs_settings":                               adcs_settings.ResourceJamfProAdcsSettings(),
 			"jamfpro_advanced_computer_search":                    advanced_computer_search.ResourceJamfProAdvancedComputerSearches(),
 			"jamfpro_advanced_mobile_device_search":               advanced_mobile_device_search.ResourceJamfProAdvancedMobileDeviceSearches(),
 			"jamfpro_advanced_user_search":                        advanced_user_search.ResourceJamfProAdvancedUserSearches(),
@@ -443,20 +493,28 @@ func Provider() *schema.Provider {
 			"jamfpro_api_role":                                    api_role.ResourceJamfProAPIRoles(),
 			"jamfpro_app_installer":                               app_installer.ResourceJamfProAppInstallers(),
 			"jamfpro_app_installer_global_settings":               app_installer_global_settings.ResourceJamfProAppInstallerGlobalSettings(),
+			"jamfpro_blueprint":                                   blueprint.ResourceJamfProBlueprint(),
 			"jamfpro_building":                                    building.ResourceJamfProBuildings(),
 			"jamfpro_category":                                    category.ResourceJamfProCategories(),
+			"jamfpro_class":                                       class.ResourceJamfProClass(),
 			"jamfpro_client_checkin":                              client_checkin.ResourceJamfProClientCheckin(),
+			"jamfpro_cloud_idp":                                   cloud_idp.ResourceJamfProCloudIdp(),
 			"jamfpro_cloud_ldap":                                  cloud_ldap.ResourceJamfProCloudLdap(),
 			"jamfpro_computer_extension_attribute":                computer_extension_attribute.ResourceJamfProComputerExtensionAttributes(),
+			"jamfpro_computer_inventory_record":                   computer_inventory_record.ResourceJamfProComputerInventoryRecord(),
 			"jamfpro_computer_inventory_collection_settings":      computer_inventory_collection_settings.ResourceJamfProComputerInventoryCollectionSettings(),
 			"jamfpro_computer_prestage_enrollment":                computer_prestage_enrollment.ResourceJamfProComputerPrestageEnrollment(),
+			"jamfpro_computer_prestage_scope":                     computer_prestage_scope.ResourceJamfProComputerPrestageScope(),
 			"jamfpro_department":                                  department.ResourceJamfProDepartments(),
 			"jamfpro_device_communication_settings":               device_communication_settings.ResourceJamfProDeviceCommunicationSettings(),
 			"jamfpro_device_enrollments":                          device_enrollments.ResourceJamfProDeviceEnrollments(),
+			"jamfpro_directory_binding":                           directory_binding.ResourceJamfProDirectoryBindings(),
 			"jamfpro_disk_encryption_configuration":               disk_encryption_configuration.ResourceJamfProDiskEncryptionConfigurations(),
+			"jamfpro_ebook":                                       ebook.ResourceJamfProEbook(),
 			"jamfpro_engage_settings":                             engage_settings.ResourceEngageSettings(),
 			"jamfpro_enrollment_customization":                    enrollment_customization.ResourceJamfProEnrollmentCustomization(),
 			"jamfpro_file_share_distribution_point":               file_share_distribution_point.ResourceJamfProFileShareDistributionPoints(),
+			"jamfpro_ibeacon":                                     ibeacon.ResourceJamfProIBeacon(),
 			"jamfpro_icon":                                        icon.ResourceJamfProIcons(),
 			"jamfpro_impact_alert_notification_settings":          impact_alert_notification_settings.ResourceImpactAlertNotificationSettings(),
 			"jamfpro_jamf_connect":                                jamf_connect.ResourceJamfConnectConfigProfile(),
@@ -467,17 +525,25 @@ func Provider() *schema.Provider {
 			"jamfpro_mac_application":                             mac_application.ResourceJamfProMacApplication(),
 			"jamfpro_macos_configuration_profile_plist":           macos_configuration_profile_plist.ResourceJamfProMacOSConfigurationProfilesPlist(),
 			"jamfpro_macos_configuration_profile_plist_generator": macos_configuration_profile_plist_generator.ResourceJamfProMacOSConfigurationProfilesPlistGenerator(),
+			"jamfpro_macos_custom_settings_profile":               macos_custom_settings_profile.ResourceJamfProMacOSCustomSettingsProfile(),
 			"jamfpro_macos_onboarding_settings":                   macos_onboarding_settings.ResourceJamfProMacOSOnboardingSettings(),
+			"jamfpro_macos_pppc_profile":                          macos_pppc_profile.ResourceJamfProMacOSPPPCProfile(),
 			"jamfpro_managed_software_update":                     managed_software_update.ResourceJamfProManagedSoftwareUpdate(),
 			"jamfpro_mobile_device_application":                   mobile_device_application.ResourceJamfProMobileDeviceApplication(),
 			"jamfpro_managed_software_update_feature_toggle":      managed_software_update_feature_toggle.ResourceManagedSoftwareUpdateFeatureToggle(),
 			"jamfpro_mobile_device_configuration_profile_plist":   mobile_device_configuration_profile_plist.ResourceJamfProMobileDeviceConfigurationProfilesPlist(),
 			"jamfpro_mobile_device_extension_attribute":           mobile_device_extension_attribute.ResourceJamfProMobileDeviceExtensionAttributes(),
+			"jamfpro_mobile_device_inventory_record":              mobile_device_inventory_record.ResourceJamfProMobileDeviceInventoryRecord(),
 			"jamfpro_mobile_device_prestage_enrollment":           mobile_device_prestage_enrollment.ResourceJamfProMobileDevicePrestageEnrollment(),
+			"jamfpro_mobile_device_prestage_scope":                mobile_device_prestage_scope.ResourceJamfProMobileDevicePrestageScope(),
 			"jamfpro_package":                                     packages.ResourceJamfProPackages(),
+			"jamfpro_patch_external_source":                       patch_external_source.ResourceJamfProPatchExternalSources(),
+			"jamfpro_patch_policy":                                patch_policy.ResourceJamfProPatchPolicies(),
+			"jamfpro_patch_software_title_configuration":          patch_software_title_configuration.ResourceJamfProPatchSoftwareTitleConfigurations(),
 			"jamfpro_policy":                                      policy.ResourceJamfProPolicies(),
 			"jamfpro_printer":                                     printer.ResourceJamfProPrinters(),
 			"jamfpro_reenrollment":                                reenrollment.ResourceReenrollmentSettings(),
+			"jamfpro_removable_mac_address":                       removable_mac_address.ResourceJamfProRemovableMacAddresses(),
 			"jamfpro_script":                                      script.ResourceJamfProScripts(),
 			"jamfpro_self_service_branding_image":                 self_service_branding_image.ResourceJamfProSelfServiceBrandingImage(),
 			"jamfpro_self_service_branding_ios":                   self_service_branding_ios.ResourceJamfProSelfServiceBrandingIOS(),
@@ -485,6 +551,7 @@ func Provider() *schema.Provider {
 			"jamfpro_self_service_settings":                       self_service_settings.ResourceJamfProSelfServiceSettings(),
 			"jamfpro_self_service_plus_settings":                  self_service_plus_settings.ResourceSelfServicePlusSettings(),
 			"jamfpro_smtp_server":                                 smtp_server.ResourceJamfProSMTPServer(),
+			"jamfpro_software_update_server":                      software_update_server.ResourceJamfProSoftwareUpdateServers(),
 			"jamfpro_site":                                        site.ResourceJamfProSites(),
 			"jamfpro_smart_computer_group":                        smart_computer_group.ResourceJamfProSmartComputerGroups(),
 			"jamfpro_smart_mobile_device_group":                   smart_mobile_device_group.ResourceJamfProSmartMobileGroups(),
@@ -492,11 +559,18 @@ func Provider() *schema.Provider {
 			"jamfpro_sso_failover":                                sso_failover.ResourceJamfProSSOFailover(),
 			"jamfpro_sso_settings":                                sso_settings.ResourceJamfProSsoSettings(),
 			"jamfpro_static_computer_group":                       static_computer_group.ResourceJamfProStaticComputerGroups(),
+			"jamfpro_static_computer_group_member":                static_computer_group_member.ResourceJamfProStaticComputerGroupMember(),
 			"jamfpro_static_mobile_device_group":                  static_mobile_device_group.ResourceJamfProStaticMobileDeviceGroups(),
+			"jamfpro_static_mobile_device_group_member":           static_mobile_device_group_member.ResourceJamfProStaticMobileDeviceGroupMember(),
 			"jamfpro_restricted_software":                         restricted_software.ResourceJamfProRestrictedSoftwares(),
+			"jamfpro_user":                                        user.ResourceJamfProUser(),
+			"jamfpro_user_extension_attribute":                    user_extension_attribute.ResourceJamfProUserExtensionAttribute(),
 			"jamfpro_user_initiated_enrollment_settings":          user_initiated_enrollment_settings.ResourceJamfProUserInitatedEnrollmentSettings(),
 			"jamfpro_user_group":                                  user_group.ResourceJamfProUserGroups(),
+			"jamfpro_venafi_settings":                             venafi_settings.ResourceJamfProVenafiSettings(),
 			"jamfpro_volume_purchasing_locations":                 volume_purchasing_locations.ResourceJamfProVolumePurchasingLocations(),
+			"jamfpro_volume_purchasing_subscription":              volume_purchasing_subscription.ResourceJamfProVolumePurchasingSubscription(),
+			"jamfpro_vpp_license_assignment":                      vpp_license_assignment.ResourceJamfProVPPLicenseAssignment(),
 			"jamfpro_webhook":                                     webhook.ResourceJamfProWebhooks(),
 		},
 	}
@@ -0,0 +1,81 @@
+package adcs_settings
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/redact"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// adcsSettings is the Jamf Pro API representation of AD CS connector settings. The SDK does not
+// support the AD CS settings endpoints.
+type adcsSettings struct {
+	ID                string          `json:"id,omitempty"`
+	DisplayName       string          `json:"displayName"`
+	CaName            string          `json:"caName"`
+	Fqdn              string          `json:"fqdn"`
+	AdcsURL           string          `json:"adcsUrl,omitempty"`
+	APIClientID       string          `json:"apiClientId,omitempty"`
+	RevocationEnabled bool            `json:"revocationEnabled"`
+	Outbound          bool            `json:"outbound"`
+	ServerCert        adcsCertificate `json:"serverCert,omitzero"`
+	ClientCert        adcsCertificate `json:"clientCert,omitzero"`
+}
+
+// adcsCertificate is an uploaded certificate on write and the certificate details on read.
+type adcsCertificate struct {
+	Filename       string `json:"filename,omitempty"`
+	Data           string `json:"data,omitempty"`
+	Password       string `json:"password,omitempty"`
+	Subject        string `json:"subject,omitempty"`
+	SerialNumber   string `json:"serialNumber,omitempty"`
+	ExpirationDate string `json:"expirationDate,omitempty"`
+}
+
+type createResponse struct {
+	ID   string `json:"id"`
+	Href string `json:"href"`
+}
+
+// construct builds an adcsSettings object from the provided schema data. Certificates are only
+// sent on create or when they change, as Jamf Pro does not return them.
+func construct(d *schema.ResourceData) (*adcsSettings, error) {
+	resource := &adcsSettings{
+		DisplayName:       d.Get("display_name").(string),
+		CaName:            d.Get("ca_name").(string),
+		Fqdn:              d.Get("fqdn").(string),
+		AdcsURL:           d.Get("adcs_url").(string),
+		APIClientID:       d.Get("api_client_id").(string),
+		RevocationEnabled: d.Get("revocation_enabled").(bool),
+		Outbound:          d.Get("outbound").(bool),
+	}
+
+	isNew := d.Id() == ""
+
+	if data := d.Get("server_certificate_file_bytes").(string); data != "" &&
+		(isNew || d.HasChanges("server_certificate_file_name", "server_certificate_file_bytes")) {
+		resource.ServerCert = adcsCertificate{
+			Filename: d.Get("server_certificate_file_name").(string),
+			Data:     data,
+		}
+	}
+
+	if data := d.Get("client_certificate_file_bytes").(string); data != "" &&
+		(isNew || d.HasChanges("client_certificate_file_name", "client_certificate_file_bytes", "client_certificate_password")) {
+		resource.ClientCert = adcsCertificate{
+			Filename: d.Get("client_certificate_file_name").(string),
+			Data:     data,
+			Password: d.Get("client_certificate_password").(string),
+		}
+	}
+
+	resourceJSON, err := redact.SerializeAndRedactJSON(resource, []string{"ServerCert.Data", "ClientCert.Data", "ClientCert.Password"})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Jamf Pro AD CS Settings '%s' to JSON: %v", resource.DisplayName, err)
+	}
+
+	log.Printf("[DEBUG] Constructed Jamf Pro AD CS Settings JSON:\n%s\n", string(resourceJSON))
+
+	return resource, nil
+}
@@ -0,0 +1,113 @@
+package adcs_settings
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	crud "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/sdkv2_crud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const uriAdcsSettings = "/api/v1/pki/adcs-settings"
+
+// create is responsible for creating new AD CS settings in Jamf Pro with terraform.
+func create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+	return crud.Create(
+		ctx,
+		d,
+		meta,
+		construct,
+		func(settings *adcsSettings) (*createResponse, error) {
+			var out createResponse
+			if err := send(client, "POST", uriAdcsSettings, settings, &out); err != nil {
+				return nil, err
+			}
+			return &out, nil
+		},
+		readNoCleanup,
+	)
+}
+
+// read is responsible for reading the current state of AD CS settings from Jamf Pro.
+func read(ctx context.Context, d *schema.ResourceData, meta any, cleanup bool) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+	return crud.Read(
+		ctx,
+		d,
+		meta,
+		cleanup,
+		func(id string) (*adcsSettings, error) {
+			var out adcsSettings
+			if err := send(client, "GET", fmt.Sprintf("%s/%s", uriAdcsSettings, id), nil, &out); err != nil {
+				return nil, err
+			}
+			return &out, nil
+		},
+		updateState,
+	)
+}
+
+// readWithCleanup reads the resource with cleanup enabled
+func readWithCleanup(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return read(ctx, d, meta, true)
+}
+
+// readNoCleanup reads the resource with cleanup disabled
+func readNoCleanup(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return read(ctx, d, meta, false)
+}
+
+// update is responsible for updating existing AD CS settings in Jamf Pro.
+func update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+	return crud.Update(
+		ctx,
+		d,
+		meta,
+		construct,
+		func(id string, settings *adcsSettings) (*adcsSettings, error) {
+			var out adcsSettings
+			if err := send(client, "PATCH", fmt.Sprintf("%s/%s", uriAdcsSettings, id), settings, &out); err != nil {
+				return nil, err
+			}
+			return &out, nil
+		},
+		readNoCleanup,
+	)
+}
+
+// delete is responsible for deleting AD CS settings from Jamf Pro.
+func delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+	return crud.Delete(
+		ctx,
+		d,
+		meta,
+		func(id string) error {
+			return send(client, "DELETE", fmt.Sprintf("%s/%s", uriAdcsSettings, id), nil, nil)
+		},
+	)
+}
+
+// send makes a Jamf Pro API AD CS settings request. Updates and deletes may be answered with 204 No
+// Content, which the HTTP client fails to unmarshal, so such a response is only checked for its
+// status code.
+func send(client *jamfpro.Client, method, endpoint string, settings *adcsSettings, out any) error {
+	var payload any
+	if settings != nil {
+		payload = settings
+	}
+
+	resp, err := client.HTTP.DoRequest(method, endpoint, payload, out)
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil && (resp == nil || resp.StatusCode != 204) {
+		return fmt.Errorf("failed to %s AD CS settings at %s: %v", method, endpoint, err)
+	}
+
+	return nil
+}
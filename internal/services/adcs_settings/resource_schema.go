@@ -0,0 +1,137 @@
+package adcs_settings
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceJamfProAdcsSettings defines the schema and CRUD operations for managing Jamf Pro AD CS
+// (Active Directory Certificate Services) connector settings in Terraform
+func ResourceJamfProAdcsSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: create,
+		ReadContext:   readWithCleanup,
+		UpdateContext: update,
+		DeleteContext: delete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(70 * time.Second),
+			Read:   schema.DefaultTimeout(70 * time.Second),
+			Update: schema.DefaultTimeout(70 * time.Second),
+			Delete: schema.DefaultTimeout(70 * time.Second),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the AD CS settings.",
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The display name of the AD CS certificate authority in Jamf Pro.",
+			},
+			"ca_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the AD CS certificate authority.",
+			},
+			"fqdn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The fully qualified domain name of the AD CS server.",
+			},
+			"adcs_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The URL of the AD CS connector. Required for outbound connections.",
+			},
+			"api_client_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The API client ID used by the AD CS connector for inbound connections.",
+			},
+			"revocation_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether certificates issued through the AD CS connector are revoked when they are removed from devices.",
+			},
+			"outbound": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether Jamf Pro connects outbound to the AD CS connector. Set to false for inbound connections from the connector.",
+			},
+			"server_certificate_file_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"server_certificate_file_bytes"},
+				Description:  "The file name of the AD CS connector server certificate.",
+			},
+			"server_certificate_file_bytes": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				RequiredWith: []string{"server_certificate_file_name"},
+				Description:  "Base64 encoded AD CS connector server certificate, used to verify the connector.",
+			},
+			"server_certificate_subject": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The subject of the AD CS connector server certificate.",
+			},
+			"server_certificate_serial_number": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The serial number of the AD CS connector server certificate.",
+			},
+			"server_certificate_expiration_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The expiration date of the AD CS connector server certificate.",
+			},
+			"client_certificate_file_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"client_certificate_file_bytes", "client_certificate_password"},
+				Description:  "The file name of the client certificate keystore Jamf Pro uses to authenticate to the AD CS connector.",
+			},
+			"client_certificate_file_bytes": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				RequiredWith: []string{"client_certificate_file_name", "client_certificate_password"},
+				Description:  "Base64 encoded PKCS #12 client certificate keystore Jamf Pro uses to authenticate to the AD CS connector.",
+			},
+			"client_certificate_password": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				RequiredWith: []string{"client_certificate_file_name", "client_certificate_file_bytes"},
+				Description:  "The password of the client certificate keystore.",
+			},
+			"client_certificate_subject": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The subject of the client certificate.",
+			},
+			"client_certificate_serial_number": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The serial number of the client certificate.",
+			},
+			"client_certificate_expiration_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The expiration date of the client certificate.",
+			},
+		},
+	}
+}
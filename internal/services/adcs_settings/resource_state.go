@@ -0,0 +1,38 @@
+package adcs_settings
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// updateState updates the Terraform state with the latest AD CS settings information from the Jamf Pro API.
+// Certificate file bytes and passwords are not returned by Jamf Pro and are kept as configured.
+func updateState(d *schema.ResourceData, resp *adcsSettings) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	settings := map[string]any{
+		"display_name":                       resp.DisplayName,
+		"ca_name":                            resp.CaName,
+		"fqdn":                               resp.Fqdn,
+		"adcs_url":                           resp.AdcsURL,
+		"api_client_id":                      resp.APIClientID,
+		"revocation_enabled":                 resp.RevocationEnabled,
+		"outbound":                           resp.Outbound,
+		"server_certificate_file_name":       resp.ServerCert.Filename,
+		"server_certificate_subject":         resp.ServerCert.Subject,
+		"server_certificate_serial_number":   resp.ServerCert.SerialNumber,
+		"server_certificate_expiration_date": resp.ServerCert.ExpirationDate,
+		"client_certificate_file_name":       resp.ClientCert.Filename,
+		"client_certificate_subject":         resp.ClientCert.Subject,
+		"client_certificate_serial_number":   resp.ClientCert.SerialNumber,
+		"client_certificate_expiration_date": resp.ClientCert.ExpirationDate,
+	}
+
+	for key, val := range settings {
+		if err := d.Set(key, val); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return diags
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
 	sharedschemas "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/shared_schemas"
 
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/smart_criteria"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -22,8 +23,12 @@ func construct(d *schema.ResourceData) (*jamfpro.ResourceAdvancedComputerSearch,
 		Sort3:  d.Get("sort3").(string),
 	}
 
-	if v, ok := d.GetOk("criteria"); ok {
-		criteriaList := v.([]any)
+	criteriaList, err := smart_criteria.GetCriteriaList(d)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(criteriaList) > 0 {
 		criteria := make([]jamfpro.SharedSubsetCriteria, len(criteriaList))
 		for i, crit := range criteriaList {
 			criterionMap := crit.(map[string]any)
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	sharedschemas "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/shared_schemas"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/smart_criteria"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -57,6 +58,7 @@ func ResourceJamfProAdvancedComputerSearches() *schema.Resource {
 				Optional:    true,
 				Description: "Third sorting criteria for the advanced computer search",
 			},
+			"criteria_expression": smart_criteria.GetSchemaCriteriaExpression(),
 			"criteria": {
 				Type:        schema.TypeList,
 				Optional:    true,
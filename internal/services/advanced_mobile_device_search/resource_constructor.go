@@ -7,6 +7,7 @@ import (
 	"log"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/smart_criteria"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -19,8 +20,12 @@ func construct(d *schema.ResourceData) (*jamfpro.ResourceAdvancedMobileDeviceSea
 		SiteId: &siteId,
 	}
 
-	if v, ok := d.GetOk("criteria"); ok {
-		criteriaList := v.([]any)
+	criteriaList, err := smart_criteria.GetCriteriaList(d)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(criteriaList) > 0 {
 		criteria := make([]jamfpro.SharedSubsetCriteriaJamfProAPI, len(criteriaList))
 		for i, crit := range criteriaList {
 			criterionMap := crit.(map[string]any)
@@ -4,6 +4,7 @@ package advanced_mobile_device_search
 import (
 	"time"
 
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/smart_criteria"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -41,6 +42,7 @@ func ResourceJamfProAdvancedMobileDeviceSearches() *schema.Resource {
 				Default:     "-1",
 				Description: "The ID of the site to associate the search with",
 			},
+			"criteria_expression": smart_criteria.GetSchemaCriteriaExpression(),
 			"criteria": {
 				Type:        schema.TypeList,
 				Optional:    true,
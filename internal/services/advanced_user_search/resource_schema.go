@@ -5,6 +5,7 @@ import (
 	"time"
 
 	sharedschemas "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/shared_schemas"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/smart_criteria"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -36,6 +37,7 @@ func ResourceJamfProAdvancedUserSearches() *schema.Resource {
 				Required:    true,
 				Description: "The name of the advanced user search",
 			},
+			"criteria_expression": smart_criteria.GetSchemaCriteriaExpression(),
 			"criteria": {
 				Type:     schema.TypeList,
 				Optional: true,
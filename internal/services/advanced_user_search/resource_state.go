@@ -5,6 +5,7 @@ import (
 	"strconv"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/smart_criteria"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -35,7 +36,7 @@ func updateState(d *schema.ResourceData, resp *jamfpro.ResourceAdvancedUserSearc
 		criteriaList[i] = criteriaMap
 	}
 
-	if err := d.Set("criteria", criteriaList); err != nil {
+	if err := smart_criteria.SetCriteriaState(d, criteriaList); err != nil {
 		diags = append(diags, diag.FromErr(err)...)
 	}
 
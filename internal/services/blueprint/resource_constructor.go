@@ -0,0 +1,83 @@
+package blueprint
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/ddm"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// blueprint is the Jamf Pro API representation of a blueprint. The SDK has no blueprint support.
+type blueprint struct {
+	ID              string          `json:"id,omitempty"`
+	Name            string          `json:"name"`
+	Description     string          `json:"description"`
+	SiteID          string          `json:"siteId"`
+	Scope           blueprintScope  `json:"scope"`
+	Steps           []blueprintStep `json:"steps"`
+	DeploymentState *struct {
+		State string `json:"state"`
+	} `json:"deploymentState,omitempty"`
+}
+
+type blueprintScope struct {
+	DeviceGroups []string `json:"deviceGroups"`
+}
+
+type blueprintStep struct {
+	Name       string               `json:"name"`
+	Components []blueprintComponent `json:"components"`
+}
+
+type blueprintComponent struct {
+	Identifier    string          `json:"identifier"`
+	Configuration json.RawMessage `json:"configuration"`
+}
+
+// construct builds a blueprint object from the provided schema data. All declarations are deployed
+// in a single step.
+func construct(d *schema.ResourceData) (*blueprint, error) {
+	resource := &blueprint{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		SiteID:      d.Get("site_id").(string),
+		Scope:       blueprintScope{DeviceGroups: []string{}},
+	}
+
+	for _, v := range d.Get("device_group_ids").(*schema.Set).List() {
+		resource.Scope.DeviceGroups = append(resource.Scope.DeviceGroups, v.(string))
+	}
+
+	step := blueprintStep{Name: resource.Name, Components: []blueprintComponent{}}
+	for i, v := range d.Get("declaration").([]any) {
+		declaration := v.(map[string]any)
+		declarationType := declaration["type"].(string)
+
+		identifier, ok := ddm.BlueprintComponentIdentifier(declarationType)
+		if !ok {
+			return nil, fmt.Errorf("declaration %d of Jamf Pro Blueprint '%s' has unsupported type '%s'", i, resource.Name, declarationType)
+		}
+
+		payload := declaration["payload"].(string)
+		if !json.Valid([]byte(payload)) {
+			return nil, fmt.Errorf("declaration %d of Jamf Pro Blueprint '%s' has an invalid JSON payload", i, resource.Name)
+		}
+
+		step.Components = append(step.Components, blueprintComponent{
+			Identifier:    identifier,
+			Configuration: json.RawMessage(payload),
+		})
+	}
+	resource.Steps = []blueprintStep{step}
+
+	resourceJSON, err := json.MarshalIndent(resource, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Jamf Pro Blueprint '%s' to JSON: %v", resource.Name, err)
+	}
+
+	log.Printf("[DEBUG] Constructed Jamf Pro Blueprint JSON:\n%s\n", string(resourceJSON))
+
+	return resource, nil
+}
@@ -0,0 +1,162 @@
+package blueprint
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	crud "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/sdkv2_crud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const uriBlueprints = "/api/v1/blueprints"
+
+// createResponse is the response of a blueprint create request.
+type createResponse struct {
+	ID   string `json:"id"`
+	Href string `json:"href"`
+}
+
+// create is responsible for creating a new blueprint in Jamf Pro and deploying it when requested.
+// Only the create request is retried; deployment runs once the ID is stored so a failure does not
+// create the blueprint again.
+func create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+	return crud.Create(
+		ctx,
+		d,
+		meta,
+		construct,
+		func(resource *blueprint) (*createResponse, error) {
+			var out createResponse
+			if err := send(client, "POST", uriBlueprints, resource, &out); err != nil {
+				return nil, err
+			}
+			return &out, nil
+		},
+		func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			if d.Get("deploy").(bool) {
+				if diags := deployWithRetries(ctx, d, client, true, schema.TimeoutCreate); diags.HasError() {
+					return diags
+				}
+			}
+			return readNoCleanup(ctx, d, meta)
+		},
+	)
+}
+
+// read is responsible for reading the current state of a blueprint from Jamf Pro.
+func read(ctx context.Context, d *schema.ResourceData, meta any, cleanup bool) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+	return crud.Read(
+		ctx,
+		d,
+		meta,
+		cleanup,
+		func(id string) (*blueprint, error) {
+			var out blueprint
+			if err := send(client, "GET", fmt.Sprintf("%s/%s", uriBlueprints, id), nil, &out); err != nil {
+				return nil, err
+			}
+			return &out, nil
+		},
+		updateState,
+	)
+}
+
+// readWithCleanup reads the resource with cleanup enabled
+func readWithCleanup(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return read(ctx, d, meta, true)
+}
+
+// readNoCleanup reads the resource with cleanup disabled
+func readNoCleanup(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return read(ctx, d, meta, false)
+}
+
+// update is responsible for updating an existing blueprint in Jamf Pro. The blueprint is then
+// redeployed, or undeployed when 'deploy' was switched off.
+func update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+	return crud.Update(
+		ctx,
+		d,
+		meta,
+		construct,
+		func(id string, resource *blueprint) (*blueprint, error) {
+			var out blueprint
+			if err := send(client, "PATCH", fmt.Sprintf("%s/%s", uriBlueprints, id), resource, &out); err != nil {
+				return nil, err
+			}
+			return &out, nil
+		},
+		func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			if deploy := d.Get("deploy").(bool); deploy || d.HasChange("deploy") {
+				if diags := deployWithRetries(ctx, d, client, deploy, schema.TimeoutUpdate); diags.HasError() {
+					return diags
+				}
+			}
+			return readNoCleanup(ctx, d, meta)
+		},
+	)
+}
+
+// delete is responsible for deleting a blueprint from Jamf Pro.
+func delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+	return crud.Delete(
+		ctx,
+		d,
+		meta,
+		func(id string) error {
+			return send(client, "DELETE", fmt.Sprintf("%s/%s", uriBlueprints, id), nil, nil)
+		},
+	)
+}
+
+// deployWithRetries deploys or undeploys the blueprint with the ID held in d, retrying only the
+// deployment request.
+func deployWithRetries(ctx context.Context, d *schema.ResourceData, client *jamfpro.Client, deploy bool, timeout string) diag.Diagnostics {
+	err := retry.RetryContext(ctx, d.Timeout(timeout), func() *retry.RetryError {
+		if err := setDeployment(client, d.Id(), deploy); err != nil {
+			return retry.RetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set deployment of blueprint '%s' (ID %s) after retries: %v", d.Get("name").(string), d.Id(), err))
+	}
+
+	return nil
+}
+
+// setDeployment deploys or undeploys a blueprint to its scope.
+func setDeployment(client *jamfpro.Client, id string, deploy bool) error {
+	action := "undeploy"
+	if deploy {
+		action = "deploy"
+	}
+
+	return send(client, "POST", fmt.Sprintf("%s/%s/%s", uriBlueprints, id, action), nil, &struct{}{})
+}
+
+// send makes a Jamf Pro API blueprint request. Deploy and update requests answer with an empty
+// body, which is not an error.
+func send(client *jamfpro.Client, method, endpoint string, resource *blueprint, out any) error {
+	var payload any
+	if resource != nil {
+		payload = resource
+	}
+
+	resp, err := client.HTTP.DoRequest(method, endpoint, payload, out)
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil && (resp == nil || (resp.StatusCode != 202 && resp.StatusCode != 204)) {
+		return fmt.Errorf("failed to %s blueprint at %s: %v", method, endpoint, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package blueprint
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/ddm"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// mainCustomDiffFunc orchestrates all custom diff validations.
+func mainCustomDiffFunc(ctx context.Context, diff *schema.ResourceDiff, i any) error {
+	if err := validateDeclarations(ctx, diff, i); err != nil {
+		return err
+	}
+
+	// Any change is redeployed or undeployed, so the deployment state is only known after apply.
+	if diff.Id() != "" && diff.HasChanges("name", "description", "site_id", "device_group_ids", "declaration", "deploy") {
+		if err := diff.SetNewComputed("deployment_state"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateDeclarations validates every declaration payload against Apple's schema for its type.
+// Payloads that are not known until apply are skipped.
+func validateDeclarations(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+	if raw := diff.GetRawConfig(); !raw.IsNull() && !raw.GetAttr("declaration").IsWhollyKnown() {
+		return nil
+	}
+
+	for i, v := range diff.Get("declaration").([]any) {
+		declaration, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		declarationType := declaration["type"].(string)
+		if err := ddm.ValidateDeclarationPayload(declarationType, declaration["payload"].(string)); err != nil {
+			return fmt.Errorf("in 'jamfpro_blueprint.declaration.%d': %v", i, err)
+		}
+	}
+
+	return nil
+}
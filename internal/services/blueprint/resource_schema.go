@@ -0,0 +1,95 @@
+package blueprint
+
+import (
+	"time"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/ddm"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceJamfProBlueprint defines the schema and CRUD operations for managing Jamf Pro
+// Declarative Device Management (DDM) blueprints in Terraform.
+func ResourceJamfProBlueprint() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: create,
+		ReadContext:   readWithCleanup,
+		UpdateContext: update,
+		DeleteContext: delete,
+		CustomizeDiff: mainCustomDiffFunc,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(70 * time.Second),
+			Read:   schema.DefaultTimeout(70 * time.Second),
+			Update: schema.DefaultTimeout(70 * time.Second),
+			Delete: schema.DefaultTimeout(70 * time.Second),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the blueprint.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the blueprint.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the blueprint.",
+			},
+			"site_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "-1",
+				Description: "The ID of the site the blueprint belongs to. Use '-1' for no site.",
+			},
+			"device_group_ids": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Description: "The IDs of the smart or static computer and mobile device groups the blueprint is scoped to.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"declaration": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "The DDM declarations deployed by the blueprint. Each payload is validated against Apple's schema for its declaration type during plan.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(ddm.SupportedDeclarationTypes(), false),
+							Description:  "The declaration type, e.g. 'com.apple.configuration.passcode.settings'.",
+						},
+						"payload": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     validation.StringIsJSON,
+							DiffSuppressFunc: structure.SuppressJsonDiff,
+							Description:      "The 'Payload' of the declaration as a JSON object, e.g. jsonencode({ RequirePasscode = true }).",
+						},
+					},
+				},
+			},
+			"deploy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the blueprint is deployed to its scope after every change. When false, the blueprint is undeployed.",
+			},
+			"deployment_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The deployment state of the blueprint reported by Jamf Pro, e.g. 'NOT_DEPLOYED', 'DEPLOYING' or 'SUCCEEDED'.",
+			},
+		},
+	}
+}
@@ -0,0 +1,61 @@
+package blueprint
+
+import (
+	"bytes"
+	"encoding/json"
+	"log"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/ddm"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// updateState updates the Terraform state with the latest blueprint information from the Jamf Pro
+// API. Components that do not deploy a supported declaration type are not managed and are skipped.
+func updateState(d *schema.ResourceData, resp *blueprint) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	declarations := make([]any, 0)
+	for _, step := range resp.Steps {
+		for _, component := range step.Components {
+			declarationType, ok := ddm.DeclarationTypeOfBlueprintComponent(component.Identifier)
+			if !ok {
+				log.Printf("[WARN] Skipping unsupported component '%s' of Jamf Pro Blueprint '%s'", component.Identifier, resp.Name)
+				continue
+			}
+
+			var payload bytes.Buffer
+			if err := json.Compact(&payload, component.Configuration); err != nil {
+				diags = append(diags, diag.Errorf("failed to read component '%s' of Jamf Pro Blueprint '%s': %v", component.Identifier, resp.Name, err)...)
+				continue
+			}
+
+			declarations = append(declarations, map[string]any{
+				"type":    declarationType,
+				"payload": payload.String(),
+			})
+		}
+	}
+
+	deploymentState := ""
+	if resp.DeploymentState != nil {
+		deploymentState = resp.DeploymentState.State
+	}
+
+	blueprintData := map[string]any{
+		"name":             resp.Name,
+		"description":      resp.Description,
+		"site_id":          resp.SiteID,
+		"device_group_ids": resp.Scope.DeviceGroups,
+		"declaration":      declarations,
+		"deployment_state": deploymentState,
+	}
+
+	for key, val := range blueprintData {
+		if err := d.Set(key, val); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,188 @@
+package certificate_authority
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+	"unsafe"
+
+	"github.com/deploymenttheory/go-api-http-client/httpclient"
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/pki"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const uriActiveCertificateAuthorityPEM = "/api/v1/pki/certificate-authority/active/pem"
+
+// dataSourceRead fetches the active Jamf Pro built-in certificate authority and its PEM encoded
+// certificate chain and sets them in the data source's state.
+func dataSourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+
+	var resource *jamfpro.ResponseActiveCertificateAuthority
+	var chain string
+	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
+		var apiErr error
+		resource, apiErr = client.GetActiveCertificateAuthority()
+		if apiErr != nil {
+			return retry.RetryableError(apiErr)
+		}
+
+		var retryErr *retry.RetryError
+		chain, retryErr = getCertificateChainPEM(ctx, client)
+		return retryErr
+	})
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read Jamf Pro active certificate authority after retries: %v", err))
+	}
+
+	certificates, err := pki.ParsePEMChain(chain)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to parse Jamf Pro certificate authority chain: %v", err))
+	}
+
+	d.SetId(resource.SHA256Fingerprint)
+
+	return updateState(d, resource, chain, certificates)
+}
+
+// getCertificateChainPEM downloads the PEM encoded certificate chain of the active certificate
+// authority. The SDK does not provide a function for this endpoint and the SDK HTTP client cannot
+// decode a PEM response, so the request is sent with the SDK client's own http.Client, keeping its
+// transport, session cookies and timeout, and the raw body is read directly. Only transport errors,
+// rate limiting and server errors are retried.
+func getCertificateChainPEM(ctx context.Context, client *jamfpro.Client) (string, *retry.RetryError) {
+	if client.HTTP == nil || client.HTTP.Integration == nil || *client.HTTP.Integration == nil {
+		return "", retry.NonRetryableError(fmt.Errorf("the Jamf Pro client is not configured with an API integration"))
+	}
+	integration := *client.HTTP.Integration
+
+	httpClient, err := sdkHTTPClient(client.HTTP)
+	if err != nil {
+		return "", retry.NonRetryableError(err)
+	}
+
+	if client.HTTP.Concurrency != nil {
+		var requestID uuid.UUID
+		ctx, requestID, err = client.HTTP.Concurrency.AcquireConcurrencyPermit(ctx)
+		if err != nil {
+			return "", retry.RetryableError(fmt.Errorf("failed to acquire concurrency permit: %v", err))
+		}
+		defer client.HTTP.Concurrency.ReleaseConcurrencyPermit(requestID)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, integration.ConstructURL(uriActiveCertificateAuthorityPEM), nil)
+	if err != nil {
+		return "", retry.NonRetryableError(fmt.Errorf("failed to build certificate authority chain request: %v", err))
+	}
+	if err := integration.PrepRequestParamsAndAuth(req); err != nil {
+		return "", retry.RetryableError(fmt.Errorf("failed to authenticate certificate authority chain request: %v", err))
+	}
+	req.Header.Set("Accept", "application/x-pem-file, text/plain, */*")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", retry.RetryableError(fmt.Errorf("failed to download certificate authority chain: %v", err))
+	}
+	defer resp.Body.Close()
+	client.HTTP.CheckDeprecationHeader(resp)
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", retry.RetryableError(fmt.Errorf("failed to read certificate authority chain: %v", err))
+	}
+	if client.HTTP.Sugar != nil {
+		client.HTTP.Sugar.Debugw("Raw HTTP Response", "status_code", resp.StatusCode, "content_type", resp.Header.Get("Content-Type"), "endpoint", uriActiveCertificateAuthorityPEM)
+	}
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		err := fmt.Errorf("failed to download certificate authority chain: %s: %s", resp.Status, strings.TrimSpace(string(body)))
+		if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError {
+			return "", retry.RetryableError(err)
+		}
+		return "", retry.NonRetryableError(err)
+	}
+
+	chain := string(body)
+	if !strings.Contains(chain, "-----BEGIN CERTIFICATE-----") {
+		return "", retry.NonRetryableError(fmt.Errorf("certificate authority chain response with content type '%s' does not contain a PEM certificate", resp.Header.Get("Content-Type")))
+	}
+
+	return chain, nil
+}
+
+// sdkHTTPClient returns the http.Client the SDK HTTP client sends its requests with. The
+// httpclient package does not export it, but requests made outside DoRequest must share its
+// transport, proxy and TLS settings, cookie jar (load balancer stickiness) and timeout.
+func sdkHTTPClient(client *httpclient.Client) (*http.Client, error) {
+	field := reflect.ValueOf(client).Elem().FieldByName("http")
+	if !field.IsValid() || field.Type() != reflect.TypeOf((*http.Client)(nil)) {
+		return nil, fmt.Errorf("the Jamf Pro SDK HTTP client does not expose its http.Client, the certificate chain cannot be downloaded")
+	}
+
+	httpClient := *(**http.Client)(unsafe.Pointer(field.UnsafeAddr()))
+	if httpClient == nil {
+		return nil, fmt.Errorf("the Jamf Pro SDK HTTP client has not been initialised")
+	}
+
+	return httpClient, nil
+}
+
+// updateState sets the certificate authority details and its certificate chain in the data
+// source's state.
+func updateState(d *schema.ResourceData, resp *jamfpro.ResponseActiveCertificateAuthority, chain string, certificates []pki.Certificate) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	certificateList := make([]any, 0, len(certificates))
+	for _, cert := range certificates {
+		payloadSettings := make([]any, 0, 2)
+		for _, setting := range cert.RootPayloadSettings() {
+			payloadSettings = append(payloadSettings, setting)
+		}
+
+		certificateList = append(certificateList, map[string]any{
+			"subject":               cert.Subject,
+			"common_name":           cert.CommonName,
+			"issuer":                cert.Issuer,
+			"serial_number":         cert.SerialNumber,
+			"not_before":            cert.NotBefore.Format(time.RFC3339),
+			"not_after":             cert.NotAfter.Format(time.RFC3339),
+			"is_ca":                 cert.IsCA,
+			"sha1_fingerprint":      cert.SHA1Fingerprint,
+			"sha256_fingerprint":    cert.SHA256Fingerprint,
+			"pem":                   cert.PEM,
+			"der_base64":            cert.DERBase64,
+			"file_name":             cert.FileName(),
+			"root_payload_settings": payloadSettings,
+		})
+	}
+
+	caData := map[string]any{
+		"subject":               resp.SubjectX500Principal,
+		"issuer":                resp.IssuerX500Principal,
+		"serial_number":         resp.SerialNumber,
+		"not_before":            time.Unix(resp.NotBefore, 0).UTC().Format(time.RFC3339),
+		"not_after":             time.Unix(resp.NotAfter, 0).UTC().Format(time.RFC3339),
+		"key_usage":             resp.KeyUsage,
+		"sha1_fingerprint":      resp.SHA1Fingerprint,
+		"sha256_fingerprint":    resp.SHA256Fingerprint,
+		"certificate_chain_pem": chain,
+		"certificates":          certificateList,
+	}
+
+	for key, val := range caData {
+		if err := d.Set(key, val); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return diags
+}
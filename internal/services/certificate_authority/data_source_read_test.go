@@ -0,0 +1,47 @@
+package certificate_authority
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/deploymenttheory/go-api-http-client/httpclient"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubIntegration struct{}
+
+func (stubIntegration) GetFQDN() string { return "https://example.jamfcloud.com" }
+func (stubIntegration) ConstructURL(endpoint string) string {
+	return "https://example.jamfcloud.com" + endpoint
+}
+func (stubIntegration) GetAuthMethodDescriptor() string              { return "stub" }
+func (stubIntegration) CheckRefreshToken() error                     { return nil }
+func (stubIntegration) PrepRequestParamsAndAuth(*http.Request) error { return nil }
+func (stubIntegration) PrepRequestBody(any, string, string) ([]byte, error) {
+	return nil, nil
+}
+func (stubIntegration) MarshalMultipartRequest(map[string]string, map[string]string) ([]byte, string, error) {
+	return nil, "", nil
+}
+func (stubIntegration) GetSessionCookies() ([]*http.Cookie, error) { return nil, nil }
+
+// TestSDKHTTPClient guards the lookup of the SDK's unexported http.Client against SDK upgrades.
+func TestSDKHTTPClient(t *testing.T) {
+	config := httpclient.ClientConfig{
+		Integration: stubIntegration{},
+		Timeout:     42 * time.Second,
+		HTTP:        http.Client{},
+	}
+	client, err := config.Build()
+	require.NoError(t, err)
+
+	httpClient, err := sdkHTTPClient(client)
+	require.NoError(t, err)
+	assert.Equal(t, 42*time.Second, httpClient.Timeout)
+	assert.NotNil(t, httpClient.Jar, "the SDK cookie jar should be shared")
+
+	_, err = sdkHTTPClient(&httpclient.Client{})
+	assert.Error(t, err)
+}
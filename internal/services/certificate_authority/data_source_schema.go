@@ -0,0 +1,170 @@
+package certificate_authority
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProCertificateAuthority provides information about the active Jamf Pro built-in
+// certificate authority and its certificate chain
+func DataSourceJamfProCertificateAuthority() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(30 * time.Second),
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SHA-256 fingerprint of the active certificate authority certificate.",
+			},
+			"subject": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The X.500 subject of the certificate authority certificate.",
+			},
+			"issuer": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The X.500 issuer of the certificate authority certificate.",
+			},
+			"serial_number": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The serial number of the certificate authority certificate.",
+			},
+			"not_before": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The start of the validity period of the certificate authority certificate, in RFC 3339 format.",
+			},
+			"not_after": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The end of the validity period of the certificate authority certificate, in RFC 3339 format.",
+			},
+			"key_usage": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The key usages of the certificate authority certificate.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"sha1_fingerprint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SHA-1 fingerprint of the certificate authority certificate.",
+			},
+			"sha256_fingerprint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SHA-256 fingerprint of the certificate authority certificate.",
+			},
+			"certificate_chain_pem": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The PEM encoded certificate chain of the certificate authority.",
+			},
+			"certificates": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The certificates of the certificate chain, in the order they appear in the chain.",
+				Elem:        certificateSchema(),
+			},
+		},
+	}
+}
+
+// certificateSchema defines a single certificate of the certificate chain.
+func certificateSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"subject": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The subject of the certificate.",
+			},
+			"common_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The common name of the certificate subject.",
+			},
+			"issuer": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The issuer of the certificate.",
+			},
+			"serial_number": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The serial number of the certificate in hexadecimal.",
+			},
+			"not_before": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The start of the validity period of the certificate, in RFC 3339 format.",
+			},
+			"not_after": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The end of the validity period of the certificate, in RFC 3339 format.",
+			},
+			"is_ca": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the certificate is a certificate authority certificate.",
+			},
+			"sha1_fingerprint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SHA-1 fingerprint of the certificate.",
+			},
+			"sha256_fingerprint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SHA-256 fingerprint of the certificate.",
+			},
+			"pem": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The PEM encoded certificate.",
+			},
+			"der_base64": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The base64 encoded DER certificate.",
+			},
+			"file_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The certificate file name used when the certificate is embedded in a configuration profile.",
+			},
+			"root_payload_settings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Description: "The payload content of a com.apple.security.root payload installing the certificate, " +
+					"for use with a dynamic 'setting' block of the jamfpro_macos_configuration_profile_plist_generator resource.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The payload key.",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The payload value.",
+						},
+						"value_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The plist type of the payload value.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
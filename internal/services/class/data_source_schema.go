@@ -0,0 +1,82 @@
+package class
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProClasses provides information about a specific Jamf Pro Class
+func DataSourceJamfProClasses() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(30 * time.Second),
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The unique identifier of the class.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the class.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The description of the class.",
+			},
+			"source": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The source of the class, e.g. Apple School Manager for imported classes.",
+			},
+			"site_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The ID of the site the class belongs to.",
+			},
+			"student_usernames": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "The usernames of the students in the class.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"student_group_ids": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "The IDs of the user groups whose members are students in the class.",
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"teacher_usernames": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "The usernames of the teachers of the class.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"teacher_group_ids": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "The IDs of the user groups whose members are teachers of the class.",
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"mobile_device_group_ids": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "The IDs of the mobile device groups assigned to the class.",
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"meeting_time": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The meeting time of the class.",
+				Elem:        meetingTimeSchema(),
+			},
+		},
+	}
+}
@@ -0,0 +1,80 @@
+package class
+
+import (
+	"encoding/xml"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	sharedschemas "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/shared_schemas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// class is the Classic API representation of a class. The SDK type nests student and teacher
+// names one element too deep and its create does not return the ID of the class.
+type class struct {
+	XMLName             xml.Name                   `xml:"class"`
+	ID                  int                        `xml:"id,omitempty"`
+	Source              string                     `xml:"source,omitempty"`
+	Name                string                     `xml:"name"`
+	Description         string                     `xml:"description"`
+	Site                jamfpro.SharedResourceSite `xml:"site"`
+	Students            []string                   `xml:"students>student"`
+	StudentGroupIDs     []int                      `xml:"student_group_ids>id"`
+	Teachers            []string                   `xml:"teachers>teacher"`
+	TeacherGroupIDs     []int                      `xml:"teacher_group_ids>id"`
+	MobileDeviceGroupID []int                      `xml:"mobile_device_group_id>id"`
+	MeetingTimes        []classMeetingTime         `xml:"meeting_times>meeting_time"`
+}
+
+type classMeetingTime struct {
+	Days      string `xml:"days"`
+	StartTime int    `xml:"start_time"`
+	EndTime   int    `xml:"end_time"`
+}
+
+// construct builds a class object from the provided schema data.
+func construct(d *schema.ResourceData) (*class, error) {
+	resource := &class{
+		Name:                d.Get("name").(string),
+		Description:         d.Get("description").(string),
+		Site:                *sharedschemas.ConstructSharedResourceSite(d.Get("site_id").(int)),
+		Students:            setToSlice[string](d.Get("student_usernames")),
+		StudentGroupIDs:     setToSlice[int](d.Get("student_group_ids")),
+		Teachers:            setToSlice[string](d.Get("teacher_usernames")),
+		TeacherGroupIDs:     setToSlice[int](d.Get("teacher_group_ids")),
+		MobileDeviceGroupID: setToSlice[int](d.Get("mobile_device_group_ids")),
+	}
+
+	if v, ok := d.GetOk("meeting_time"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		meetingTime := v.([]any)[0].(map[string]any)
+		resource.MeetingTimes = []classMeetingTime{{
+			Days:      meetingTime["days"].(string),
+			StartTime: meetingTime["start_time"].(int),
+			EndTime:   meetingTime["end_time"].(int),
+		}}
+	}
+
+	resourceXML, err := xml.MarshalIndent(resource, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Jamf Pro Class '%s' to XML: %v", resource.Name, err)
+	}
+
+	log.Printf("[DEBUG] Constructed Jamf Pro Class XML:\n%s\n", string(resourceXML))
+
+	return resource, nil
+}
+
+// setToSlice converts a schema set into a slice of its elements.
+func setToSlice[T any](v any) []T {
+	set, ok := v.(*schema.Set)
+	if !ok {
+		return nil
+	}
+
+	out := make([]T, 0, set.Len())
+	for _, item := range set.List() {
+		out = append(out, item.(T))
+	}
+	return out
+}
@@ -0,0 +1,97 @@
+package class
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	crud "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/sdkv2_crud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const uriClasses = "/JSSResource/classes"
+
+// create is responsible for creating a new Jamf Pro Class in the remote system.
+func create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+	return crud.Create(
+		ctx,
+		d,
+		meta,
+		construct,
+		func(resource *class) (*class, error) {
+			return send(client, "POST", uriClasses+"/id/0", resource)
+		},
+		readNoCleanup,
+	)
+}
+
+// read is responsible for reading the current state of a Jamf Pro Class from the remote system.
+func read(ctx context.Context, d *schema.ResourceData, meta any, cleanup bool) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+	return crud.Read(
+		ctx,
+		d,
+		meta,
+		cleanup,
+		func(id string) (*class, error) {
+			return send(client, "GET", fmt.Sprintf("%s/id/%s", uriClasses, id), nil)
+		},
+		updateState,
+	)
+}
+
+// readWithCleanup reads the resource with cleanup enabled
+func readWithCleanup(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return read(ctx, d, meta, true)
+}
+
+// readNoCleanup reads the resource with cleanup disabled
+func readNoCleanup(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return read(ctx, d, meta, false)
+}
+
+// update is responsible for updating an existing Jamf Pro Class on the remote system.
+func update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+	return crud.Update(
+		ctx,
+		d,
+		meta,
+		construct,
+		func(id string, resource *class) (*class, error) {
+			return send(client, "PUT", fmt.Sprintf("%s/id/%s", uriClasses, id), resource)
+		},
+		readNoCleanup,
+	)
+}
+
+// delete is responsible for deleting a Jamf Pro Class.
+func delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return crud.Delete(
+		ctx,
+		d,
+		meta,
+		meta.(*jamfpro.Client).DeleteClassByID,
+	)
+}
+
+// send makes a Classic API class request. A create or update returns only the ID of the class.
+func send(client *jamfpro.Client, method, endpoint string, resource *class) (*class, error) {
+	var payload any
+	if resource != nil {
+		payload = resource
+	}
+
+	var out class
+	resp, err := client.HTTP.DoRequest(method, endpoint, payload, &out)
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to %s class at %s: %v", method, endpoint, err)
+	}
+
+	return &out, nil
+}
@@ -0,0 +1,113 @@
+package class
+
+import (
+	"time"
+
+	sharedschemas "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/shared_schemas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceJamfProClass defines the schema and CRUD operations for managing Jamf Pro Classes in Terraform
+func ResourceJamfProClass() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: create,
+		ReadContext:   readWithCleanup,
+		UpdateContext: update,
+		DeleteContext: delete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(70 * time.Second),
+			Read:   schema.DefaultTimeout(70 * time.Second),
+			Update: schema.DefaultTimeout(70 * time.Second),
+			Delete: schema.DefaultTimeout(70 * time.Second),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the class.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the class.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the class.",
+			},
+			"source": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The source of the class, e.g. Apple School Manager for imported classes.",
+			},
+			"site_id": sharedschemas.GetSharedSchemaSite(),
+			"student_usernames": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The usernames of the students in the class.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"student_group_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The IDs of the user groups whose members are students in the class.",
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"teacher_usernames": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The usernames of the teachers of the class.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"teacher_group_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The IDs of the user groups whose members are teachers of the class.",
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"mobile_device_group_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The IDs of the mobile device groups assigned to the class.",
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"meeting_time": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The meeting time of the class.",
+				Elem:        meetingTimeSchema(),
+			},
+		},
+	}
+}
+
+// meetingTimeSchema defines the meeting time of a class.
+func meetingTimeSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"days": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The days the class meets, e.g. \"M W F\".",
+			},
+			"start_time": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				Description:  "The start time of the class in 24 hour HHMM format, e.g. 1300.",
+				ValidateFunc: validation.IntBetween(0, 2359),
+			},
+			"end_time": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				Description:  "The end time of the class in 24 hour HHMM format, e.g. 1345.",
+				ValidateFunc: validation.IntBetween(0, 2359),
+			},
+		},
+	}
+}
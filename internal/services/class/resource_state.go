@@ -0,0 +1,48 @@
+package class
+
+import (
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// updateState updates the Terraform state with the latest Class information from the Jamf Pro API.
+func updateState(d *schema.ResourceData, resp *class) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	students := append([]string(nil), resp.Students...)
+	teachers := append([]string(nil), resp.Teachers...)
+	sort.Strings(students)
+	sort.Strings(teachers)
+
+	classData := map[string]any{
+		"name":                    resp.Name,
+		"description":             resp.Description,
+		"source":                  resp.Source,
+		"site_id":                 resp.Site.ID,
+		"student_usernames":       students,
+		"student_group_ids":       resp.StudentGroupIDs,
+		"teacher_usernames":       teachers,
+		"teacher_group_ids":       resp.TeacherGroupIDs,
+		"mobile_device_group_ids": resp.MobileDeviceGroupID,
+		"meeting_time":            []any{},
+	}
+
+	if len(resp.MeetingTimes) > 0 && resp.MeetingTimes[0].Days != "" {
+		meetingTime := resp.MeetingTimes[0]
+		classData["meeting_time"] = []any{map[string]any{
+			"days":       meetingTime.Days,
+			"start_time": meetingTime.StartTime,
+			"end_time":   meetingTime.EndTime,
+		}}
+	}
+
+	for key, val := range classData {
+		if err := d.Set(key, val); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return diags
+}
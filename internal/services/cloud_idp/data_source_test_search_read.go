@@ -0,0 +1,112 @@
+package cloud_idp
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const uriCloudIdp = "/api/v1/cloud-idp"
+
+// testSearchResponse is the response of a cloud identity provider test search.
+type testSearchResponse struct {
+	TotalCount int                `json:"totalCount"`
+	Results    []testSearchResult `json:"results"`
+}
+
+type testSearchResult struct {
+	ID                string         `json:"id"`
+	UUID              string         `json:"uuid"`
+	Name              string         `json:"name"`
+	DistinguishedName string         `json:"distinguishedName"`
+	Attributes        map[string]any `json:"attributes"`
+	IsMember          bool           `json:"isMember"`
+}
+
+// dataSourceTestSearchRead runs the test searches for the configured username and group name.
+func dataSourceTestSearchRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+	id := d.Get("cloud_idp_id").(string)
+	username := d.Get("username").(string)
+	groupName := d.Get("group_name").(string)
+
+	search := func(test string, payload map[string]string) (*testSearchResponse, error) {
+		var out testSearchResponse
+		err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
+			if apiErr := send(client, "POST", fmt.Sprintf("%s/%s/%s", uriCloudIdp, id, test), payload, &out); apiErr != nil {
+				return retry.RetryableError(apiErr)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to run %s on Jamf Pro Cloud Identity Provider ID %s after retries: %v", test, id, err)
+		}
+		return &out, nil
+	}
+
+	users, groups := []any{}, []any{}
+	if username != "" {
+		resp, err := search("test-user", map[string]string{"username": username})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		users = flattenSearchResults(resp.Results)
+	}
+	if groupName != "" {
+		resp, err := search("test-group", map[string]string{"groupname": groupName})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		groups = flattenSearchResults(resp.Results)
+	}
+
+	isMember := false
+	if username != "" && groupName != "" {
+		resp, err := search("test-user-membership", map[string]string{"username": username, "groupname": groupName})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		for _, result := range resp.Results {
+			isMember = isMember || result.IsMember
+		}
+	}
+
+	if err := d.Set("users", users); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("groups", groups); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("is_member", isMember); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%x", sha256.Sum256([]byte(id+"|"+username+"|"+groupName)))[:16])
+
+	return nil
+}
+
+func flattenSearchResults(results []testSearchResult) []any {
+	out := make([]any, 0, len(results))
+	for _, result := range results {
+		attributes := make(map[string]any, len(result.Attributes))
+		for key, value := range result.Attributes {
+			if value != nil {
+				attributes[key] = fmt.Sprint(value)
+			}
+		}
+		out = append(out, map[string]any{
+			"id":                 result.ID,
+			"uuid":               result.UUID,
+			"name":               result.Name,
+			"distinguished_name": result.DistinguishedName,
+			"attributes":         attributes,
+		})
+	}
+	return out
+}
@@ -0,0 +1,87 @@
+package cloud_idp
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProCloudIdpTestSearch runs a test user, group or membership search against a cloud
+// identity provider, to verify its server settings and mappings.
+func DataSourceJamfProCloudIdpTestSearch() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceTestSearchRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(70 * time.Second),
+		},
+		Schema: map[string]*schema.Schema{
+			"cloud_idp_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the cloud identity provider to search.",
+			},
+			"username": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"username", "group_name"},
+				Description:  "The username to search for.",
+			},
+			"group_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"username", "group_name"},
+				Description:  "The group name to search for. When 'username' is also set, the membership of the user in the group is tested too.",
+			},
+			"users": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The users matching 'username'.",
+				Elem:        searchResultSchema(),
+			},
+			"groups": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The groups matching 'group_name'.",
+				Elem:        searchResultSchema(),
+			},
+			"is_member": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the user is a member of the group. Only set when both 'username' and 'group_name' are set.",
+			},
+		},
+	}
+}
+
+func searchResultSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the user or group in the directory.",
+			},
+			"uuid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The UUID of the user or group in the directory.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the user or group.",
+			},
+			"distinguished_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The distinguished name of the user or group.",
+			},
+			"attributes": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The mapped attributes of the user or group, e.g. 'email' or 'realName'.",
+			},
+		},
+	}
+}
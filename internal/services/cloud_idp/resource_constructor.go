@@ -0,0 +1,112 @@
+package cloud_idp
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// azureConfiguration is the Jamf Pro API representation of a Microsoft Entra ID cloud identity
+// provider. The SDK has no support for it.
+type azureConfiguration struct {
+	CloudIdPCommon azureCommon `json:"cloudIdPCommon"`
+	Server         azureServer `json:"server"`
+}
+
+type azureCommon struct {
+	ID           string `json:"id,omitempty"`
+	DisplayName  string `json:"displayName"`
+	ProviderName string `json:"providerName"`
+}
+
+type azureServer struct {
+	ID                                       string        `json:"id,omitempty"`
+	TenantID                                 string        `json:"tenantId"`
+	Enabled                                  bool          `json:"enabled"`
+	Migrated                                 bool          `json:"migrated"`
+	Mappings                                 azureMappings `json:"mappings"`
+	SearchTimeout                            int           `json:"searchTimeout"`
+	TransitiveMembershipEnabled              bool          `json:"transitiveMembershipEnabled"`
+	TransitiveMembershipUserField            string        `json:"transitiveMembershipUserField,omitempty"`
+	TransitiveDirectoryMembershipEnabled     bool          `json:"transitiveDirectoryMembershipEnabled"`
+	MembershipCalculationOptimizationEnabled bool          `json:"membershipCalculationOptimizationEnabled"`
+	Code                                     string        `json:"code,omitempty"`
+}
+
+type azureMappings struct {
+	UserID     string `json:"userId"`
+	UserName   string `json:"userName"`
+	RealName   string `json:"realName"`
+	Email      string `json:"email"`
+	Department string `json:"department"`
+	Building   string `json:"building"`
+	Room       string `json:"room"`
+	Phone      string `json:"phone"`
+	Position   string `json:"position"`
+	GroupID    string `json:"groupId"`
+	GroupName  string `json:"groupName"`
+}
+
+// mappingFields pairs the mapping attributes with their fields in azureMappings.
+func mappingFields(m *azureMappings) map[string]*string {
+	return map[string]*string{
+		"user_mappings_id":            &m.UserID,
+		"user_mappings_username":      &m.UserName,
+		"user_mappings_real_name":     &m.RealName,
+		"user_mappings_email_address": &m.Email,
+		"user_mappings_department":    &m.Department,
+		"user_mappings_building":      &m.Building,
+		"user_mappings_room":          &m.Room,
+		"user_mappings_phone":         &m.Phone,
+		"user_mappings_position":      &m.Position,
+		"group_mappings_id":           &m.GroupID,
+		"group_mappings_name":         &m.GroupName,
+	}
+}
+
+// construct builds the cloud identity provider payload from the provided schema data. Mappings that
+// are not configured keep the values in defaults.
+func construct(d *schema.ResourceData, defaults azureMappings) (*azureConfiguration, error) {
+	resource := &azureConfiguration{
+		CloudIdPCommon: azureCommon{
+			DisplayName:  d.Get("display_name").(string),
+			ProviderName: "AZURE",
+		},
+		Server: azureServer{
+			TenantID:                                 d.Get("tenant_id").(string),
+			Enabled:                                  d.Get("enabled").(bool),
+			SearchTimeout:                            d.Get("search_timeout").(int),
+			TransitiveMembershipEnabled:              d.Get("transitive_membership_enabled").(bool),
+			TransitiveMembershipUserField:            d.Get("transitive_membership_user_field").(string),
+			TransitiveDirectoryMembershipEnabled:     d.Get("transitive_directory_membership_enabled").(bool),
+			MembershipCalculationOptimizationEnabled: d.Get("membership_calculation_optimization_enabled").(bool),
+			Mappings:                                 defaults,
+		},
+	}
+
+	if d.Id() == "" {
+		resource.Server.Code = d.Get("consent_code").(string)
+	} else {
+		resource.CloudIdPCommon.ID = d.Id()
+		resource.Server.ID = d.Id()
+	}
+
+	for key, field := range mappingFields(&resource.Server.Mappings) {
+		if value := d.Get(key).(string); value != "" {
+			*field = value
+		}
+	}
+
+	logged := *resource
+	logged.Server.Code = ""
+	resourceJSON, err := json.MarshalIndent(logged, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Jamf Pro Cloud Identity Provider '%s' to JSON: %v", resource.CloudIdPCommon.DisplayName, err)
+	}
+
+	log.Printf("[DEBUG] Constructed Jamf Pro Cloud Identity Provider JSON:\n%s\n", string(resourceJSON))
+
+	return resource, nil
+}
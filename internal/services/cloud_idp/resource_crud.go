@@ -0,0 +1,124 @@
+package cloud_idp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	crud "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/sdkv2_crud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const uriCloudAzure = "/api/v1/cloud-azure"
+
+// responseCreated is the response to creating a cloud identity provider.
+type responseCreated struct {
+	ID   string `json:"id"`
+	Href string `json:"href"`
+}
+
+// create creates and states a jamfpro Entra ID cloud identity provider
+func create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+
+	var defaults azureMappings
+	if err := send(client, "GET", uriCloudAzure+"/defaults/mappings", nil, &defaults); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read default Jamf Pro Cloud Identity Provider mappings: %v", err))
+	}
+
+	return crud.Create(
+		ctx,
+		d,
+		meta,
+		func(d *schema.ResourceData) (*azureConfiguration, error) {
+			return construct(d, defaults)
+		},
+		func(payload *azureConfiguration) (*responseCreated, error) {
+			var out responseCreated
+			return &out, send(client, "POST", uriCloudAzure, payload, &out)
+		},
+		readNoCleanup,
+	)
+}
+
+// read reads and states a jamfpro Entra ID cloud identity provider
+func read(ctx context.Context, d *schema.ResourceData, meta any, cleanup bool) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+	return crud.Read(
+		ctx,
+		d,
+		meta,
+		cleanup,
+		func(id string) (*azureConfiguration, error) {
+			var out azureConfiguration
+			return &out, send(client, "GET", fmt.Sprintf("%s/%s", uriCloudAzure, id), nil, &out)
+		},
+		updateResourceState,
+	)
+}
+
+// readWithCleanup reads the resource with cleanup enabled
+func readWithCleanup(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return read(ctx, d, meta, true)
+}
+
+// readNoCleanup reads the resource with cleanup disabled
+func readNoCleanup(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return read(ctx, d, meta, false)
+}
+
+// update updates a jamfpro Entra ID cloud identity provider. Mappings removed from configuration
+// keep their current values.
+func update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+
+	var current azureConfiguration
+	if err := send(client, "GET", fmt.Sprintf("%s/%s", uriCloudAzure, d.Id()), nil, &current); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read Jamf Pro Cloud Identity Provider ID %s: %v", d.Id(), err))
+	}
+
+	return crud.Update(
+		ctx,
+		d,
+		meta,
+		func(d *schema.ResourceData) (*azureConfiguration, error) {
+			payload, err := construct(d, current.Server.Mappings)
+			if err != nil {
+				return nil, err
+			}
+			payload.Server.Migrated = current.Server.Migrated
+			return payload, nil
+		},
+		func(id string, payload *azureConfiguration) (*azureConfiguration, error) {
+			var out azureConfiguration
+			return &out, send(client, "PUT", fmt.Sprintf("%s/%s", uriCloudAzure, id), payload, &out)
+		},
+		readNoCleanup,
+	)
+}
+
+// delete deletes a jamfpro Entra ID cloud identity provider
+func delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+	return crud.Delete(
+		ctx,
+		d,
+		meta,
+		func(id string) error {
+			return send(client, "DELETE", fmt.Sprintf("%s/%s", uriCloudAzure, id), nil, nil)
+		},
+	)
+}
+
+// send makes a cloud identity provider request and decodes the response into out.
+func send(client *jamfpro.Client, method, endpoint string, payload any, out any) error {
+	resp, err := client.HTTP.DoRequest(method, endpoint, payload, out)
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return fmt.Errorf("%s %s failed: %v", method, endpoint, err)
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package cloud_idp
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceJamfProCloudIdp defines the schema and CRUD operations for managing a Microsoft Entra ID
+// cloud identity provider in Terraform. Google Secure LDAP is managed with 'jamfpro_cloud_ldap'.
+func ResourceJamfProCloudIdp() *schema.Resource {
+	resource := &schema.Resource{
+		CreateContext: create,
+		ReadContext:   readWithCleanup,
+		UpdateContext: update,
+		DeleteContext: delete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(70 * time.Second),
+			Read:   schema.DefaultTimeout(70 * time.Second),
+			Update: schema.DefaultTimeout(70 * time.Second),
+			Delete: schema.DefaultTimeout(70 * time.Second),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The jamf pro unique identifier of the cloud identity provider.",
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The display name of the cloud identity provider.",
+			},
+			"provider_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the cloud identity provider, always 'AZURE'.",
+			},
+			"tenant_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+				Description:  "The ID of the Microsoft Entra ID tenant.",
+			},
+			"consent_code": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+				DiffSuppressFunc: func(k, oldValue, newValue string, d *schema.ResourceData) bool {
+					return d.Id() != ""
+				},
+				Description: "The code returned by Microsoft after an administrator grants the Jamf Pro app admin consent for the tenant. " +
+					"Only used when the connection is created; later changes are ignored.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the cloud identity provider is enabled.",
+			},
+			"search_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validation.IntBetween(5, 600),
+				Description:  "The number of seconds to wait for a directory search.",
+			},
+			"transitive_membership_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether group membership includes users that are members through nested groups.",
+			},
+			"transitive_membership_user_field": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The user field used to calculate transitive group membership.",
+			},
+			"transitive_directory_membership_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether transitive group membership is calculated by the directory.",
+			},
+			"membership_calculation_optimization_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether group membership lookups are optimized by fetching a user's groups in one request.",
+			},
+		},
+	}
+
+	for key, field := range mappingDescriptions {
+		resource.Schema[key] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "The Entra ID attribute mapped to the " + field + ". Jamf Pro's default mapping is used when omitted.",
+		}
+	}
+
+	return resource
+}
+
+// mappingDescriptions describes the user and group mapping attributes.
+var mappingDescriptions = map[string]string{
+	"user_mappings_id":            "user ID",
+	"user_mappings_username":      "username",
+	"user_mappings_real_name":     "full name",
+	"user_mappings_email_address": "email address",
+	"user_mappings_department":    "department",
+	"user_mappings_building":      "building",
+	"user_mappings_room":          "room",
+	"user_mappings_phone":         "phone number",
+	"user_mappings_position":      "position",
+	"group_mappings_id":           "group ID",
+	"group_mappings_name":         "group name",
+}
@@ -0,0 +1,35 @@
+package cloud_idp
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// updateResourceState updates the Terraform state with the latest Entra ID cloud identity provider
+// configuration from the Jamf Pro API.
+func updateResourceState(d *schema.ResourceData, resp *azureConfiguration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	resourceData := map[string]any{
+		"display_name":                     resp.CloudIdPCommon.DisplayName,
+		"provider_name":                    resp.CloudIdPCommon.ProviderName,
+		"tenant_id":                        resp.Server.TenantID,
+		"enabled":                          resp.Server.Enabled,
+		"search_timeout":                   resp.Server.SearchTimeout,
+		"transitive_membership_enabled":    resp.Server.TransitiveMembershipEnabled,
+		"transitive_membership_user_field": resp.Server.TransitiveMembershipUserField,
+		"transitive_directory_membership_enabled":     resp.Server.TransitiveDirectoryMembershipEnabled,
+		"membership_calculation_optimization_enabled": resp.Server.MembershipCalculationOptimizationEnabled,
+	}
+	for key, field := range mappingFields(&resp.Server.Mappings) {
+		resourceData[key] = *field
+	}
+
+	for key, val := range resourceData {
+		if err := d.Set(key, val); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,134 @@
+package computer_inventory
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"log"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const uriComputersInventory = "/api/v1/computers-inventory"
+
+// computerState collects the attributes of a single computer in the 'computers' list.
+type computerState map[string]any
+
+func (c computerState) Set(key string, value any) error {
+	c[key] = value
+	return nil
+}
+
+// dataSourceReadInventories pages through the computer inventory matching the configured filter,
+// stopping at 'max_results', and flattens the selected sections of each computer.
+func dataSourceReadInventories(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+
+	filter := d.Get("filter").(string)
+	sort := d.Get("sort").(string)
+	pageSize := d.Get("page_size").(int)
+	maxResults := d.Get("max_results").(int)
+
+	sections := selectedSections(d)
+	apiSections := make([]string, len(sections))
+	for i, section := range sections {
+		apiSections[i] = section.apiName
+	}
+
+	var computers []jamfpro.ResourceComputerInventory
+	var total int
+	for page := 0; len(computers) < maxResults; page++ {
+		var resp jamfpro.ResponseComputerInventoryList
+		err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
+			resp = jamfpro.ResponseComputerInventoryList{}
+			if _, apiErr := client.HTTP.DoRequest("GET", inventoriesPageURI(filter, sort, apiSections, page, pageSize), nil, &resp); apiErr != nil {
+				return retry.RetryableError(apiErr)
+			}
+			return nil
+		})
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed to read Jamf Pro Computer Inventory page %d with filter '%s' after retries: %v", page, filter, err))
+		}
+
+		total = resp.TotalCount
+		computers = append(computers, resp.Results...)
+		if len(resp.Results) < pageSize || len(computers) >= total {
+			break
+		}
+	}
+
+	truncated := len(computers) > maxResults || total > len(computers)
+	if len(computers) > maxResults {
+		computers = computers[:maxResults]
+	}
+	if truncated {
+		log.Printf("[WARN] Computer inventory with filter '%s' matched %d computers, only %d were read (max_results)", filter, total, len(computers))
+	}
+
+	list := make([]any, 0, len(computers))
+	for i := range computers {
+		computer := computerState{
+			"id":   computers[i].ID,
+			"udid": computers[i].UDID,
+		}
+		if err := setSections(computer, &computers[i], sections); err != nil {
+			return diag.FromErr(fmt.Errorf("failed to flatten computer ID '%s': %v", computers[i].ID, err))
+		}
+		list = append(list, map[string]any(computer))
+	}
+
+	id := strings.Join([]string{filter, sort, strings.Join(apiSections, ","), strconv.Itoa(maxResults)}, "|")
+	d.SetId(fmt.Sprintf("%x", sha256.Sum256([]byte(id)))[:16])
+
+	fields := map[string]any{
+		"total_count": total,
+		"truncated":   truncated,
+		"computers":   list,
+	}
+	for k, v := range fields {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+// selectedSections returns the configured sections in inventory order, defaulting to 'general'.
+func selectedSections(d *schema.ResourceData) []inventorySection {
+	selected := []any{"general"}
+	if v, ok := d.GetOk("sections"); ok {
+		selected = v.(*schema.Set).List()
+	}
+
+	var sections []inventorySection
+	for _, section := range computerInventorySections {
+		if slices.Contains(selected, any(section.attribute)) {
+			sections = append(sections, section)
+		}
+	}
+	return sections
+}
+
+func inventoriesPageURI(filter, sort string, sections []string, page, pageSize int) string {
+	params := url.Values{}
+	for _, section := range sections {
+		params.Add("section", section)
+	}
+	params.Set("page", strconv.Itoa(page))
+	params.Set("page-size", strconv.Itoa(pageSize))
+	if sort != "" {
+		params.Set("sort", sort)
+	}
+	if filter != "" {
+		params.Set("filter", filter)
+	}
+	return fmt.Sprintf("%s?%s", uriComputersInventory, params.Encode())
+}
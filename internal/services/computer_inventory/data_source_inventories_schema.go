@@ -0,0 +1,93 @@
+package computer_inventory
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	defaultInventoriesPageSize   = 100
+	defaultInventoriesMaxResults = 1000
+)
+
+// DataSourceJamfProComputerInventories provides the inventory of the computers matching an RSQL
+// filter, limited to the requested sections.
+func DataSourceJamfProComputerInventories() *schema.Resource {
+	computer := DataSourceJamfProComputerInventory().Schema
+	computer["id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The Jamf Pro ID of the computer.",
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceReadInventories,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Description: "Provides the inventory of the computers matching an RSQL filter from the Jamf Pro API.",
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "RSQL filter applied to the inventory, e.g. 'hardware.model==\"MacBookPro18,1\"'. " +
+					"All computers are returned when unset.",
+			},
+			"sort": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "id:asc",
+				Description: "Sort order of the results, e.g. 'general.name:asc'.",
+			},
+			"sections": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Inventory sections to populate. Only 'general' is populated when unset.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(sectionAttributes(), false),
+				},
+			},
+			"page_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultInventoriesPageSize,
+				ValidateFunc: validation.IntBetween(1, 2000),
+				Description:  "The number of computers requested per page.",
+			},
+			"max_results": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultInventoriesMaxResults,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The maximum number of computers read. Paging stops once this many computers have been read.",
+			},
+			"total_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of computers matching the filter, which may exceed the number returned.",
+			},
+			"truncated": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether more computers match the filter than 'max_results' allowed to be read.",
+			},
+			"computers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The computers matching the filter.",
+				Elem:        &schema.Resource{Schema: computer},
+			},
+		},
+	}
+}
+
+func sectionAttributes() []string {
+	names := make([]string, len(computerInventorySections))
+	for i, section := range computerInventorySections {
+		names[i] = section.attribute
+	}
+	return names
+}
@@ -49,111 +49,102 @@ func dataSourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.
 	d.Set("id", profile.ID)
 	d.Set("udid", profile.UDID)
 
-	// Set 'general' section
-	if err := setGeneralSection(d, profile.General); err != nil {
+	if err := setSections(d, profile, computerInventorySections); err != nil {
 		return diag.FromErr(err)
 	}
 
-	// Set 'diskEncryption' section
-	if err := setDiskEncryptionSection(d, profile.DiskEncryption); err != nil {
-		return diag.FromErr(err)
-	}
-
-	// Set 'purchasing' section
-	if err := setPurchasingSection(d, profile.Purchasing); err != nil {
-		return diag.FromErr(err)
-	}
-
-	// Set 'applications' section
-	if err := setApplicationsSection(d, profile.Applications); err != nil {
-		return diag.FromErr(err)
-	}
-
-	// Set 'storage' section
-	if err := setStorageSection(d, profile.Storage); err != nil {
-		return diag.FromErr(err)
-	}
-
-	// Set 'userAndLocation' section
-	if err := setUserAndLocationSection(d, profile.UserAndLocation); err != nil {
-		return diag.FromErr(err)
-	}
-
-	// Set 'hardware' section
-	if err := setHardwareSection(d, profile.Hardware); err != nil {
-		return diag.FromErr(err)
-	}
-
-	// Set 'localUserAccounts' section
-	if err := setLocalUserAccountsSection(d, profile.LocalUserAccounts); err != nil {
-		return diag.FromErr(err)
-	}
-
-	// Set 'certificates' section
-	if err := setCertificatesSection(d, profile.Certificates); err != nil {
-		return diag.FromErr(err)
-	}
-
-	// Set 'attachments' section
-	if err := setAttachmentsSection(d, profile.Attachments); err != nil {
-		return diag.FromErr(err)
-	}
-
-	// Set 'plugins' section
-	if err := setPluginsSection(d, profile.Plugins); err != nil {
-		return diag.FromErr(err)
-	}
-
-	// Set 'packageReceipts' section
-	if err := setPackageReceiptsSection(d, profile.PackageReceipts); err != nil {
-		return diag.FromErr(err)
-	}
-
-	// Set 'fonts' section
-	if err := setFontsSection(d, profile.Fonts); err != nil {
-		return diag.FromErr(err)
-	}
-
-	// Set 'security' section
-	if err := setSecuritySection(d, profile.Security); err != nil {
-		return diag.FromErr(err)
-	}
-
-	// Set 'operatingSystem' section
-	if err := setOperatingSystemSection(d, profile.OperatingSystem); err != nil {
-		return diag.FromErr(err)
-	}
-
-	// Set 'licensedSoftware' section
-	if err := setLicensedSoftwareSection(d, profile.LicensedSoftware); err != nil {
-		return diag.FromErr(err)
-	}
+	return nil
+}
 
-	// Set 'ibeacons' section
-	if err := setIBeaconsSection(d, profile.Ibeacons); err != nil {
-		return diag.FromErr(err)
-	}
+// stateSetter is satisfied by *schema.ResourceData and by the per computer maps of the
+// jamfpro_computer_inventories data source, so the section flatteners serve both.
+type stateSetter interface {
+	Set(key string, value any) error
+}
 
-	// Set 'softwareUpdates' section
-	if err := setSoftwareUpdatesSection(d, profile.SoftwareUpdates); err != nil {
-		return diag.FromErr(err)
-	}
+// inventorySection maps a section attribute to its Jamf Pro API section name and flattener.
+type inventorySection struct {
+	attribute string
+	apiName   string
+	set       func(d stateSetter, inventory *jamfpro.ResourceComputerInventory) error
+}
 
-	// Set 'extensionAttributes' section
-	if err := setExtensionAttributesSection(d, profile.ExtensionAttributes); err != nil {
-		return diag.FromErr(err)
-	}
+// computerInventorySections lists the inventory sections exposed by the data sources.
+var computerInventorySections = []inventorySection{
+	{"general", "GENERAL", func(d stateSetter, inv *jamfpro.ResourceComputerInventory) error {
+		return setGeneralSection(d, inv.General)
+	}},
+	{"disk_encryption", "DISK_ENCRYPTION", func(d stateSetter, inv *jamfpro.ResourceComputerInventory) error {
+		return setDiskEncryptionSection(d, inv.DiskEncryption)
+	}},
+	{"purchasing", "PURCHASING", func(d stateSetter, inv *jamfpro.ResourceComputerInventory) error {
+		return setPurchasingSection(d, inv.Purchasing)
+	}},
+	{"applications", "APPLICATIONS", func(d stateSetter, inv *jamfpro.ResourceComputerInventory) error {
+		return setApplicationsSection(d, inv.Applications)
+	}},
+	{"storage", "STORAGE", func(d stateSetter, inv *jamfpro.ResourceComputerInventory) error {
+		return setStorageSection(d, inv.Storage)
+	}},
+	{"user_and_location", "USER_AND_LOCATION", func(d stateSetter, inv *jamfpro.ResourceComputerInventory) error {
+		return setUserAndLocationSection(d, inv.UserAndLocation)
+	}},
+	{"hardware", "HARDWARE", func(d stateSetter, inv *jamfpro.ResourceComputerInventory) error {
+		return setHardwareSection(d, inv.Hardware)
+	}},
+	{"local_user_accounts", "LOCAL_USER_ACCOUNTS", func(d stateSetter, inv *jamfpro.ResourceComputerInventory) error {
+		return setLocalUserAccountsSection(d, inv.LocalUserAccounts)
+	}},
+	{"certificates", "CERTIFICATES", func(d stateSetter, inv *jamfpro.ResourceComputerInventory) error {
+		return setCertificatesSection(d, inv.Certificates)
+	}},
+	{"attachments", "ATTACHMENTS", func(d stateSetter, inv *jamfpro.ResourceComputerInventory) error {
+		return setAttachmentsSection(d, inv.Attachments)
+	}},
+	{"plugins", "PLUGINS", func(d stateSetter, inv *jamfpro.ResourceComputerInventory) error {
+		return setPluginsSection(d, inv.Plugins)
+	}},
+	{"package_receipts", "PACKAGE_RECEIPTS", func(d stateSetter, inv *jamfpro.ResourceComputerInventory) error {
+		return setPackageReceiptsSection(d, inv.PackageReceipts)
+	}},
+	{"fonts", "FONTS", func(d stateSetter, inv *jamfpro.ResourceComputerInventory) error {
+		return setFontsSection(d, inv.Fonts)
+	}},
+	{"security", "SECURITY", func(d stateSetter, inv *jamfpro.ResourceComputerInventory) error {
+		return setSecuritySection(d, inv.Security)
+	}},
+	{"operating_system", "OPERATING_SYSTEM", func(d stateSetter, inv *jamfpro.ResourceComputerInventory) error {
+		return setOperatingSystemSection(d, inv.OperatingSystem)
+	}},
+	{"licensed_software", "LICENSED_SOFTWARE", func(d stateSetter, inv *jamfpro.ResourceComputerInventory) error {
+		return setLicensedSoftwareSection(d, inv.LicensedSoftware)
+	}},
+	{"ibeacons", "IBEACONS", func(d stateSetter, inv *jamfpro.ResourceComputerInventory) error {
+		return setIBeaconsSection(d, inv.Ibeacons)
+	}},
+	{"software_updates", "SOFTWARE_UPDATES", func(d stateSetter, inv *jamfpro.ResourceComputerInventory) error {
+		return setSoftwareUpdatesSection(d, inv.SoftwareUpdates)
+	}},
+	{"extension_attributes", "EXTENSION_ATTRIBUTES", func(d stateSetter, inv *jamfpro.ResourceComputerInventory) error {
+		return setExtensionAttributesSection(d, inv.ExtensionAttributes)
+	}},
+	{"group_memberships", "GROUP_MEMBERSHIPS", func(d stateSetter, inv *jamfpro.ResourceComputerInventory) error {
+		return setGroupMembershipsSection(d, inv.GroupMemberships)
+	}},
+}
 
-	// Set 'groupMemberships' section
-	if err := setGroupMembershipsSection(d, profile.GroupMemberships); err != nil {
-		return diag.FromErr(err)
+// setSections flattens each of sections of inventory into d.
+func setSections(d stateSetter, inventory *jamfpro.ResourceComputerInventory, sections []inventorySection) error {
+	for _, section := range sections {
+		if err := section.set(d, inventory); err != nil {
+			return fmt.Errorf("failed to set '%s': %v", section.attribute, err)
+		}
 	}
-
 	return nil
 }
 
 // setGeneralSection maps the 'general' section of the computer inventory response to the Terraform resource data and updates the state.
-func setGeneralSection(d *schema.ResourceData, general jamfpro.ComputerInventorySubsetGeneral) error {
+func setGeneralSection(d stateSetter, general jamfpro.ComputerInventorySubsetGeneral) error {
 	// Initialize a map to hold the 'general' section attributes.
 	gen := make(map[string]any)
 
@@ -167,7 +158,10 @@ func setGeneralSection(d *schema.ResourceData, general jamfpro.ComputerInventory
 	gen["barcode2"] = general.Barcode2
 	gen["asset_tag"] = general.AssetTag
 	gen["supervised"] = general.Supervised
-	gen["mdm_capable"] = general.MdmCapable.Capable
+	gen["mdm_capable"] = []any{map[string]any{
+		"capable":       general.MdmCapable.Capable,
+		"capable_users": general.MdmCapable.CapableUsers,
+	}}
 	gen["report_date"] = general.ReportDate
 	gen["last_contact_time"] = general.LastContactTime
 	gen["last_cloud_backup_date"] = general.LastCloudBackupDate
@@ -209,7 +203,7 @@ func setGeneralSection(d *schema.ResourceData, general jamfpro.ComputerInventory
 }
 
 // setDiskEncryptionSection maps the 'diskEncryption' section of the computer inventory response to the Terraform resource data and updates the state.
-func setDiskEncryptionSection(d *schema.ResourceData, diskEncryption jamfpro.ComputerInventorySubsetDiskEncryption) error {
+func setDiskEncryptionSection(d stateSetter, diskEncryption jamfpro.ComputerInventorySubsetDiskEncryption) error {
 	// Initialize a map to hold the 'diskEncryption' section attributes.
 	diskEnc := make(map[string]any)
 
@@ -238,7 +232,7 @@ func setDiskEncryptionSection(d *schema.ResourceData, diskEncryption jamfpro.Com
 }
 
 // setPurchasingSection maps the 'purchasing' section of the computer inventory response to the Terraform resource data and updates the state.
-func setPurchasingSection(d *schema.ResourceData, purchasing jamfpro.ComputerInventorySubsetPurchasing) error {
+func setPurchasingSection(d stateSetter, purchasing jamfpro.ComputerInventorySubsetPurchasing) error {
 	// Initialize a map to hold the 'purchasing' section attributes.
 	purchasingMap := make(map[string]any)
 
@@ -279,7 +273,7 @@ func setPurchasingSection(d *schema.ResourceData, purchasing jamfpro.ComputerInv
 }
 
 // setApplicationsSection maps the 'applications' section of the computer inventory response to the Terraform resource data and updates the state.
-func setApplicationsSection(d *schema.ResourceData, applications []jamfpro.ComputerInventorySubsetApplication) error {
+func setApplicationsSection(d stateSetter, applications []jamfpro.ComputerInventorySubsetApplication) error {
 	// Create a slice to hold the application maps.
 	apps := make([]any, len(applications))
 
@@ -306,7 +300,7 @@ func setApplicationsSection(d *schema.ResourceData, applications []jamfpro.Compu
 }
 
 // setStorageSection maps the 'storage' section of the computer inventory response to the Terraform resource data and updates the state.
-func setStorageSection(d *schema.ResourceData, storage jamfpro.ComputerInventorySubsetStorage) error {
+func setStorageSection(d stateSetter, storage jamfpro.ComputerInventorySubsetStorage) error {
 	storageMap := make(map[string]any)
 
 	storageMap["boot_drive_available_space_megabytes"] = storage.BootDriveAvailableSpaceMegabytes
@@ -349,7 +343,7 @@ func setStorageSection(d *schema.ResourceData, storage jamfpro.ComputerInventory
 }
 
 // setUserAndLocationSection maps the 'userAndLocation' section of the computer inventory response to the Terraform resource data and updates the state.
-func setUserAndLocationSection(d *schema.ResourceData, userAndLocation jamfpro.ComputerInventorySubsetUserAndLocation) error {
+func setUserAndLocationSection(d stateSetter, userAndLocation jamfpro.ComputerInventorySubsetUserAndLocation) error {
 	userLocationMap := make(map[string]any)
 
 	// Map each attribute from the 'userAndLocation' object to the corresponding schema attribute
@@ -387,7 +381,7 @@ func setUserAndLocationSection(d *schema.ResourceData, userAndLocation jamfpro.C
 }
 
 // setHardwareSection maps the 'hardware' section of the computer inventory response to the Terraform resource data and updates the state.
-func setHardwareSection(d *schema.ResourceData, hardware jamfpro.ComputerInventorySubsetHardware) error {
+func setHardwareSection(d stateSetter, hardware jamfpro.ComputerInventorySubsetHardware) error {
 	hardwareMap := make(map[string]any)
 
 	// Map each attribute from the 'hardware' object to the corresponding schema attribute
@@ -442,7 +436,7 @@ func setHardwareSection(d *schema.ResourceData, hardware jamfpro.ComputerInvento
 }
 
 // setLocalUserAccountsSection maps the 'localUserAccounts' section of the computer inventory response to the Terraform resource data and updates the state.
-func setLocalUserAccountsSection(d *schema.ResourceData, localUserAccounts []jamfpro.ComputerInventorySubsetLocalUserAccount) error {
+func setLocalUserAccountsSection(d stateSetter, localUserAccounts []jamfpro.ComputerInventorySubsetLocalUserAccount) error {
 	accounts := make([]any, len(localUserAccounts))
 	for i, account := range localUserAccounts {
 		acc := make(map[string]any)
@@ -465,11 +459,11 @@ func setLocalUserAccountsSection(d *schema.ResourceData, localUserAccounts []jam
 		acc["azure_active_directory_id"] = account.AzureActiveDirectoryId
 		accounts[i] = acc
 	}
-	return d.Set("localUserAccounts", accounts)
+	return d.Set("local_user_accounts", accounts)
 }
 
 // setCertificatesSection maps the 'certificate' section of the computer inventory response to the Terraform resource data and updates the state.
-func setCertificatesSection(d *schema.ResourceData, certificates []jamfpro.ComputerInventorySubsetCertificate) error {
+func setCertificatesSection(d stateSetter, certificates []jamfpro.ComputerInventorySubsetCertificate) error {
 	certs := make([]any, len(certificates))
 	for i, cert := range certificates {
 		certMap := make(map[string]any)
@@ -489,7 +483,7 @@ func setCertificatesSection(d *schema.ResourceData, certificates []jamfpro.Compu
 }
 
 // setAttachmentsSection maps the 'attachments' section of the computer inventory response to the Terraform resource data and updates the state.
-func setAttachmentsSection(d *schema.ResourceData, attachments []jamfpro.ComputerInventorySubsetAttachment) error {
+func setAttachmentsSection(d stateSetter, attachments []jamfpro.ComputerInventorySubsetAttachment) error {
 	atts := make([]any, len(attachments))
 	for i, att := range attachments {
 		attMap := make(map[string]any)
@@ -503,7 +497,7 @@ func setAttachmentsSection(d *schema.ResourceData, attachments []jamfpro.Compute
 }
 
 // setPluginsSection maps the 'plugins' section of the computer inventory response to the Terraform resource data and updates the state.
-func setPluginsSection(d *schema.ResourceData, plugins []jamfpro.ComputerInventorySubsetPlugin) error {
+func setPluginsSection(d stateSetter, plugins []jamfpro.ComputerInventorySubsetPlugin) error {
 	pluginList := make([]any, len(plugins))
 	for i, plugin := range plugins {
 		pluginMap := make(map[string]any)
@@ -516,7 +510,7 @@ func setPluginsSection(d *schema.ResourceData, plugins []jamfpro.ComputerInvento
 }
 
 // setPackageReceiptsSection maps the 'package receipts' section of the computer inventory response to the Terraform resource data and updates the state.
-func setPackageReceiptsSection(d *schema.ResourceData, packageReceipts jamfpro.ComputerInventorySubsetPackageReceipts) error {
+func setPackageReceiptsSection(d stateSetter, packageReceipts jamfpro.ComputerInventorySubsetPackageReceipts) error {
 	packageReceiptMap := make(map[string]any)
 	packageReceiptMap["installed_by_jamf_pro"] = packageReceipts.InstalledByJamfPro
 	packageReceiptMap["installed_by_installer_swu"] = packageReceipts.InstalledByInstallerSwu
@@ -525,7 +519,7 @@ func setPackageReceiptsSection(d *schema.ResourceData, packageReceipts jamfpro.C
 }
 
 // setFontsSection maps the 'fonts' section of the computer inventory response to the Terraform resource data and updates the state.
-func setFontsSection(d *schema.ResourceData, fonts []jamfpro.ComputerInventorySubsetFont) error {
+func setFontsSection(d stateSetter, fonts []jamfpro.ComputerInventorySubsetFont) error {
 	fontsList := make([]any, len(fonts))
 	for i, font := range fonts {
 		fontMap := make(map[string]any)
@@ -538,7 +532,7 @@ func setFontsSection(d *schema.ResourceData, fonts []jamfpro.ComputerInventorySu
 }
 
 // setSecuritySection maps the 'security' section of the computer inventory response to the Terraform resource data and updates the state.
-func setSecuritySection(d *schema.ResourceData, security jamfpro.ComputerInventorySubsetSecurity) error {
+func setSecuritySection(d stateSetter, security jamfpro.ComputerInventorySubsetSecurity) error {
 	securityMap := make(map[string]any)
 	securityMap["sip_status"] = security.SipStatus
 	securityMap["gatekeeper_status"] = security.GatekeeperStatus
@@ -555,7 +549,7 @@ func setSecuritySection(d *schema.ResourceData, security jamfpro.ComputerInvento
 }
 
 // setOperatingSystemSection maps the 'Operating System' section of the computer inventory response to the Terraform resource data and updates the state.
-func setOperatingSystemSection(d *schema.ResourceData, operatingSystem jamfpro.ComputerInventorySubsetOperatingSystem) error {
+func setOperatingSystemSection(d stateSetter, operatingSystem jamfpro.ComputerInventorySubsetOperatingSystem) error {
 	osMap := make(map[string]any)
 	osMap["name"] = operatingSystem.Name
 	osMap["version"] = operatingSystem.Version
@@ -564,7 +558,7 @@ func setOperatingSystemSection(d *schema.ResourceData, operatingSystem jamfpro.C
 	osMap["rapid_security_response"] = operatingSystem.RapidSecurityResponse
 	osMap["active_directory_status"] = operatingSystem.ActiveDirectoryStatus
 	osMap["filevault2_status"] = operatingSystem.FileVault2Status
-	osMap["softwareUpdate_device_id"] = operatingSystem.SoftwareUpdateDeviceId
+	osMap["software_update_device_id"] = operatingSystem.SoftwareUpdateDeviceId
 	// Map extension attributes if present
 	extAttrs := make([]map[string]any, len(operatingSystem.ExtensionAttributes))
 	for i, attr := range operatingSystem.ExtensionAttributes {
@@ -586,7 +580,7 @@ func setOperatingSystemSection(d *schema.ResourceData, operatingSystem jamfpro.C
 }
 
 // setLicensedSoftwareSection maps the 'Licensed Software' section of the computer inventory response to the Terraform resource data and updates the state.
-func setLicensedSoftwareSection(d *schema.ResourceData, licensedSoftware []jamfpro.ComputerInventorySubsetLicensedSoftware) error {
+func setLicensedSoftwareSection(d stateSetter, licensedSoftware []jamfpro.ComputerInventorySubsetLicensedSoftware) error {
 	softwareList := make([]any, len(licensedSoftware))
 	for i, software := range licensedSoftware {
 		softwareMap := make(map[string]any)
@@ -598,7 +592,7 @@ func setLicensedSoftwareSection(d *schema.ResourceData, licensedSoftware []jamfp
 }
 
 // setIBeaconsSection maps the 'IBeacons' section of the computer inventory response to the Terraform resource data and updates the state.
-func setIBeaconsSection(d *schema.ResourceData, ibeacons []jamfpro.ComputerInventorySubsetIBeacon) error {
+func setIBeaconsSection(d stateSetter, ibeacons []jamfpro.ComputerInventorySubsetIBeacon) error {
 	ibeaconList := make([]any, len(ibeacons))
 	for i, ibeacon := range ibeacons {
 		ibeaconMap := make(map[string]any)
@@ -609,7 +603,7 @@ func setIBeaconsSection(d *schema.ResourceData, ibeacons []jamfpro.ComputerInven
 }
 
 // setSoftwareUpdatesSection maps the 'Software Updates' section of the computer inventory response to the Terraform resource data and updates the state.
-func setSoftwareUpdatesSection(d *schema.ResourceData, softwareUpdates []jamfpro.ComputerInventorySubsetSoftwareUpdate) error {
+func setSoftwareUpdatesSection(d stateSetter, softwareUpdates []jamfpro.ComputerInventorySubsetSoftwareUpdate) error {
 	updateList := make([]any, len(softwareUpdates))
 	for i, update := range softwareUpdates {
 		updateMap := make(map[string]any)
@@ -622,7 +616,7 @@ func setSoftwareUpdatesSection(d *schema.ResourceData, softwareUpdates []jamfpro
 }
 
 // setExtensionAttributesSection maps the 'Extension Attributes' section of the computer inventory response to the Terraform resource data and updates the state.
-func setExtensionAttributesSection(d *schema.ResourceData, extensionAttributes []jamfpro.ComputerInventorySubsetExtensionAttribute) error {
+func setExtensionAttributesSection(d stateSetter, extensionAttributes []jamfpro.ComputerInventorySubsetExtensionAttribute) error {
 	attrList := make([]any, len(extensionAttributes))
 	for i, attr := range extensionAttributes {
 		attrMap := make(map[string]any)
@@ -641,7 +635,7 @@ func setExtensionAttributesSection(d *schema.ResourceData, extensionAttributes [
 }
 
 // setGroupMembershipsSection maps the 'groupMemberships' section of the computer inventory response to the Terraform resource data and updates the state.
-func setGroupMembershipsSection(d *schema.ResourceData, groupMemberships []jamfpro.ComputerInventorySubsetGroupMembership) error {
+func setGroupMembershipsSection(d stateSetter, groupMemberships []jamfpro.ComputerInventorySubsetGroupMembership) error {
 	memberships := make([]any, len(groupMemberships))
 	for i, group := range groupMemberships {
 		groupMap := make(map[string]any)
@@ -0,0 +1,46 @@
+package computer_inventory
+
+import (
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testInventory() *jamfpro.ResourceComputerInventory {
+	inventory := &jamfpro.ResourceComputerInventory{ID: "7", UDID: "UDID-7"}
+	inventory.General.Name = "mac-7"
+	inventory.General.MdmCapable.Capable = true
+	inventory.General.MdmCapable.CapableUsers = []string{"alice"}
+	inventory.Hardware.SerialNumber = "C02ABC"
+	inventory.OperatingSystem.SoftwareUpdateDeviceId = "J413AP"
+	inventory.LocalUserAccounts = []jamfpro.ComputerInventorySubsetLocalUserAccount{{Username: "alice"}}
+	inventory.Purchasing.ExtensionAttributes = []jamfpro.ComputerInventorySubsetExtensionAttribute{{Name: "Cost Centre"}}
+	return inventory
+}
+
+func TestSetSectionsResourceData(t *testing.T) {
+	d := DataSourceJamfProComputerInventory().TestResourceData()
+
+	require.NoError(t, setSections(d, testInventory(), computerInventorySections))
+
+	assert.Equal(t, "mac-7", d.Get("general.0.name"))
+	assert.Equal(t, true, d.Get("general.0.mdm_capable.0.capable"))
+	assert.Equal(t, "alice", d.Get("local_user_accounts.0.username"))
+	assert.Equal(t, "J413AP", d.Get("operating_system.0.software_update_device_id"))
+}
+
+func TestSetSectionsComputerList(t *testing.T) {
+	d := DataSourceJamfProComputerInventories().TestResourceData()
+
+	computer := computerState{"id": "7"}
+	sections := computerInventorySections
+	require.NoError(t, setSections(computer, testInventory(), sections))
+	require.NoError(t, d.Set("computers", []any{map[string]any(computer)}))
+
+	assert.Equal(t, "7", d.Get("computers.0.id"))
+	assert.Equal(t, "mac-7", d.Get("computers.0.general.0.name"))
+	assert.Equal(t, "C02ABC", d.Get("computers.0.hardware.0.serial_number"))
+	assert.Equal(t, 1, d.Get("computers.0.local_user_accounts.#"))
+}
@@ -0,0 +1,100 @@
+package computer_inventory_record
+
+import (
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/inventory_record"
+)
+
+// computerInventoryPatch is the body of PATCH /api/v1/computers-inventory-detail/{id}. Only non
+// nil fields are sent, so every other inventory field is left untouched.
+type computerInventoryPatch struct {
+	General             *generalPatch             `json:"general,omitempty"`
+	Purchasing          *purchasingPatch          `json:"purchasing,omitempty"`
+	UserAndLocation     *userAndLocationPatch     `json:"userAndLocation,omitempty"`
+	ExtensionAttributes []extensionAttributePatch `json:"extensionAttributes,omitempty"`
+}
+
+type generalPatch struct {
+	AssetTag *string `json:"assetTag,omitempty"`
+	SiteID   *string `json:"siteId,omitempty"`
+}
+
+type userAndLocationPatch struct {
+	Username     *string `json:"username,omitempty"`
+	Realname     *string `json:"realname,omitempty"`
+	Email        *string `json:"email,omitempty"`
+	Position     *string `json:"position,omitempty"`
+	Phone        *string `json:"phone,omitempty"`
+	DepartmentID *string `json:"departmentId,omitempty"`
+	BuildingID   *string `json:"buildingId,omitempty"`
+	Room         *string `json:"room,omitempty"`
+}
+
+type purchasingPatch struct {
+	Purchased         *bool   `json:"purchased,omitempty"`
+	Leased            *bool   `json:"leased,omitempty"`
+	PoNumber          *string `json:"poNumber,omitempty"`
+	PoDate            *string `json:"poDate,omitempty"`
+	Vendor            *string `json:"vendor,omitempty"`
+	AppleCareID       *string `json:"appleCareId,omitempty"`
+	PurchasePrice     *string `json:"purchasePrice,omitempty"`
+	PurchasingAccount *string `json:"purchasingAccount,omitempty"`
+	PurchasingContact *string `json:"purchasingContact,omitempty"`
+	WarrantyDate      *string `json:"warrantyDate,omitempty"`
+	LeaseDate         *string `json:"leaseDate,omitempty"`
+	LifeExpectancy    *int    `json:"lifeExpectancy,omitempty"`
+}
+
+type extensionAttributePatch struct {
+	DefinitionID string   `json:"definitionId"`
+	Values       []string `json:"values"`
+}
+
+// construct builds the inventory PATCH body from the changed fields.
+func construct(patch inventory_record.Patch) *computerInventoryPatch {
+	out := &computerInventoryPatch{}
+
+	if patch.AssetTag != nil || patch.SiteID != nil {
+		out.General = &generalPatch{AssetTag: patch.AssetTag, SiteID: patch.SiteID}
+	}
+
+	if patch.UserAndLocation != nil || patch.BuildingID != nil || patch.DepartmentID != nil {
+		out.UserAndLocation = &userAndLocationPatch{
+			BuildingID:   patch.BuildingID,
+			DepartmentID: patch.DepartmentID,
+		}
+		if u := patch.UserAndLocation; u != nil {
+			out.UserAndLocation.Username = u.Username
+			out.UserAndLocation.Realname = u.RealName
+			out.UserAndLocation.Email = u.EmailAddress
+			out.UserAndLocation.Position = u.Position
+			out.UserAndLocation.Phone = u.PhoneNumber
+			out.UserAndLocation.Room = u.Room
+		}
+	}
+
+	if p := patch.Purchasing; p != nil {
+		out.Purchasing = &purchasingPatch{
+			Purchased:         p.Purchased,
+			Leased:            p.Leased,
+			PoNumber:          p.PoNumber,
+			PoDate:            p.PoDate,
+			Vendor:            p.Vendor,
+			AppleCareID:       p.AppleCareID,
+			PurchasePrice:     p.PurchasePrice,
+			PurchasingAccount: p.PurchasingAccount,
+			PurchasingContact: p.PurchasingContact,
+			WarrantyDate:      p.WarrantyDate,
+			LeaseDate:         p.LeaseDate,
+			LifeExpectancy:    p.LifeExpectancy,
+		}
+	}
+
+	for _, ea := range patch.ExtensionAttributes {
+		out.ExtensionAttributes = append(out.ExtensionAttributes, extensionAttributePatch{
+			DefinitionID: ea.ID,
+			Values:       ea.Values,
+		})
+	}
+
+	return out
+}
@@ -0,0 +1,23 @@
+package computer_inventory_record
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/inventory_record"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConstructSendsOnlyPatchedFields(t *testing.T) {
+	poNumber := "PO-1"
+	username := "alice"
+
+	body, err := json.Marshal(construct(inventory_record.Patch{
+		Purchasing:      &inventory_record.PurchasingPatch{PoNumber: &poNumber},
+		UserAndLocation: &inventory_record.UserAndLocationPatch{Username: &username},
+	}))
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{"purchasing":{"poNumber":"PO-1"},"userAndLocation":{"username":"alice"}}`, string(body))
+}
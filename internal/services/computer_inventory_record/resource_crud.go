@@ -0,0 +1,139 @@
+package computer_inventory_record
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/device_identifiers"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/errors"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/inventory_record"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const uriComputersInventoryDetail = "/api/v1/computers-inventory-detail"
+
+// create looks up the computer by serial number and applies the configured inventory fields.
+func create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+	serialNumber := d.Get("serial_number").(string)
+
+	id, err := lookupComputerID(client, serialNumber)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(id)
+
+	if diags := apply(ctx, d, client, schema.TimeoutCreate); diags.HasError() {
+		d.SetId("")
+		return diags
+	}
+
+	return read(ctx, d, meta, false)
+}
+
+// read reads the inventory record of the computer. A computer deleted from Jamf Pro is dropped from
+// state.
+func read(ctx context.Context, d *schema.ResourceData, meta any, cleanup bool) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+
+	var inventory *jamfpro.ResourceComputerInventory
+	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
+		var apiErr error
+		inventory, apiErr = client.GetComputerInventoryByID(d.Id())
+		if apiErr != nil {
+			if strings.Contains(apiErr.Error(), "404") {
+				return retry.NonRetryableError(apiErr)
+			}
+			return retry.RetryableError(apiErr)
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.HandleResourceNotFoundError(err, d, cleanup)
+	}
+
+	if err := inventory_record.SetState(d, recordFromInventory(inventory)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// readWithCleanup reads the resource with cleanup enabled
+func readWithCleanup(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return read(ctx, d, meta, true)
+}
+
+// update applies the changed inventory fields.
+func update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	if diags := apply(ctx, d, meta.(*jamfpro.Client), schema.TimeoutUpdate); diags.HasError() {
+		return diags
+	}
+
+	return read(ctx, d, meta, false)
+}
+
+// delete stops managing the inventory fields. The computer and its inventory are left as they are.
+func delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	log.Printf("[INFO] Removing Jamf Pro Computer Inventory Record for computer ID %s from state; the computer is not modified", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// importState accepts the serial number of the computer.
+func importState(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	serialNumber := d.Id()
+
+	id, err := lookupComputerID(meta.(*jamfpro.Client), serialNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(id)
+	if err := d.Set("serial_number", serialNumber); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// apply sends the changed inventory fields to Jamf Pro.
+func apply(ctx context.Context, d *schema.ResourceData, client *jamfpro.Client, timeout string) diag.Diagnostics {
+	patch := inventory_record.ExpandPatch(d)
+	if patch.IsEmpty() {
+		return nil
+	}
+
+	payload := construct(patch)
+	endpoint := fmt.Sprintf("%s/%s", uriComputersInventoryDetail, d.Id())
+
+	err := retry.RetryContext(ctx, d.Timeout(timeout), func() *retry.RetryError {
+		resp, apiErr := client.HTTP.DoRequest("PATCH", endpoint, payload, nil)
+		if resp != nil && resp.Body != nil {
+			defer resp.Body.Close()
+		}
+		if apiErr != nil {
+			return retry.RetryableError(apiErr)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to update Jamf Pro Computer Inventory Record for computer ID %s: %v", d.Id(), err))
+	}
+
+	return nil
+}
+
+func lookupComputerID(client *jamfpro.Client, serialNumber string) (string, error) {
+	ids, err := device_identifiers.ResolveIDs(client, device_identifiers.Computers, []string{serialNumber}, nil)
+	if err != nil {
+		return "", err
+	}
+	return strconv.Itoa(ids[0]), nil
+}
@@ -0,0 +1,65 @@
+package computer_inventory_record
+
+import (
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/inventory_record"
+)
+
+// recordFromInventory extracts the managed fields from a computer inventory response.
+// Extension attributes are collected from every section they can be displayed in.
+func recordFromInventory(inventory *jamfpro.ResourceComputerInventory) inventory_record.Record {
+	general := inventory.General
+	user := inventory.UserAndLocation
+	purchasing := inventory.Purchasing
+
+	record := inventory_record.Record{
+		ID:           inventory.ID,
+		SerialNumber: inventory.Hardware.SerialNumber,
+		UDID:         inventory.UDID,
+		Name:         general.Name,
+		AssetTag:     general.AssetTag,
+		SiteID:       general.Site.ID,
+		BuildingID:   user.BuildingId,
+		DepartmentID: user.DepartmentId,
+		UserAndLocation: inventory_record.UserAndLocation{
+			Username:     user.Username,
+			RealName:     user.Realname,
+			EmailAddress: user.Email,
+			Position:     user.Position,
+			PhoneNumber:  user.Phone,
+			Room:         user.Room,
+		},
+		Purchasing: inventory_record.Purchasing{
+			Purchased:         purchasing.Purchased,
+			Leased:            purchasing.Leased,
+			PoNumber:          purchasing.PoNumber,
+			PoDate:            purchasing.PoDate,
+			Vendor:            purchasing.Vendor,
+			AppleCareID:       purchasing.AppleCareId,
+			PurchasePrice:     purchasing.PurchasePrice,
+			PurchasingAccount: purchasing.PurchasingAccount,
+			PurchasingContact: purchasing.PurchasingContact,
+			WarrantyDate:      purchasing.WarrantyDate,
+			LeaseDate:         purchasing.LeaseDate,
+			LifeExpectancy:    purchasing.LifeExpectancy,
+		},
+	}
+
+	for _, list := range [][]jamfpro.ComputerInventorySubsetExtensionAttribute{
+		inventory.ExtensionAttributes,
+		general.ExtensionAttributes,
+		inventory.Hardware.ExtensionAttributes,
+		inventory.OperatingSystem.ExtensionAttributes,
+		user.ExtensionAttributes,
+		purchasing.ExtensionAttributes,
+	} {
+		for _, ea := range list {
+			record.ExtensionAttributes = append(record.ExtensionAttributes, inventory_record.ExtensionAttribute{
+				ID:     ea.DefinitionId,
+				Values: ea.Values,
+			})
+		}
+	}
+
+	return record
+}
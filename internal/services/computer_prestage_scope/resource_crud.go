@@ -0,0 +1,34 @@
+package computer_prestage_scope
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/prestage_scope"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// create assigns the configured serial numbers to the computer prestage
+func create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return prestage_scope.Computers.Create(ctx, d, meta)
+}
+
+// readWithCleanup reads the computer prestage scope with cleanup enabled
+func readWithCleanup(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return prestage_scope.Computers.Read(ctx, d, meta)
+}
+
+// update applies changed serial numbers to the computer prestage
+func update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return prestage_scope.Computers.Update(ctx, d, meta)
+}
+
+// delete removes the managed serial numbers from the computer prestage
+func delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return prestage_scope.Computers.Delete(ctx, d, meta)
+}
+
+// importState accepts the ID of the computer prestage
+func importState(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	return prestage_scope.Computers.Import(ctx, d, meta)
+}
@@ -0,0 +1,156 @@
+package device_enrollment_devices
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const uriDeviceEnrollments = "/api/v1/device-enrollments"
+
+// deviceList is the list of devices assigned to a device enrollment instance.
+type deviceList struct {
+	TotalCount int      `json:"totalCount"`
+	Results    []device `json:"results"`
+}
+
+type device struct {
+	ID                 string `json:"id"`
+	SerialNumber       string `json:"serialNumber"`
+	Description        string `json:"description"`
+	Model              string `json:"model"`
+	Color              string `json:"color"`
+	AssetTag           string `json:"assetTag"`
+	PrestageID         string `json:"prestageId"`
+	ProfileStatus      string `json:"profileStatus"`
+	ProfileAssignTime  string `json:"profileAssignTime"`
+	ProfilePushTime    string `json:"profilePushTime"`
+	DeviceAssignedDate string `json:"deviceAssignedDate"`
+}
+
+// filter holds the filters of the data source.
+type filter struct {
+	serialNumbers map[string]bool
+	model         string
+	prestageID    string
+	unassigned    bool
+	profileStatus string
+}
+
+// dataSourceRead fetches the devices assigned to a device enrollment instance and applies the filters.
+func dataSourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+	instanceID := d.Get("device_enrollment_id").(string)
+
+	var list deviceList
+	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
+		endpoint := fmt.Sprintf("%s/%s/devices", uriDeviceEnrollments, instanceID)
+		resp, apiErr := client.HTTP.DoRequest("GET", endpoint, nil, &list)
+		if resp != nil && resp.Body != nil {
+			defer resp.Body.Close()
+		}
+		if apiErr != nil {
+			if strings.Contains(apiErr.Error(), "404") {
+				return retry.NonRetryableError(apiErr)
+			}
+			return retry.RetryableError(apiErr)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read devices of Jamf Pro Device Enrollment ID %s after retries: %v", instanceID, err))
+	}
+
+	f := filter{
+		serialNumbers: make(map[string]bool),
+		model:         d.Get("model").(string),
+		prestageID:    d.Get("prestage_id").(string),
+		unassigned:    d.Get("unassigned").(bool),
+		profileStatus: d.Get("profile_status").(string),
+	}
+	for _, v := range d.Get("serial_numbers").(*schema.Set).List() {
+		f.serialNumbers[strings.ToUpper(v.(string))] = true
+	}
+
+	matched := f.apply(list.Results)
+
+	devices := make([]any, 0, len(matched))
+	for _, dev := range matched {
+		devices = append(devices, map[string]any{
+			"id":                   dev.ID,
+			"serial_number":        dev.SerialNumber,
+			"model":                dev.Model,
+			"description":          dev.Description,
+			"color":                dev.Color,
+			"asset_tag":            dev.AssetTag,
+			"prestage_id":          prestageID(dev),
+			"profile_status":       dev.ProfileStatus,
+			"profile_assign_time":  dev.ProfileAssignTime,
+			"profile_push_time":    dev.ProfilePushTime,
+			"device_assigned_date": dev.DeviceAssignedDate,
+		})
+	}
+
+	if err := d.Set("total_count", len(list.Results)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("devices", devices); err != nil {
+		return diag.FromErr(err)
+	}
+
+	key := fmt.Sprintf("%s|%v|%s|%s|%t|%s", instanceID, sortedKeys(f.serialNumbers), f.model, f.prestageID, f.unassigned, f.profileStatus)
+	d.SetId(fmt.Sprintf("%x", sha256.Sum256([]byte(key)))[:16])
+
+	return nil
+}
+
+// apply returns the devices matching every filter, sorted by serial number.
+func (f filter) apply(devices []device) []device {
+	out := make([]device, 0, len(devices))
+	for _, dev := range devices {
+		if len(f.serialNumbers) > 0 && !f.serialNumbers[strings.ToUpper(dev.SerialNumber)] {
+			continue
+		}
+		if f.model != "" && !strings.Contains(strings.ToLower(dev.Model), strings.ToLower(f.model)) {
+			continue
+		}
+		if f.prestageID != "" && prestageID(dev) != f.prestageID {
+			continue
+		}
+		if f.unassigned && prestageID(dev) != "" {
+			continue
+		}
+		if f.profileStatus != "" && dev.ProfileStatus != f.profileStatus {
+			continue
+		}
+		out = append(out, dev)
+	}
+
+	sort.Slice(out, func(i, j int) bool { return out[i].SerialNumber < out[j].SerialNumber })
+	return out
+}
+
+// prestageID returns the prestage the device is assigned to. Jamf Pro reports unassigned devices
+// with an empty ID or '-1'.
+func prestageID(dev device) string {
+	if dev.PrestageID == "-1" {
+		return ""
+	}
+	return dev.PrestageID
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
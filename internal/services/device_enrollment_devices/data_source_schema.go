@@ -0,0 +1,125 @@
+package device_enrollment_devices
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// DataSourceJamfProDeviceEnrollmentDevices lists the devices Apple Business Manager or Apple School
+// Manager has assigned to an Automated Device Enrollment instance.
+func DataSourceJamfProDeviceEnrollmentDevices() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(70 * time.Second),
+		},
+		Schema: map[string]*schema.Schema{
+			"device_enrollment_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the Automated Device Enrollment instance.",
+			},
+			"serial_numbers": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Only return devices with these serial numbers, ignoring case.",
+			},
+			"model": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return devices whose model contains this value, ignoring case, e.g. 'MacBook Pro'.",
+			},
+			"prestage_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Only return devices assigned to this prestage enrollment.",
+				ConflictsWith: []string{"unassigned"},
+			},
+			"unassigned": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				Description:   "Only return devices not assigned to a prestage enrollment.",
+				ConflictsWith: []string{"prestage_id"},
+			},
+			"profile_status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Only return devices with this enrollment profile status: 'EMPTY', 'ASSIGNED', 'PUSHED' or 'REMOVED'.",
+				ValidateFunc: validation.StringInSlice([]string{"EMPTY", "ASSIGNED", "PUSHED", "REMOVED"}, false),
+			},
+			"total_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of devices assigned to the instance, before filtering.",
+			},
+			"devices": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The devices matching the filters, sorted by serial number.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the device in the instance.",
+						},
+						"serial_number": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The serial number of the device.",
+						},
+						"model": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The model of the device.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The description of the device in Apple Business Manager.",
+						},
+						"color": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The color of the device.",
+						},
+						"asset_tag": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The asset tag of the device.",
+						},
+						"prestage_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the prestage enrollment the device is assigned to, or empty when unassigned.",
+						},
+						"profile_status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the enrollment profile of the device: 'EMPTY', 'ASSIGNED', 'PUSHED' or 'REMOVED'.",
+						},
+						"profile_assign_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "When the enrollment profile was assigned to the device.",
+						},
+						"profile_push_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "When the enrollment profile was pushed to the device.",
+						},
+						"device_assigned_date": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "When the device was assigned to the instance in Apple Business Manager.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
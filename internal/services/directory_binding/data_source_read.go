@@ -0,0 +1,62 @@
+package directory_binding
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceRead fetches the details of a specific Jamf Pro Directory Binding using either its ID or its Name.
+func dataSourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+
+	lookupMethod, lookupValue := "ID", d.Get("id").(string)
+	get := client.GetDirectoryBindingByID
+	if v := d.Get("name").(string); v != "" && lookupValue == "" {
+		lookupMethod, lookupValue, get = "name", v, client.GetDirectoryBindingByName
+	}
+
+	var resource *jamfpro.ResponseDirectoryBinding
+	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
+		var apiErr error
+		resource, apiErr = get(lookupValue)
+		if apiErr != nil {
+			return retry.RetryableError(apiErr)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read Jamf Pro Directory Binding with %s '%s' after retries: %v", lookupMethod, lookupValue, err))
+	}
+
+	if resource == nil {
+		d.SetId("")
+		return diag.FromErr(fmt.Errorf("the Jamf Pro Directory Binding was not found"))
+	}
+
+	d.SetId(strconv.Itoa(resource.ID))
+
+	resourceData := map[string]any{
+		"name":        resource.Name,
+		"priority":    resource.Priority,
+		"domain":      resource.Domain,
+		"username":    resource.Username,
+		"computer_ou": resource.ComputerOU,
+		"type":        resource.Type,
+	}
+
+	var diags diag.Diagnostics
+	for key, val := range resourceData {
+		if err := d.Set(key, val); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,57 @@
+package directory_binding
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProDirectoryBindings provides information about a specific Jamf Pro Directory Binding by its ID or Name.
+func DataSourceJamfProDirectoryBindings() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(30 * time.Second),
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The unique identifier of the directory binding.",
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the directory binding.",
+			},
+			"priority": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The priority of the directory binding.",
+			},
+			"domain": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The domain or server the computer is bound to.",
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The username of the account used to bind computers.",
+			},
+			"computer_ou": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The organizational unit computer objects are created in.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the directory binding.",
+			},
+		},
+	}
+}
@@ -0,0 +1,32 @@
+package directory_binding
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/redact"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// construct constructs a ResponseDirectoryBinding object from the provided schema data.
+func construct(d *schema.ResourceData) (*jamfpro.ResponseDirectoryBinding, error) {
+	resource := &jamfpro.ResponseDirectoryBinding{
+		Name:       d.Get("name").(string),
+		Priority:   d.Get("priority").(int),
+		Domain:     d.Get("domain").(string),
+		Username:   d.Get("username").(string),
+		Password:   d.Get("password").(string),
+		ComputerOU: d.Get("computer_ou").(string),
+		Type:       d.Get("type").(string),
+	}
+
+	resourceXML, err := redact.SerializeAndRedactXML(resource, []string{"Password"})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Jamf Pro Directory Binding '%s' to XML: %v", resource.Name, err)
+	}
+
+	log.Printf("[DEBUG] Constructed Jamf Pro Directory Binding XML:\n%s\n", resourceXML)
+
+	return resource, nil
+}
@@ -0,0 +1,83 @@
+package directory_binding
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceJamfProDirectoryBindings defines the schema and CRUD operations for managing Jamf Pro Directory Bindings in Terraform.
+func ResourceJamfProDirectoryBindings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: create,
+		ReadContext:   readWithCleanup,
+		UpdateContext: update,
+		DeleteContext: delete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(70 * time.Second),
+			Read:   schema.DefaultTimeout(70 * time.Second),
+			Update: schema.DefaultTimeout(70 * time.Second),
+			Delete: schema.DefaultTimeout(70 * time.Second),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the directory binding.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the directory binding.",
+			},
+			"priority": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 10),
+				Description:  "The priority of the directory binding when several bindings are applied by a policy.",
+			},
+			"domain": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The domain or server the computer is bound to.",
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The username of the account used to bind computers.",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The password of the account used to bind computers. Jamf Pro does not return the password, so changes made outside of Terraform are not detected.",
+			},
+			"computer_ou": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The organizational unit computer objects are created in, e.g. 'CN=Computers,DC=example,DC=com'.",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Active Directory",
+				ValidateFunc: validation.StringInSlice(directoryBindingTypes, false),
+				Description:  "The type of the directory binding. One of 'Active Directory', 'Open Directory', 'PowerBroker Identity Services', 'Centrify' or 'ADmitMac'.",
+			},
+		},
+	}
+}
+
+// directoryBindingTypes are the directory binding types supported by Jamf Pro.
+var directoryBindingTypes = []string{
+	"Active Directory",
+	"Open Directory",
+	"PowerBroker Identity Services",
+	"Centrify",
+	"ADmitMac",
+}
@@ -0,0 +1,33 @@
+package directory_binding
+
+import (
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// updateState updates the Terraform state with the latest Directory Binding information from the Jamf Pro API.
+// The password is not returned by Jamf Pro, so the configured value is kept in state.
+func updateState(d *schema.ResourceData, resp *jamfpro.ResponseDirectoryBinding) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	resourceData := map[string]any{
+		"id":          strconv.Itoa(resp.ID),
+		"name":        resp.Name,
+		"priority":    resp.Priority,
+		"domain":      resp.Domain,
+		"username":    resp.Username,
+		"computer_ou": resp.ComputerOU,
+		"type":        resp.Type,
+	}
+
+	for key, val := range resourceData {
+		if err := d.Set(key, val); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,56 @@
+package ebook
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceRead fetches the details of a specific Jamf Pro eBook from Jamf Pro using its ID or
+// name. Once the details are fetched, they are set in the data source's state.
+func dataSourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+
+	resourceID := d.Get("id").(string)
+	name := d.Get("name").(string)
+
+	if resourceID == "" && name == "" {
+		return diag.FromErr(fmt.Errorf("either 'id' or 'name' must be provided"))
+	}
+
+	endpoint := fmt.Sprintf("%s/id/%s", uriEbooks, resourceID)
+	lookupMethod, lookupValue := "ID", resourceID
+	if name != "" {
+		endpoint = fmt.Sprintf("%s/name/%s", uriEbooks, url.PathEscape(name))
+		lookupMethod, lookupValue = "name", name
+	}
+
+	var resource *ebook
+	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
+		var apiErr error
+		resource, apiErr = send(client, "GET", endpoint, nil)
+		if apiErr != nil {
+			return retry.RetryableError(apiErr)
+		}
+		return nil
+	})
+
+	if err != nil {
+		//nolint:err113
+		return diag.FromErr(fmt.Errorf("failed to read Jamf Pro eBook with %s '%s' after retries: %w", lookupMethod, lookupValue, err))
+	}
+
+	if resource == nil {
+		d.SetId("")
+		return diag.FromErr(fmt.Errorf("the Jamf Pro eBook was not found"))
+	}
+
+	d.SetId(strconv.Itoa(resource.ID))
+	return updateState(d, resource)
+}
@@ -0,0 +1,88 @@
+package ebook
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProEbooks provides information about a specific Jamf Pro eBook
+func DataSourceJamfProEbooks() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(30 * time.Second),
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The unique identifier of the eBook.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the eBook.",
+			},
+			"author": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The author of the eBook.",
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The version of the eBook.",
+			},
+			"free": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates if the eBook is free.",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The Apple Books store URL of the eBook.",
+			},
+			"file_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The file type of the eBook.",
+			},
+			"deployment_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The deployment type of the eBook.",
+			},
+			"deploy_as_managed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the eBook is deployed as managed.",
+			},
+			"site_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The ID of the site the eBook belongs to.",
+			},
+			"category_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The ID of the category of the eBook.",
+			},
+			"self_service": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The Self Service settings of the eBook.",
+				Elem:        selfServiceSchema(),
+			},
+			"scope": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The scope of the eBook.",
+				Elem:        scopeSchema(),
+			},
+		},
+	}
+}
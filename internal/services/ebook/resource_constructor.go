@@ -0,0 +1,189 @@
+package ebook
+
+import (
+	"encoding/xml"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/constructors"
+	sharedschemas "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/shared_schemas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ebook is the Classic API representation of an eBook. The SDK type does not return the ID of a
+// created eBook and its scope is missing iBeacons and most exclusions, so a local scope is used.
+type ebook struct {
+	XMLName     xml.Name                       `xml:"ebook"`
+	ID          int                            `xml:"id,omitempty"`
+	General     jamfpro.EbookSubsetGeneral     `xml:"general"`
+	Scope       ebookScope                     `xml:"scope"`
+	SelfService jamfpro.EbookSubsetSelfService `xml:"self_service"`
+}
+
+type ebookScope struct {
+	AllComputers       bool             `xml:"all_computers"`
+	AllMobileDevices   bool             `xml:"all_mobile_devices"`
+	AllJSSUsers        bool             `xml:"all_jss_users"`
+	Computers          []scopeEntity    `xml:"computers>computer"`
+	ComputerGroups     []scopeEntity    `xml:"computer_groups>computer_group"`
+	MobileDevices      []scopeEntity    `xml:"mobile_devices>mobile_device"`
+	MobileDeviceGroups []scopeEntity    `xml:"mobile_device_groups>mobile_device_group"`
+	Buildings          []scopeEntity    `xml:"buildings>building"`
+	Departments        []scopeEntity    `xml:"departments>department"`
+	JSSUsers           []scopeEntity    `xml:"jss_users>user"`
+	JSSUserGroups      []scopeEntity    `xml:"jss_user_groups>user_group"`
+	Classes            []scopeEntity    `xml:"classes>class"`
+	Limitations        ebookLimitations `xml:"limitations"`
+	Exclusions         ebookExclusions  `xml:"exclusions"`
+}
+
+type ebookLimitations struct {
+	NetworkSegments []scopeEntity `xml:"network_segments>network_segment"`
+	Users           []scopeEntity `xml:"users>user"`
+	UserGroups      []scopeEntity `xml:"user_groups>user_group"`
+	IBeacons        []scopeEntity `xml:"ibeacons>ibeacon"`
+}
+
+type ebookExclusions struct {
+	Computers          []scopeEntity `xml:"computers>computer"`
+	ComputerGroups     []scopeEntity `xml:"computer_groups>computer_group"`
+	MobileDevices      []scopeEntity `xml:"mobile_devices>mobile_device"`
+	MobileDeviceGroups []scopeEntity `xml:"mobile_device_groups>mobile_device_group"`
+	Buildings          []scopeEntity `xml:"buildings>building"`
+	Departments        []scopeEntity `xml:"departments>department"`
+	JSSUsers           []scopeEntity `xml:"jss_users>user"`
+	JSSUserGroups      []scopeEntity `xml:"jss_user_groups>user_group"`
+	NetworkSegments    []scopeEntity `xml:"network_segments>network_segment"`
+	Users              []scopeEntity `xml:"users>user"`
+	UserGroups         []scopeEntity `xml:"user_groups>user_group"`
+	IBeacons           []scopeEntity `xml:"ibeacons>ibeacon"`
+}
+
+type scopeEntity struct {
+	ID   int    `xml:"id,omitempty"`
+	Name string `xml:"name,omitempty"`
+}
+
+// construct builds an eBook object from the provided schema data.
+func construct(d *schema.ResourceData) (*ebook, error) {
+	resource := &ebook{
+		General: jamfpro.EbookSubsetGeneral{
+			Name:            d.Get("name").(string),
+			Author:          d.Get("author").(string),
+			Version:         d.Get("version").(string),
+			Free:            d.Get("free").(bool),
+			URL:             d.Get("url").(string),
+			DeploymentType:  d.Get("deployment_type").(string),
+			DeployAsManaged: d.Get("deploy_as_managed").(bool),
+			Category:        sharedschemas.ConstructSharedResourceCategory(d.Get("category_id").(int)),
+			Site:            *sharedschemas.ConstructSharedResourceSite(d.Get("site_id").(int)),
+		},
+	}
+
+	constructSelfService(d, resource)
+
+	if err := constructScope(d, resource); err != nil {
+		return nil, fmt.Errorf("failed to construct scope: %v", err)
+	}
+
+	resourceXML, err := xml.MarshalIndent(resource, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Jamf Pro eBook '%s' to XML: %v", resource.General.Name, err)
+	}
+
+	log.Printf("[DEBUG] Constructed Jamf Pro eBook XML:\n%s\n", string(resourceXML))
+
+	return resource, nil
+}
+
+// constructSelfService pulls the self service settings from HCL and packages them into the eBook.
+func constructSelfService(d *schema.ResourceData, resource *ebook) {
+	v, ok := d.GetOk("self_service")
+	if !ok || len(v.([]any)) == 0 || v.([]any)[0] == nil {
+		return
+	}
+	selfServiceMap := v.([]any)[0].(map[string]any)
+
+	selfService := jamfpro.EbookSubsetSelfService{
+		SelfServiceDisplayName:      selfServiceMap["self_service_display_name"].(string),
+		InstallButtonText:           selfServiceMap["install_button_text"].(string),
+		SelfServiceDescription:      selfServiceMap["self_service_description"].(string),
+		ForceUsersToViewDescription: selfServiceMap["force_users_to_view_description"].(bool),
+		FeatureOnMainPage:           selfServiceMap["feature_on_main_page"].(bool),
+		Notification:                selfServiceMap["notification"].(bool),
+		NotificationSubject:         selfServiceMap["notification_subject"].(string),
+		NotificationMessage:         selfServiceMap["notification_message"].(string),
+	}
+
+	if iconID := selfServiceMap["self_service_icon_id"].(int); iconID != 0 {
+		selfService.SelfServiceIcon = jamfpro.SharedResourceSelfServiceIcon{ID: iconID}
+	}
+
+	if categories, ok := selfServiceMap["self_service_category_ids"].(*schema.Set); ok {
+		for _, id := range categories.List() {
+			selfService.SelfServiceCategories.Category = append(selfService.SelfServiceCategories.Category, struct {
+				ID   int    `xml:"id"`
+				Name string `xml:"name"`
+			}{ID: id.(int)})
+		}
+	}
+
+	resource.SelfService = selfService
+}
+
+// constructScope pulls the scope from HCL and packages it into the eBook.
+func constructScope(d *schema.ResourceData, resource *ebook) error {
+	scope := &resource.Scope
+	scope.AllComputers = d.Get("scope.0.all_computers").(bool)
+	scope.AllMobileDevices = d.Get("scope.0.all_mobile_devices").(bool)
+	scope.AllJSSUsers = d.Get("scope.0.all_jss_users").(bool)
+
+	ids := []struct {
+		path string
+		out  *[]scopeEntity
+	}{
+		// Targets
+		{"scope.0.computer_ids", &scope.Computers},
+		{"scope.0.computer_group_ids", &scope.ComputerGroups},
+		{"scope.0.mobile_device_ids", &scope.MobileDevices},
+		{"scope.0.mobile_device_group_ids", &scope.MobileDeviceGroups},
+		{"scope.0.building_ids", &scope.Buildings},
+		{"scope.0.department_ids", &scope.Departments},
+		{"scope.0.jss_user_ids", &scope.JSSUsers},
+		{"scope.0.jss_user_group_ids", &scope.JSSUserGroups},
+		{"scope.0.class_ids", &scope.Classes},
+
+		// Limitations
+		{"scope.0.limitations.0.network_segment_ids", &scope.Limitations.NetworkSegments},
+		{"scope.0.limitations.0.directory_service_usergroup_ids", &scope.Limitations.UserGroups},
+		{"scope.0.limitations.0.ibeacon_ids", &scope.Limitations.IBeacons},
+
+		// Exclusions
+		{"scope.0.exclusions.0.computer_ids", &scope.Exclusions.Computers},
+		{"scope.0.exclusions.0.computer_group_ids", &scope.Exclusions.ComputerGroups},
+		{"scope.0.exclusions.0.mobile_device_ids", &scope.Exclusions.MobileDevices},
+		{"scope.0.exclusions.0.mobile_device_group_ids", &scope.Exclusions.MobileDeviceGroups},
+		{"scope.0.exclusions.0.building_ids", &scope.Exclusions.Buildings},
+		{"scope.0.exclusions.0.department_ids", &scope.Exclusions.Departments},
+		{"scope.0.exclusions.0.jss_user_ids", &scope.Exclusions.JSSUsers},
+		{"scope.0.exclusions.0.jss_user_group_ids", &scope.Exclusions.JSSUserGroups},
+		{"scope.0.exclusions.0.network_segment_ids", &scope.Exclusions.NetworkSegments},
+		{"scope.0.exclusions.0.directory_service_usergroup_ids", &scope.Exclusions.UserGroups},
+		{"scope.0.exclusions.0.ibeacon_ids", &scope.Exclusions.IBeacons},
+	}
+	for _, v := range ids {
+		if err := constructors.MapSetToStructs[scopeEntity, int](v.path, "ID", d, v.out); err != nil {
+			return err
+		}
+	}
+
+	if err := constructors.MapSetToStructs[scopeEntity, string]("scope.0.limitations.0.directory_service_or_local_usernames", "Name", d, &scope.Limitations.Users); err != nil {
+		return err
+	}
+	if err := constructors.MapSetToStructs[scopeEntity, string]("scope.0.exclusions.0.directory_service_or_local_usernames", "Name", d, &scope.Exclusions.Users); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package ebook
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	crud "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/sdkv2_crud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const uriEbooks = "/JSSResource/ebooks"
+
+// create is responsible for creating a new Jamf Pro eBook in the remote system.
+func create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+	return crud.Create(
+		ctx,
+		d,
+		meta,
+		construct,
+		func(resource *ebook) (*ebook, error) {
+			return send(client, "POST", uriEbooks+"/id/0", resource)
+		},
+		readNoCleanup,
+	)
+}
+
+// read is responsible for reading the current state of a Jamf Pro eBook from the remote system.
+func read(ctx context.Context, d *schema.ResourceData, meta any, cleanup bool) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+	return crud.Read(
+		ctx,
+		d,
+		meta,
+		cleanup,
+		func(id string) (*ebook, error) {
+			return send(client, "GET", fmt.Sprintf("%s/id/%s", uriEbooks, id), nil)
+		},
+		updateState,
+	)
+}
+
+// readWithCleanup reads the resource with cleanup enabled
+func readWithCleanup(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return read(ctx, d, meta, true)
+}
+
+// readNoCleanup reads the resource with cleanup disabled
+func readNoCleanup(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return read(ctx, d, meta, false)
+}
+
+// update is responsible for updating an existing Jamf Pro eBook on the remote system.
+func update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+	return crud.Update(
+		ctx,
+		d,
+		meta,
+		construct,
+		func(id string, resource *ebook) (*ebook, error) {
+			return send(client, "PUT", fmt.Sprintf("%s/id/%s", uriEbooks, id), resource)
+		},
+		readNoCleanup,
+	)
+}
+
+// delete is responsible for deleting a Jamf Pro eBook.
+func delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return crud.Delete(
+		ctx,
+		d,
+		meta,
+		meta.(*jamfpro.Client).DeleteEbookByID,
+	)
+}
+
+// send makes a Classic API eBook request. A create or update returns only the ID of the eBook.
+func send(client *jamfpro.Client, method, endpoint string, resource *ebook) (*ebook, error) {
+	var payload any
+	if resource != nil {
+		payload = resource
+	}
+
+	var out ebook
+	resp, err := client.HTTP.DoRequest(method, endpoint, payload, &out)
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to %s eBook at %s: %v", method, endpoint, err)
+	}
+
+	if out.ID == 0 {
+		out.ID = out.General.ID
+	}
+
+	return &out, nil
+}
@@ -0,0 +1,196 @@
+package ebook
+
+import (
+	"slices"
+	"time"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/device_identifiers"
+	sharedschemas "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/shared_schemas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceJamfProEbook defines the schema and CRUD operations for managing Jamf Pro eBooks in Terraform
+func ResourceJamfProEbook() *schema.Resource {
+	return device_identifiers.WrapResource(&schema.Resource{
+		CreateContext: create,
+		ReadContext:   readWithCleanup,
+		UpdateContext: update,
+		DeleteContext: delete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(70 * time.Second),
+			Read:   schema.DefaultTimeout(70 * time.Second),
+			Update: schema.DefaultTimeout(70 * time.Second),
+			Delete: schema.DefaultTimeout(70 * time.Second),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the eBook.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the eBook.",
+			},
+			"author": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The author of the eBook.",
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The version of the eBook.",
+			},
+			"free": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Indicates if the eBook is free.",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The Apple Books store URL of the eBook.",
+			},
+			"file_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The file type of the eBook, e.g. PDF or ePub.",
+			},
+			"deployment_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "Install Automatically/Prompt Users to Install",
+				Description: "The deployment type of the eBook.",
+				ValidateFunc: validation.StringInSlice([]string{
+					"Install Automatically/Prompt Users to Install",
+					"Make Available in Self Service",
+				}, false),
+			},
+			"deploy_as_managed": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Deploy the eBook as managed on mobile devices.",
+			},
+			"site_id":     sharedschemas.GetSharedSchemaSite(),
+			"category_id": sharedschemas.GetSharedSchemaCategory(),
+			"self_service": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem:     selfServiceSchema(),
+			},
+			"scope": {
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Description: "The scope of the eBook.",
+				Required:    true,
+				Elem:        scopeSchema(),
+			},
+		},
+	}, slices.Concat(device_identifiers.ComputerScopeFields, device_identifiers.MobileDeviceScopeFields)...)
+}
+
+// selfServiceSchema defines the Self Service settings of an eBook.
+func selfServiceSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"self_service_display_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name displayed for the eBook in Self Service.",
+			},
+			"install_button_text": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "Install",
+				Description: "The text displayed on the install button in Self Service.",
+			},
+			"self_service_description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The Self Service description.",
+			},
+			"force_users_to_view_description": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Force users to view the description before installing.",
+			},
+			"feature_on_main_page": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Feature this eBook on the main page.",
+			},
+			"self_service_icon_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The ID of the Self Service icon.",
+			},
+			"self_service_category_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The Self Service categories the eBook is displayed in.",
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"notification": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Enable notifications for this eBook.",
+			},
+			"notification_subject": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The subject of the notification.",
+			},
+			"notification_message": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The message of the notification.",
+			},
+		},
+	}
+}
+
+// scopeSchema combines the shared computer and mobile device scopes, as an eBook can be scoped to
+// both, and adds Apple School Manager classes as targets.
+func scopeSchema() *schema.Resource {
+	scope := sharedschemas.GetSharedMobileDeviceSchemaScope()
+	computer := sharedschemas.GetSharedmacOSComputerSchemaScope()
+
+	mergeSchema(scope, computer)
+	mergeSchema(scope.Schema["exclusions"].Elem.(*schema.Resource), computer.Schema["exclusions"].Elem.(*schema.Resource))
+
+	allComputers := scope.Schema["all_computers"]
+	allComputers.Required = false
+	allComputers.Optional = true
+	allComputers.Default = false
+	allComputers.Description = "If true, the eBook is scoped to all computers."
+
+	scope.Schema["class_ids"] = &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Description: "A list of class IDs the eBook is scoped to.",
+		Elem:        &schema.Schema{Type: schema.TypeInt},
+	}
+
+	return scope
+}
+
+// mergeSchema adds the attributes of src missing from dst.
+func mergeSchema(dst, src *schema.Resource) {
+	for key, value := range src.Schema {
+		if _, ok := dst.Schema[key]; !ok {
+			dst.Schema[key] = value
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package ebook
+
+import (
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// updateState updates the Terraform state with the latest eBook information from the Jamf Pro API.
+func updateState(d *schema.ResourceData, resp *ebook) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ebookData := map[string]any{
+		"name":              resp.General.Name,
+		"author":            resp.General.Author,
+		"version":           resp.General.Version,
+		"free":              resp.General.Free,
+		"url":               resp.General.URL,
+		"file_type":         resp.General.FileType,
+		"deployment_type":   resp.General.DeploymentType,
+		"deploy_as_managed": resp.General.DeployAsManaged,
+		"site_id":           resp.General.Site.ID,
+		"scope":             []any{stateScope(resp.Scope)},
+	}
+
+	if resp.General.Category != nil {
+		ebookData["category_id"] = resp.General.Category.ID
+	}
+
+	// Self service settings are returned for every eBook, so they are only stated when configured or
+	// when the eBook is made available in Self Service.
+	if len(d.Get("self_service").([]any)) > 0 || resp.General.DeploymentType == "Make Available in Self Service" {
+		ss := resp.SelfService
+
+		categoryIDs := make([]int, 0, len(ss.SelfServiceCategories.Category))
+		for _, category := range ss.SelfServiceCategories.Category {
+			categoryIDs = append(categoryIDs, category.ID)
+		}
+		sort.Ints(categoryIDs)
+
+		ebookData["self_service"] = []any{map[string]any{
+			"self_service_display_name":       ss.SelfServiceDisplayName,
+			"install_button_text":             ss.InstallButtonText,
+			"self_service_description":        ss.SelfServiceDescription,
+			"force_users_to_view_description": ss.ForceUsersToViewDescription,
+			"feature_on_main_page":            ss.FeatureOnMainPage,
+			"self_service_icon_id":            ss.SelfServiceIcon.ID,
+			"self_service_category_ids":       categoryIDs,
+			"notification":                    ss.Notification,
+			"notification_subject":            ss.NotificationSubject,
+			"notification_message":            ss.NotificationMessage,
+		}}
+	}
+
+	for key, val := range ebookData {
+		if err := d.Set(key, val); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return diags
+}
+
+// stateScope converts the eBook scope into the combined computer and mobile device scope schema.
+func stateScope(scope ebookScope) map[string]any {
+	out := map[string]any{
+		"all_computers":           scope.AllComputers,
+		"all_mobile_devices":      scope.AllMobileDevices,
+		"all_jss_users":           scope.AllJSSUsers,
+		"computer_ids":            ids(scope.Computers),
+		"computer_group_ids":      ids(scope.ComputerGroups),
+		"mobile_device_ids":       ids(scope.MobileDevices),
+		"mobile_device_group_ids": ids(scope.MobileDeviceGroups),
+		"building_ids":            ids(scope.Buildings),
+		"department_ids":          ids(scope.Departments),
+		"jss_user_ids":            ids(scope.JSSUsers),
+		"jss_user_group_ids":      ids(scope.JSSUserGroups),
+		"class_ids":               ids(scope.Classes),
+	}
+
+	l := scope.Limitations
+	limitations := map[string]any{
+		"network_segment_ids":                  ids(l.NetworkSegments),
+		"directory_service_or_local_usernames": names(l.Users),
+		"directory_service_usergroup_ids":      ids(l.UserGroups),
+		"ibeacon_ids":                          ids(l.IBeacons),
+	}
+	if !emptyBlock(limitations) {
+		out["limitations"] = []any{limitations}
+	}
+
+	e := scope.Exclusions
+	exclusions := map[string]any{
+		"computer_ids":                         ids(e.Computers),
+		"computer_group_ids":                   ids(e.ComputerGroups),
+		"mobile_device_ids":                    ids(e.MobileDevices),
+		"mobile_device_group_ids":              ids(e.MobileDeviceGroups),
+		"building_ids":                         ids(e.Buildings),
+		"department_ids":                       ids(e.Departments),
+		"jss_user_ids":                         ids(e.JSSUsers),
+		"jss_user_group_ids":                   ids(e.JSSUserGroups),
+		"network_segment_ids":                  ids(e.NetworkSegments),
+		"directory_service_or_local_usernames": names(e.Users),
+		"directory_service_usergroup_ids":      ids(e.UserGroups),
+		"ibeacon_ids":                          ids(e.IBeacons),
+	}
+	if !emptyBlock(exclusions) {
+		out["exclusions"] = []any{exclusions}
+	}
+
+	return out
+}
+
+// ids returns the sorted, non-zero IDs of entities.
+func ids(entities []scopeEntity) []int {
+	var out []int
+	for _, entity := range entities {
+		if entity.ID != 0 {
+			out = append(out, entity.ID)
+		}
+	}
+	sort.Ints(out)
+	return out
+}
+
+// names returns the sorted, non-empty names of entities.
+func names(entities []scopeEntity) []string {
+	var out []string
+	for _, entity := range entities {
+		if entity.Name != "" {
+			out = append(out, entity.Name)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+func emptyBlock(block map[string]any) bool {
+	for _, v := range block {
+		switch list := v.(type) {
+		case []int:
+			if len(list) > 0 {
+				return false
+			}
+		case []string:
+			if len(list) > 0 {
+				return false
+			}
+		}
+	}
+	return true
+}
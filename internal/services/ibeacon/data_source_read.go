@@ -0,0 +1,60 @@
+package ibeacon
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceRead fetches the details of a specific Jamf Pro iBeacon region from Jamf Pro using its
+// ID or name. Once the details are fetched, they are set in the data source's state.
+func dataSourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+
+	resourceID := d.Get("id").(string)
+	name := d.Get("name").(string)
+
+	if resourceID == "" && name == "" {
+		return diag.FromErr(fmt.Errorf("either 'id' or 'name' must be provided"))
+	}
+
+	var resource *jamfpro.ResourceIBeacons
+	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
+		var apiErr error
+
+		if name != "" {
+			resource, apiErr = client.GetIBeaconByName(name)
+		} else {
+			resource, apiErr = client.GetIBeaconByID(resourceID)
+		}
+
+		if apiErr != nil {
+			return retry.RetryableError(apiErr)
+		}
+		return nil
+	})
+
+	if err != nil {
+		lookupMethod := "ID"
+		lookupValue := resourceID
+		if name != "" {
+			lookupMethod = "name"
+			lookupValue = name
+		}
+		//nolint:err113
+		return diag.FromErr(fmt.Errorf("failed to read Jamf Pro iBeacon with %s '%s' after retries: %w", lookupMethod, lookupValue, err))
+	}
+
+	if resource == nil {
+		d.SetId("")
+		return diag.FromErr(fmt.Errorf("the Jamf Pro iBeacon was not found"))
+	}
+
+	d.SetId(strconv.Itoa(resource.ID))
+	return updateState(d, resource)
+}
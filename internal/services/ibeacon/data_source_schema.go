@@ -0,0 +1,46 @@
+package ibeacon
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProIBeacons provides information about a specific Jamf Pro iBeacon region
+func DataSourceJamfProIBeacons() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(30 * time.Second),
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The unique identifier of the iBeacon region.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the iBeacon region.",
+			},
+			"uuid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The proximity UUID of the iBeacon region.",
+			},
+			"major": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The major value of the iBeacon region. -1 matches any major value.",
+			},
+			"minor": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The minor value of the iBeacon region. -1 matches any minor value.",
+			},
+		},
+	}
+}
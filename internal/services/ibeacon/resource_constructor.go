@@ -0,0 +1,38 @@
+package ibeacon
+
+import (
+	"encoding/xml"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ibeacon is the Classic API request body of an iBeacon region. The SDK type omits a major or
+// minor value of 0, which Jamf Pro then stores as -1.
+type ibeacon struct {
+	XMLName xml.Name `xml:"ibeacon"`
+	Name    string   `xml:"name"`
+	UUID    string   `xml:"uuid"`
+	Major   int      `xml:"major"`
+	Minor   int      `xml:"minor"`
+}
+
+// construct builds an iBeacon region object from the provided schema data.
+func construct(d *schema.ResourceData) (*ibeacon, error) {
+	resource := &ibeacon{
+		Name:  d.Get("name").(string),
+		UUID:  d.Get("uuid").(string),
+		Major: d.Get("major").(int),
+		Minor: d.Get("minor").(int),
+	}
+
+	resourceXML, err := xml.MarshalIndent(resource, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Jamf Pro iBeacon '%s' to XML: %v", resource.Name, err)
+	}
+
+	log.Printf("[DEBUG] Constructed Jamf Pro iBeacon XML:\n%s\n", string(resourceXML))
+
+	return resource, nil
+}
@@ -0,0 +1,89 @@
+package ibeacon
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	crud "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/sdkv2_crud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const uriIBeacons = "/JSSResource/ibeacons"
+
+// create is responsible for creating a new Jamf Pro iBeacon region in the remote system.
+func create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+	return crud.Create(
+		ctx,
+		d,
+		meta,
+		construct,
+		func(resource *ibeacon) (*jamfpro.ResourceIBeacons, error) {
+			return send(client, "POST", uriIBeacons+"/id/0", resource)
+		},
+		readNoCleanup,
+	)
+}
+
+// read is responsible for reading the current state of a Jamf Pro iBeacon region from the remote system.
+func read(ctx context.Context, d *schema.ResourceData, meta any, cleanup bool) diag.Diagnostics {
+	return crud.Read(
+		ctx,
+		d,
+		meta,
+		cleanup,
+		meta.(*jamfpro.Client).GetIBeaconByID,
+		updateState,
+	)
+}
+
+// readWithCleanup reads the resource with cleanup enabled
+func readWithCleanup(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return read(ctx, d, meta, true)
+}
+
+// readNoCleanup reads the resource with cleanup disabled
+func readNoCleanup(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return read(ctx, d, meta, false)
+}
+
+// update is responsible for updating an existing Jamf Pro iBeacon region on the remote system.
+func update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+	return crud.Update(
+		ctx,
+		d,
+		meta,
+		construct,
+		func(id string, resource *ibeacon) (*jamfpro.ResourceIBeacons, error) {
+			return send(client, "PUT", fmt.Sprintf("%s/id/%s", uriIBeacons, id), resource)
+		},
+		readNoCleanup,
+	)
+}
+
+// delete is responsible for deleting a Jamf Pro iBeacon region.
+func delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return crud.Delete(
+		ctx,
+		d,
+		meta,
+		meta.(*jamfpro.Client).DeleteIBeaconByID,
+	)
+}
+
+// send writes an iBeacon region with the local request body.
+func send(client *jamfpro.Client, method, endpoint string, resource *ibeacon) (*jamfpro.ResourceIBeacons, error) {
+	var out jamfpro.ResourceIBeacons
+	resp, err := client.HTTP.DoRequest(method, endpoint, resource, &out)
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to %s iBeacon at %s: %v", method, endpoint, err)
+	}
+
+	return &out, nil
+}
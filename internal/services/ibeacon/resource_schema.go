@@ -0,0 +1,59 @@
+package ibeacon
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceJamfProIBeacon defines the schema and CRUD operations for managing Jamf Pro iBeacon regions in Terraform
+func ResourceJamfProIBeacon() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: create,
+		ReadContext:   readWithCleanup,
+		UpdateContext: update,
+		DeleteContext: delete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(70 * time.Second),
+			Read:   schema.DefaultTimeout(70 * time.Second),
+			Update: schema.DefaultTimeout(70 * time.Second),
+			Delete: schema.DefaultTimeout(70 * time.Second),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the iBeacon region.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the iBeacon region.",
+			},
+			"uuid": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The proximity UUID of the iBeacon region.",
+				ValidateFunc: validation.IsUUID,
+			},
+			"major": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      -1,
+				Description:  "The major value of the iBeacon region. -1 matches any major value.",
+				ValidateFunc: validation.IntBetween(-1, 65535),
+			},
+			"minor": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      -1,
+				Description:  "The minor value of the iBeacon region. -1 matches any minor value.",
+				ValidateFunc: validation.IntBetween(-1, 65535),
+			},
+		},
+	}
+}
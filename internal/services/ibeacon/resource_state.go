@@ -0,0 +1,27 @@
+package ibeacon
+
+import (
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// updateState updates the Terraform state with the latest iBeacon region information from the Jamf Pro API.
+func updateState(d *schema.ResourceData, resp *jamfpro.ResourceIBeacons) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ibeaconData := map[string]any{
+		"name":  resp.Name,
+		"uuid":  resp.UUID,
+		"major": resp.Major,
+		"minor": resp.Minor,
+	}
+
+	for key, val := range ibeaconData {
+		if err := d.Set(key, val); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return diags
+}
@@ -3,6 +3,7 @@ package mac_application
 import (
 	"time"
 
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/device_identifiers"
 	sharedschemas "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/shared_schemas"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -10,7 +11,7 @@ import (
 
 // ResourceJamfProMacApplication defines the schema and CRUD operations for managing Jamf Pro Mac Applications in Terraform
 func ResourceJamfProMacApplication() *schema.Resource {
-	return &schema.Resource{
+	return device_identifiers.WrapResource(&schema.Resource{
 		CreateContext: create,
 		ReadContext:   readWithCleanup,
 		UpdateContext: update,
@@ -197,5 +198,5 @@ func ResourceJamfProMacApplication() *schema.Resource {
 				Elem:        sharedschemas.GetSharedmacOSComputerSchemaScope(),
 			},
 		},
-	}
+	}, device_identifiers.ComputerScopeFields...)
 }
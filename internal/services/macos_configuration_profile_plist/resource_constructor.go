@@ -35,9 +35,10 @@ import (
 //   - Re-encodes updated plist
 //
 // Parameters:
-// - d: Schema ResourceData containing configuration
-// - mode: "create" or "update" to control UUID handling
-// - meta: Provider meta containing client for API calls
+//   - d: Schema ResourceData containing configuration
+//   - mode: "create" or "update" to control UUID handling (with 'payload_uuid_mode' set to 'deterministic', nested payload UUIDs
+//     are derived from the instance FQDN and 'payload_uuid_key' instead of reused from Jamf Pro)
+//   - meta: Provider meta containing client for API calls
 //
 // Returns:
 // - Constructed ResourceMacOSConfigurationProfile
@@ -80,16 +81,34 @@ func constructJamfProMacOSConfigurationProfilePlist(d *schema.ResourceData, mode
 		resource.SelfService = constructMacOSConfigurationProfileSubsetSelfService(selfServiceData)
 	}
 
-	if mode != "update" {
+	client, _ := meta.(*jamfpro.Client)
+	fqdn, uuidKey, deterministic, err := sharedschemas.GetDeterministicPayloadUUIDSource(d, client)
+	if err != nil {
+		return nil, err
+	}
+
+	if mode != "update" && !deterministic {
 		resource.General.Payloads = html.EscapeString(d.Get("payloads").(string))
+	} else if mode != "update" {
+		var newPlist map[string]any
+		if err := plist.NewDecoder(strings.NewReader(d.Get("payloads").(string))).Decode(&newPlist); err != nil {
+			return nil, fmt.Errorf("failed to decode plist payload for deterministic PayloadUUID generation: %v", err)
+		}
+
+		helpers.ApplyDeterministicPayloadUUIDs(newPlist, fqdn, uuidKey, true)
+
+		encoder := plist.NewEncoder(&buf)
+		encoder.Indent("    ")
+		if err := encoder.Encode(newPlist); err != nil {
+			return nil, fmt.Errorf("failed to encode plist payload with deterministic PayloadUUID and PayloadIdentifier: %v", err)
+		}
+		resource.General.Payloads = preMarshallingXMLPayloadEscaping(preMarshallingXMLPayloadUnescaping(buf.String()))
 
 	} else if mode == "update" {
 		var existingPlist map[string]any
 		var newPlist map[string]any
 
-		client := meta.(*jamfpro.Client)
 		resourceID := d.Id()
-		var err error
 		existingProfile, err = client.GetMacOSConfigurationProfileByID(resourceID)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get existing configuration profile by ID for update operation: %v", err)
@@ -113,18 +132,23 @@ func constructJamfProMacOSConfigurationProfilePlist(d *schema.ResourceData, mode
 		newPlist["PayloadUUID"] = existingPlist["PayloadUUID"]
 		newPlist["PayloadIdentifier"] = existingPlist["PayloadIdentifier"]
 
-		// Ensure nested UUIDs and PayloadIdentifiers are also matched properly
-		uuidMap := make(map[string]string)
-		identifierMap := make(map[string]string)
-		helpers.ExtractUUIDs(existingPlist, uuidMap, true)
-		helpers.ExtractPayloadIdentifiers(existingPlist, identifierMap, true)
-		helpers.UpdateUUIDs(newPlist, uuidMap, identifierMap, true)
-
-		var mismatches []string
-		helpers.ValidatePayloadUUIDsMatch(existingPlist, newPlist, "Payload", &mismatches)
-
-		if len(mismatches) > 0 {
-			return nil, fmt.Errorf("configuration profile UUID mismatch found:\n%s", strings.Join(mismatches, "\n"))
+		if deterministic {
+			// Nested payloads take their deterministic UUIDs rather than those stored in Jamf Pro.
+			helpers.ApplyDeterministicPayloadUUIDs(newPlist, fqdn, uuidKey, false)
+		} else {
+			// Ensure nested UUIDs and PayloadIdentifiers are also matched properly
+			uuidMap := make(map[string]string)
+			identifierMap := make(map[string]string)
+			helpers.ExtractUUIDs(existingPlist, uuidMap, true)
+			helpers.ExtractPayloadIdentifiers(existingPlist, identifierMap, true)
+			helpers.UpdateUUIDs(newPlist, uuidMap, identifierMap, true)
+
+			var mismatches []string
+			helpers.ValidatePayloadUUIDsMatch(existingPlist, newPlist, "Payload", &mismatches)
+
+			if len(mismatches) > 0 {
+				return nil, fmt.Errorf("configuration profile UUID mismatch found:\n%s", strings.Join(mismatches, "\n"))
+			}
 		}
 
 		// Encode the plist with injections
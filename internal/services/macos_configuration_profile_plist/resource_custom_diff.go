@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/plist"
+	sharedschemas "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/shared_schemas"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -24,6 +25,10 @@ func mainCustomDiffFunc(ctx context.Context, diff *schema.ResourceDiff, i any) e
 		}
 	}
 
+	if err := sharedschemas.ValidatePayloadUUIDKey(diff, "jamfpro_macos_configuration_profile_plist"); err != nil {
+		return err
+	}
+
 	if err := validateDistributionMethod(ctx, diff, i); err != nil {
 		return err
 	}
@@ -153,7 +158,7 @@ func validateAllComputersScope(_ context.Context, diff *schema.ResourceDiff, _ a
 	allComputers := scope["all_computers"].(bool)
 
 	if allComputers {
-		fieldsToCheck := []string{"computer_ids", "computer_group_ids"}
+		fieldsToCheck := []string{"computer_ids", "computer_serial_numbers", "computer_udids", "computer_group_ids"}
 		for _, field := range fieldsToCheck {
 			if value, exists := scope[field]; exists {
 				if setVal, ok := value.(*schema.Set); ok && setVal.Len() > 0 {
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/device_identifiers"
 	sharedschemas "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/shared_schemas"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -11,7 +12,7 @@ import (
 
 // ResourceJamfProMacOSConfigurationProfilesPlist defines the schema and CRUD operations for managing Jamf Pro macOS Configuration Profiles in Terraform.
 func ResourceJamfProMacOSConfigurationProfilesPlist() *schema.Resource {
-	return &schema.Resource{
+	return device_identifiers.WrapResource(&schema.Resource{
 		CreateContext: create,
 		ReadContext:   readWithCleanup,
 		UpdateContext: update,
@@ -139,6 +140,8 @@ func ResourceJamfProMacOSConfigurationProfilesPlist() *schema.Resource {
 					"structure is incompatible with Jamf Pro, or triggers jamf pro plist processing " +
 					"not handled by 'payloads' diff suppression. Switch off at your own risk.",
 			},
+			"payload_uuid_mode": sharedschemas.GetSharedSchemaPayloadUUIDMode(),
+			"payload_uuid_key":  sharedschemas.GetSharedSchemaPayloadUUIDKey(),
 			"redeploy_on_update": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -254,5 +257,5 @@ func ResourceJamfProMacOSConfigurationProfilesPlist() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, device_identifiers.ComputerScopeFields...)
 }
@@ -6,23 +6,18 @@ import (
 	"fmt"
 
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/plist"
+	sharedschemas "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/shared_schemas"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // mainCustomDiffFunc orchestrates all custom diff validations for macOS config profiles.
 func mainCustomDiffFunc(ctx context.Context, diff *schema.ResourceDiff, i any) error {
-	if diff.Get("payload_validate").(bool) {
-		if err := validatePayloadIdentifers(ctx, diff, i); err != nil {
-			return err
-		}
-
-		if err := normalizePayloadState(ctx, diff, i); err != nil {
-			return err
-		}
+	if err := sharedschemas.ValidatePayloadUUIDKey(diff, "jamfpro_macos_configuration_profile_plist_generator"); err != nil {
+		return err
+	}
 
-		if err := validatePlistPayloadScope(ctx, diff, i); err != nil {
-			return err
-		}
+	if err := validateSettingValues(ctx, diff, i); err != nil {
+		return err
 	}
 
 	if err := validateDistributionMethod(ctx, diff, i); err != nil {
@@ -44,28 +39,27 @@ func mainCustomDiffFunc(ctx context.Context, diff *schema.ResourceDiff, i any) e
 	return nil
 }
 
-func normalizePayloadState(_ context.Context, diff *schema.ResourceDiff, _ any) error {
-	diff.SetNew("payloads", plist.NormalizePayloadState(diff.Get("payloads").(string)))
-	return nil
-}
-
-// validatePayloadIdentifers performs the payload validation that was previously in the ValidateFunc.
-func validatePayloadIdentifers(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+// validateSettingValues checks that every setting and dictionary entry resolves to a valid typed plist value.
+func validateSettingValues(_ context.Context, diff *schema.ResourceDiff, _ any) error {
 	resourceName := diff.Get("name").(string)
-	payload := diff.Get("payloads").(string)
-
-	profile, err := plist.UnmarshalPayload(payload)
-	if err != nil {
-		return fmt.Errorf("in 'jamfpro_macos_configuration_profile_plist.%s': error unmarshalling payload: %v", resourceName, err)
+	if raw := diff.GetRawConfig(); !raw.IsNull() && !raw.GetAttr("payloads").IsWhollyKnown() {
+		return nil
 	}
 
-	if profile.PayloadIdentifier != profile.PayloadUUID {
-		return fmt.Errorf("in 'jamfpro_macos_configuration_profile_plist.%s': root-level PayloadIdentifier and PayloadUUID within the plist do not match. Expected PayloadIdentifier to be '%s', but got '%s'", resourceName, profile.PayloadUUID, profile.PayloadIdentifier)
+	payloadContents, ok := diff.Get("payloads.0.payload_content").([]any)
+	if !ok {
+		return nil
 	}
 
-	errs := plist.ValidatePayloadFields(profile)
-	if len(errs) > 0 {
-		return fmt.Errorf("in 'jamfpro_macos_configuration_profile_plist.%s': %v", resourceName, errs)
+	for i, v := range payloadContents {
+		content, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
+		settings, _ := content["setting"].([]any)
+		if _, err := plist.ConvertSettingsToDictionary(settings); err != nil {
+			return fmt.Errorf("in 'jamfpro_macos_configuration_profile_plist_generator.%s': payload_content[%d]: %v", resourceName, i, err)
+		}
 	}
 
 	return nil
@@ -93,29 +87,6 @@ func validateDistributionMethod(_ context.Context, diff *schema.ResourceDiff, _
 	return nil
 }
 
-// validatePlistPayloadScope validates that the 'PayloadScope' key in the payload matches the 'level' attribute in the HCL.
-func validatePlistPayloadScope(_ context.Context, diff *schema.ResourceDiff, _ any) error {
-	resourceName := diff.Get("name").(string)
-	level := diff.Get("level").(string)
-	payloads := diff.Get("payloads").(string)
-
-	plistData, err := plist.DecodePlist([]byte(payloads))
-	if err != nil {
-		return fmt.Errorf("in 'jamfpro_macos_configuration_profile.%s': error decoding plist data: %v", resourceName, err)
-	}
-
-	payloadScope, err := plist.GetPayloadScope(plistData)
-	if err != nil {
-		return fmt.Errorf("in 'jamfpro_macos_configuration_profile.%s': error getting 'PayloadScope' from plist: %v", resourceName, err)
-	}
-
-	if payloadScope != level {
-		return fmt.Errorf("in 'jamfpro_macos_configuration_profile.%s': the hcl 'level' attribute (%s) does not match the 'PayloadScope' in the root dict of the plist (%s); the values must be identical", resourceName, level, payloadScope)
-	}
-
-	return nil
-}
-
 // validateSelfServiceCategories validates the 'self_service_category' block.
 func validateSelfServiceCategories(_ context.Context, diff *schema.ResourceDiff, _ any) error {
 	resourceName := diff.Get("name").(string)
@@ -154,7 +125,7 @@ func validateAllComputersScope(_ context.Context, diff *schema.ResourceDiff, _ a
 	allComputers := scope["all_computers"].(bool)
 
 	if allComputers {
-		fieldsToCheck := []string{"computer_ids", "computer_group_ids"}
+		fieldsToCheck := []string{"computer_ids", "computer_serial_numbers", "computer_udids", "computer_group_ids"}
 		for _, field := range fieldsToCheck {
 			if value, exists := scope[field]; exists {
 				if setVal, ok := value.(*schema.Set); ok && setVal.Len() > 0 {
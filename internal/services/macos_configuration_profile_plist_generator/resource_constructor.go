@@ -15,7 +15,9 @@ import (
 )
 
 // constructJamfProMacOSConfigurationProfilesPlistGenerator constructs a ResourceMacOSConfigurationProfile object from the provided schema data.
-func constructJamfProMacOSConfigurationProfilesPlistGenerator(d *schema.ResourceData) (*jamfpro.ResourceMacOSConfigurationProfile, error) {
+// With 'payload_uuid_mode' set to 'deterministic' the profile and payload UUIDs are derived from the Jamf
+// Pro instance FQDN and 'payload_uuid_key' rather than generated at random on every construct.
+func constructJamfProMacOSConfigurationProfilesPlistGenerator(d *schema.ResourceData, client *jamfpro.Client) (*jamfpro.ResourceMacOSConfigurationProfile, error) {
 	var resource *jamfpro.ResourceMacOSConfigurationProfile
 
 	plistXML, err := plist.ConvertHCLToPlist(d)
@@ -23,6 +25,16 @@ func constructJamfProMacOSConfigurationProfilesPlistGenerator(d *schema.Resource
 		return nil, fmt.Errorf("failed to generate plist from payloads: %v", err)
 	}
 
+	fqdn, uuidKey, deterministic, err := sharedschemas.GetDeterministicPayloadUUIDSource(d, client)
+	if err != nil {
+		return nil, err
+	}
+	if deterministic {
+		if plistXML, err = plist.ApplyDeterministicPayloadUUIDsToPlist(plistXML, fqdn, uuidKey); err != nil {
+			return nil, err
+		}
+	}
+
 	resource = &jamfpro.ResourceMacOSConfigurationProfile{
 		General: jamfpro.MacOSConfigurationProfileSubsetGeneral{
 			Name:               d.Get("name").(string),
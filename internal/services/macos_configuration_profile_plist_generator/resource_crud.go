@@ -25,7 +25,7 @@ func resourceJamfProMacOSConfigurationProfilesPlistGeneratorCreate(ctx context.C
 
 	// Lock the mutex to ensure only one profile plist create can run this function at a time
 
-	resource, err := constructJamfProMacOSConfigurationProfilesPlistGenerator(d)
+	resource, err := constructJamfProMacOSConfigurationProfilesPlistGenerator(d, client)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to construct Jamf Pro macOS Configuration Profile: %v", err))
 	}
@@ -92,7 +92,7 @@ func resourceJamfProMacOSConfigurationProfilesPlistGeneratorUpdate(ctx context.C
 	var diags diag.Diagnostics
 	resourceID := d.Id()
 
-	resource, err := constructJamfProMacOSConfigurationProfilesPlistGenerator(d)
+	resource, err := constructJamfProMacOSConfigurationProfilesPlistGenerator(d, client)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to construct Jamf Pro macOS Configuration Profile for update: %v", err))
 	}
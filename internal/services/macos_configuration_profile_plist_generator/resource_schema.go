@@ -5,8 +5,11 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/device_identifiers"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/plist"
 	sharedschemas "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/shared_schemas"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
@@ -113,7 +116,7 @@ import (
 
 // resourceJamfProMacOSConfigurationProfilesPlistGenerator defines the schema and CRUD operations for managing Jamf Pro macOS Configuration Profiles in Terraform.
 func ResourceJamfProMacOSConfigurationProfilesPlistGenerator() *schema.Resource {
-	return &schema.Resource{
+	return device_identifiers.WrapResource(&schema.Resource{
 		CreateContext: resourceJamfProMacOSConfigurationProfilesPlistGeneratorCreate,
 		ReadContext:   resourceJamfProMacOSConfigurationProfilesPlistGeneratorReadWithCleanup,
 		UpdateContext: resourceJamfProMacOSConfigurationProfilesPlistGeneratorUpdate,
@@ -150,8 +153,10 @@ func ResourceJamfProMacOSConfigurationProfilesPlistGenerator() *schema.Resource
 				Computed:    true,
 				Description: "The universally unique identifier for the profile.",
 			},
-			"site_id":     sharedschemas.GetSharedSchemaSite(),
-			"category_id": sharedschemas.GetSharedSchemaCategory(),
+			"site_id":           sharedschemas.GetSharedSchemaSite(),
+			"category_id":       sharedschemas.GetSharedSchemaCategory(),
+			"payload_uuid_mode": sharedschemas.GetSharedSchemaPayloadUUIDMode(),
+			"payload_uuid_key":  sharedschemas.GetSharedSchemaPayloadUUIDKey(),
 			"distribution_method": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -232,7 +237,7 @@ func ResourceJamfProMacOSConfigurationProfilesPlistGenerator() *schema.Resource
 						"payload_content": {
 							Type:        schema.TypeList,
 							Required:    true,
-							Description: "The payload content of the macOS configuration profile plist. Multiple payloads can be defined as needed.Defined as key value pairs and supports nested dictionaries.",
+							Description: "The payload content of the macOS configuration profile plist. Multiple payloads can be defined as needed.Defined as key value pairs and supports typed values, nested dictionaries and arrays.",
 							MaxItems:    1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
@@ -416,56 +421,75 @@ func ResourceJamfProMacOSConfigurationProfilesPlistGenerator() *schema.Resource
 				},
 			},
 		},
-	}
+	}, device_identifiers.ComputerScopeFields...)
 }
 
-// Define a finite level of nested dictionaries
+// Define a finite level of nested dictionaries. Entries at the deepest level cannot hold a
+// further 'dictionary' and express nested structures with 'value_json' instead.
 func nestedDictionarySchema(level int) *schema.Schema {
-	if level <= 0 {
-		return &schema.Schema{
-			Type:        schema.TypeMap,
-			Optional:    true,
-			Description: "A nested dictionary structure for xml plist definition.",
-			Elem:        schema.TypeString,
-		}
+	entry := settingValueSchema()
+	entry["key"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The key for the dictionary entry.",
 	}
+	if level > 0 {
+		entry["dictionary"] = nestedDictionarySchema(level - 1)
+	}
+
 	return &schema.Schema{
 		Type:        schema.TypeList,
 		Optional:    true,
 		Description: "A nested dictionary structure.",
 		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"key": {
-					Type:        schema.TypeString,
-					Required:    true,
-					Description: "The key for the dictionary entry.",
-				},
-				"value": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "The value for the dictionary entry.",
-				},
-				"dictionary": nestedDictionarySchema(level - 1),
-			},
+			Schema: entry,
 		},
 	}
 }
 
 // Define the payload content schema with limited depth
 func payloadContentSchema() *schema.Resource {
+	setting := settingValueSchema()
+	setting["key"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The key for the xml plist entry.",
+	}
+	setting["dictionary"] = nestedDictionarySchema(plist.MaxDictionaryNestingDepth)
+
 	return &schema.Resource{
-		Schema: map[string]*schema.Schema{
-			"key": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The key for the xml plist entry.",
-			},
-			"value": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "The value for the xml plist entry.",
-			},
-			"dictionary": nestedDictionarySchema(6),
+		Schema: setting,
+	}
+}
+
+// settingValueSchema defines the typed value attributes shared by settings and dictionary entries.
+func settingValueSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"value": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The value for the xml plist entry. Interpreted according to 'value_type'.",
+		},
+		"value_type": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Description: "The plist type of the entry. One of 'string', 'integer', 'real', 'boolean', 'date' (RFC 3339), " +
+				"'data' (base64), 'dict' or 'array'. When omitted the type is inferred from 'value' as in earlier releases: " +
+				"'true', 'false' and the other boolean spellings '1', '0', 'TRUE', 't' and so on become booleans, other whole " +
+				"numbers become integers and everything else a string. Set value_type to 'integer' for 1 or 0, or to 'string' " +
+				"for text that looks like a boolean or number. With value_type set, scalar values must use their canonical " +
+				"form, e.g. 'true' rather than '1' for a boolean and '1' rather than '01' for an integer.",
+			ValidateFunc: validation.StringInSlice(plist.SupportedValueTypes(), false),
+		},
+		"value_json": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Description: "A JSON document for the entry value, for arrays and arbitrarily nested structures. Objects become " +
+				"dicts, arrays become arrays, whole numbers integers and fractional numbers reals. Dates, data and whole " +
+				"number reals are expressed as {\"$date\": \"2024-01-01T00:00:00Z\"}, {\"$data\": \"<base64>\"} and {\"$real\": 1}. " +
+				"Takes precedence over 'value' and 'dictionary'.",
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: structure.SuppressJsonDiff,
 		},
 	}
 }
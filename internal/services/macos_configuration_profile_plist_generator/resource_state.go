@@ -35,8 +35,10 @@ func updateState(d *schema.ResourceData, resp *jamfpro.ResourceMacOSConfiguratio
 
 	d.Set("site_id", resp.General.Site.ID)
 
-	profile := plist.NormalizePayloadState(resp.General.Payloads)
-	if err := d.Set("payloads", profile); err != nil {
+	prior, _ := d.Get("payloads").([]any)
+	if payloads, err := plist.ConvertPlistToHCL(resp.General.Payloads, prior); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	} else if err := d.Set("payloads", payloads); err != nil {
 		diags = append(diags, diag.FromErr(err)...)
 	}
 
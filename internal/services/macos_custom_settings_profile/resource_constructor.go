@@ -0,0 +1,146 @@
+package macos_custom_settings_profile
+
+import (
+	"encoding/xml"
+	"fmt"
+	"html"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/crypto"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/plist"
+	sharedschemas "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/shared_schemas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	customSettingsPayloadDisplayName = "Custom Settings"
+	customSettingsDistributionMethod = "Install Automatically"
+)
+
+// construct builds a ResourceMacOSConfigurationProfile for a new custom settings profile.
+// With 'payload_uuid_mode' set to 'deterministic' the payload identifiers are derived from the Jamf
+// Pro instance FQDN and 'payload_uuid_key' rather than generated at random.
+func construct(d *schema.ResourceData, client *jamfpro.Client) (*jamfpro.ResourceMacOSConfigurationProfile, error) {
+	fqdn, uuidKey, deterministic, err := sharedschemas.GetDeterministicPayloadUUIDSource(d, client)
+	if err != nil {
+		return nil, err
+	}
+
+	if deterministic {
+		return constructWithIdentity(d, plist.DeterministicPayloadIdentity(fqdn, uuidKey, plist.ManagedPreferencesPayloadType))
+	}
+
+	return constructWithIdentity(d, plist.NewPayloadIdentity(plist.ManagedPreferencesPayloadType))
+}
+
+// constructForUpdate builds a ResourceMacOSConfigurationProfile reusing the PayloadUUID and
+// PayloadIdentifier values of the profile stored in Jamf Pro.
+// In deterministic payload UUID mode the payload keeps its derived identifiers.
+func constructForUpdate(d *schema.ResourceData, client *jamfpro.Client) (*jamfpro.ResourceMacOSConfigurationProfile, error) {
+	existingProfile, err := client.GetMacOSConfigurationProfileByID(d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get existing custom settings profile by ID for update operation: %v", err)
+	}
+
+	identity, err := plist.ExtractPayloadIdentity(existingProfile.General.Payloads, plist.ManagedPreferencesPayloadType)
+	if err != nil {
+		return nil, err
+	}
+
+	fqdn, uuidKey, deterministic, err := sharedschemas.GetDeterministicPayloadUUIDSource(d, client)
+	if err != nil {
+		return nil, err
+	}
+
+	if deterministic {
+		derived := plist.DeterministicPayloadIdentity(fqdn, uuidKey, plist.ManagedPreferencesPayloadType)
+		identity.PayloadUUID = derived.PayloadUUID
+		identity.PayloadIdentifier = derived.PayloadIdentifier
+	}
+
+	return constructWithIdentity(d, identity)
+}
+
+// constructWithIdentity renders the managed preferences plist and wraps it in a macOS configuration profile.
+func constructWithIdentity(d *schema.ResourceData, identity plist.PayloadIdentity) (*jamfpro.ResourceMacOSConfigurationProfile, error) {
+	settings, err := getSettings(d.Get("settings").(string), d.Get("settings_file").(string))
+	if err != nil {
+		return nil, err
+	}
+
+	level := d.Get("level").(string)
+	plistXML, err := plist.RenderSinglePayloadProfile(plist.SinglePayloadProfile{
+		DisplayName:        d.Get("name").(string),
+		Description:        d.Get("description").(string),
+		Organization:       d.Get("organization").(string),
+		Scope:              level,
+		RemovalDisallowed:  true,
+		PayloadDisplayName: customSettingsPayloadDisplayName,
+		PayloadType:        plist.ManagedPreferencesPayloadType,
+		PayloadContent:     plist.BuildManagedPreferences(d.Get("preference_domain").(string), settings),
+		Identity:           identity,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resource := &jamfpro.ResourceMacOSConfigurationProfile{
+		General: jamfpro.MacOSConfigurationProfileSubsetGeneral{
+			Name:               d.Get("name").(string),
+			Description:        d.Get("description").(string),
+			DistributionMethod: customSettingsDistributionMethod,
+			UserRemovable:      false,
+			Level:              level,
+			UUID:               d.Get("uuid").(string),
+			RedeployOnUpdate:   d.Get("redeploy_on_update").(string),
+			Payloads:           html.EscapeString(plistXML),
+		},
+	}
+
+	resource.General.Site = sharedschemas.ConstructSharedResourceSite(d.Get("site_id").(int))
+	resource.General.Category = sharedschemas.ConstructSharedResourceCategory(d.Get("category_id").(int))
+
+	if _, ok := d.GetOk("scope"); ok {
+		resource.Scope = sharedschemas.ConstructMacOSConfigurationProfileScope(d)
+	}
+
+	resourceXML, err := xml.MarshalIndent(resource, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Jamf Pro custom settings profile '%s' to XML: %v", resource.General.Name, err)
+	}
+
+	log.Printf("[DEBUG] Constructed Jamf Pro custom settings profile XML:\n%s\n", string(resourceXML))
+
+	return resource, nil
+}
+
+// getSettings decodes the preference settings from either the 'settings' JSON or the 'settings_file'.
+func getSettings(settingsJSON string, settingsFile string) (map[string]any, error) {
+	if settingsFile != "" {
+		return plist.LoadSettingsFile(settingsFile)
+	}
+
+	decoded, err := plist.DecodeJSONValue(settingsJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	settings, ok := decoded.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("'settings' must be a JSON object")
+	}
+
+	return settings, nil
+}
+
+// settingsHash returns a SHA-256 hash of the canonical JSON form of settings, so settings decoded
+// from a file and from Jamf Pro hash identically when they hold the same values.
+func settingsHash(settings map[string]any) (string, error) {
+	canonical, err := plist.EncodeJSONValue(plist.CanonicalizeValue(settings))
+	if err != nil {
+		return "", err
+	}
+
+	return crypto.HashString(canonical), nil
+}
@@ -0,0 +1,109 @@
+package macos_custom_settings_profile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/plist"
+	sharedschemas "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/shared_schemas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// mainCustomDiffFunc orchestrates all custom diff validations for custom settings profiles.
+func mainCustomDiffFunc(ctx context.Context, diff *schema.ResourceDiff, i any) error {
+	if err := validateSettings(ctx, diff, i); err != nil {
+		return err
+	}
+
+	if err := sharedschemas.ValidatePayloadUUIDKey(diff, "jamfpro_macos_custom_settings_profile"); err != nil {
+		return err
+	}
+
+	if err := validateAllComputersScope(ctx, diff, i); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateSettings decodes the configured settings, records the hash of file based settings so file
+// changes produce a diff, and validates the settings against 'schema_manifest' when one is supplied.
+func validateSettings(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+	resourceName := diff.Get("name").(string)
+	if raw := diff.GetRawConfig(); !raw.IsNull() {
+		for _, attr := range []string{"settings", "settings_file", "schema_manifest"} {
+			if !raw.GetAttr(attr).IsWhollyKnown() {
+				return nil
+			}
+		}
+	}
+
+	settingsFile := diff.Get("settings_file").(string)
+	settings, err := getSettings(diff.Get("settings").(string), settingsFile)
+	if err != nil {
+		return fmt.Errorf("in 'jamfpro_macos_custom_settings_profile.%s': %v", resourceName, err)
+	}
+
+	if settingsFile != "" {
+		hash, err := settingsHash(settings)
+		if err != nil {
+			return fmt.Errorf("in 'jamfpro_macos_custom_settings_profile.%s': %v", resourceName, err)
+		}
+		if diff.Get("settings_file_hash").(string) != hash {
+			if err := diff.SetNew("settings_file_hash", hash); err != nil {
+				return err
+			}
+		}
+	}
+
+	if manifest := diff.Get("schema_manifest").(string); manifest != "" {
+		if errs := plist.ValidateAgainstJamfJSONSchema(settings, manifest); len(errs) > 0 {
+			return fmt.Errorf("in 'jamfpro_macos_custom_settings_profile.%s': settings do not match 'schema_manifest':\n%w", resourceName, errors.Join(errs...))
+		}
+	}
+
+	return nil
+}
+
+// validateAllComputersScope ensures computer targets are not set alongside 'all_computers'.
+func validateAllComputersScope(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+	resourceName := diff.Get("name").(string)
+	scopeRaw, ok := diff.GetOk("scope")
+	if !ok {
+		return nil
+	}
+
+	scope := scopeRaw.([]any)[0].(map[string]any)
+	if !scope["all_computers"].(bool) {
+		return nil
+	}
+
+	for _, field := range []string{"computer_ids", "computer_serial_numbers", "computer_udids", "computer_group_ids"} {
+		if setVal, ok := scope[field].(*schema.Set); ok && setVal.Len() > 0 {
+			return fmt.Errorf("in 'jamfpro_macos_custom_settings_profile.%s': when 'all_computers' scope is set to true, '%s' should not be set", resourceName, field)
+		}
+	}
+
+	return nil
+}
+
+// diffSuppressSettings suppresses differences between 'settings' documents that decode to the same
+// preference values, ignoring key order, whitespace and integer/real encoding differences.
+func diffSuppressSettings(_, old, new string, _ *schema.ResourceData) bool {
+	if old == "" || new == "" {
+		return old == new
+	}
+
+	oldValue, err := plist.DecodeJSONValue(old)
+	if err != nil {
+		return false
+	}
+	newValue, err := plist.DecodeJSONValue(new)
+	if err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(plist.CanonicalizeValue(oldValue), plist.CanonicalizeValue(newValue))
+}
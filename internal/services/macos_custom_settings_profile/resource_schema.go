@@ -0,0 +1,118 @@
+package macos_custom_settings_profile
+
+import (
+	"time"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/device_identifiers"
+	sharedschemas "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/shared_schemas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceJamfProMacOSCustomSettingsProfile defines the schema and CRUD operations for managing
+// Application & Custom Settings (managed preferences) macOS configuration profiles in Terraform.
+func ResourceJamfProMacOSCustomSettingsProfile() *schema.Resource {
+	return device_identifiers.WrapResource(&schema.Resource{
+		CreateContext: create,
+		ReadContext:   readWithCleanup,
+		UpdateContext: update,
+		DeleteContext: delete,
+		CustomizeDiff: mainCustomDiffFunc,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(70 * time.Second),
+			Read:   schema.DefaultTimeout(70 * time.Second),
+			Update: schema.DefaultTimeout(70 * time.Second),
+			Delete: schema.DefaultTimeout(70 * time.Second),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the custom settings configuration profile.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Jamf UI name for the configuration profile. Also used as the profile PayloadDisplayName.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the configuration profile.",
+			},
+			"organization": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The PayloadOrganization written to the profile and its payload.",
+			},
+			"uuid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The universally unique identifier for the profile.",
+			},
+			"site_id":     sharedschemas.GetSharedSchemaSite(),
+			"category_id": sharedschemas.GetSharedSchemaCategory(),
+			"level": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "System",
+				Description:  "The deployment level of the configuration profile. Available options are: 'User' or 'System'. Note: 'System' is mapped to 'Computer Level' in the Jamf Pro GUI.",
+				ValidateFunc: validation.StringInSlice([]string{"User", "System"}, false),
+			},
+			"payload_uuid_mode": sharedschemas.GetSharedSchemaPayloadUUIDMode(),
+			"payload_uuid_key":  sharedschemas.GetSharedSchemaPayloadUUIDKey(),
+			"redeploy_on_update": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Newly Assigned",
+				Description:  "Defines the redeployment behaviour when an update to the profile occurs. Valid values are 'All' or 'Newly Assigned'.",
+				ValidateFunc: validation.StringInSlice([]string{"All", "Newly Assigned"}, false),
+			},
+			"scope": {
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Description: "The scope of the configuration profile.",
+				Required:    true,
+				Elem:        sharedschemas.GetSharedmacOSComputerSchemaScope(),
+			},
+			"preference_domain": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The preference domain of the application being configured, for example 'com.google.Chrome'.",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"settings": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "The preference settings as a JSON object, typically written with jsonencode(). Plist dates, data " +
+					"and whole number reals can be expressed with the {\"$date\": \"<RFC 3339>\"}, {\"$data\": \"<base64>\"} and " +
+					"{\"$real\": <number>} markers. Conflicts with 'settings_file'.",
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: diffSuppressSettings,
+				ExactlyOneOf:     []string{"settings", "settings_file"},
+			},
+			"settings_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Path to a .plist or .json file holding the preference settings. Conflicts with 'settings'.",
+			},
+			"settings_file_hash": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA-256 hash of the settings applied from 'settings_file', used to detect changes to the file and drift in Jamf Pro.",
+			},
+			"schema_manifest": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "An optional Jamf JSON schema manifest, as used by the Application & Custom Settings " +
+					"'Jamf Pro schema' source. When set, the settings are validated against it at plan time. The manifest " +
+					"is only used for validation and is not sent to Jamf Pro.",
+				ValidateFunc: validation.StringIsJSON,
+			},
+		},
+	}, device_identifiers.ComputerScopeFields...)
+}
@@ -0,0 +1,73 @@
+package macos_custom_settings_profile
+
+import (
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/plist"
+	sharedschemas "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/shared_schemas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// updateState updates the Terraform state with the latest custom settings profile information from the Jamf Pro API.
+func updateState(d *schema.ResourceData, resp *jamfpro.ResourceMacOSConfigurationProfile) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Jamf Pro returns 'Computer' for system level profiles
+	level := resp.General.Level
+	if level == "Computer" {
+		level = "System"
+	}
+
+	resourceData := map[string]any{
+		"name":        resp.General.Name,
+		"description": resp.General.Description,
+		"uuid":        resp.General.UUID,
+		"level":       level,
+		"site_id":     resp.General.Site.ID,
+		"category_id": resp.General.Category.ID,
+		"scope":       []any{sharedschemas.FlattenMacOSConfigurationProfileScope(resp.Scope)},
+	}
+
+	profile, err := plist.DecodePlist([]byte(resp.General.Payloads))
+	if err != nil {
+		return append(diags, diag.FromErr(fmt.Errorf("failed to decode custom settings profile plist: %v", err))...)
+	}
+
+	resourceData["organization"], _ = profile["PayloadOrganization"].(string)
+
+	payload, err := plist.FindPayloadOfType(profile, plist.ManagedPreferencesPayloadType)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	domain, settings, err := plist.ParseManagedPreferences(payload)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	resourceData["preference_domain"] = domain
+
+	// Settings sourced from a file are tracked by hash; inline settings are written back as JSON.
+	if d.Get("settings_file").(string) != "" {
+		hash, err := settingsHash(settings)
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		resourceData["settings_file_hash"] = hash
+	} else {
+		settingsJSON, err := plist.EncodeJSONValue(settings)
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		resourceData["settings"] = settingsJSON
+	}
+
+	for k, v := range resourceData {
+		if err := d.Set(k, v); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,158 @@
+package macos_pppc_profile
+
+import (
+	"encoding/xml"
+	"fmt"
+	"html"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/plist"
+	sharedschemas "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/shared_schemas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	pppcPayloadDisplayName = "Privacy Preferences Policy Control"
+	pppcDistributionMethod = "Install Automatically"
+	pppcLevel              = "System"
+)
+
+// construct builds a ResourceMacOSConfigurationProfile for a new PPPC profile.
+// With 'payload_uuid_mode' set to 'deterministic' the payload identifiers are derived from the Jamf
+// Pro instance FQDN and 'payload_uuid_key' rather than generated at random.
+func construct(d *schema.ResourceData, client *jamfpro.Client) (*jamfpro.ResourceMacOSConfigurationProfile, error) {
+	fqdn, uuidKey, deterministic, err := sharedschemas.GetDeterministicPayloadUUIDSource(d, client)
+	if err != nil {
+		return nil, err
+	}
+
+	if deterministic {
+		return constructWithIdentity(d, plist.DeterministicPayloadIdentity(fqdn, uuidKey, plist.PPPCPayloadType))
+	}
+
+	return constructWithIdentity(d, plist.NewPayloadIdentity(plist.PPPCPayloadType))
+}
+
+// constructForUpdate builds a ResourceMacOSConfigurationProfile reusing the PayloadUUID and
+// PayloadIdentifier values of the profile stored in Jamf Pro, so an update modifies the installed
+// profile rather than replacing it.
+// In deterministic payload UUID mode the payload keeps its derived identifiers.
+func constructForUpdate(d *schema.ResourceData, client *jamfpro.Client) (*jamfpro.ResourceMacOSConfigurationProfile, error) {
+	existingProfile, err := client.GetMacOSConfigurationProfileByID(d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get existing PPPC profile by ID for update operation: %v", err)
+	}
+
+	identity, err := plist.ExtractPayloadIdentity(existingProfile.General.Payloads, plist.PPPCPayloadType)
+	if err != nil {
+		return nil, err
+	}
+
+	fqdn, uuidKey, deterministic, err := sharedschemas.GetDeterministicPayloadUUIDSource(d, client)
+	if err != nil {
+		return nil, err
+	}
+
+	if deterministic {
+		derived := plist.DeterministicPayloadIdentity(fqdn, uuidKey, plist.PPPCPayloadType)
+		identity.PayloadUUID = derived.PayloadUUID
+		identity.PayloadIdentifier = derived.PayloadIdentifier
+	}
+
+	return constructWithIdentity(d, identity)
+}
+
+// constructWithIdentity renders the PPPC plist and wraps it in a macOS configuration profile.
+func constructWithIdentity(d *schema.ResourceData, identity plist.PayloadIdentity) (*jamfpro.ResourceMacOSConfigurationProfile, error) {
+	entries, err := constructPPPCEntries(d)
+	if err != nil {
+		return nil, err
+	}
+
+	plistXML, err := renderPPPCProfile(d, entries, identity)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := &jamfpro.ResourceMacOSConfigurationProfile{
+		General: jamfpro.MacOSConfigurationProfileSubsetGeneral{
+			Name:               d.Get("name").(string),
+			Description:        d.Get("description").(string),
+			DistributionMethod: pppcDistributionMethod,
+			UserRemovable:      false,
+			Level:              pppcLevel,
+			UUID:               d.Get("uuid").(string),
+			RedeployOnUpdate:   d.Get("redeploy_on_update").(string),
+			Payloads:           html.EscapeString(plistXML),
+		},
+	}
+
+	resource.General.Site = sharedschemas.ConstructSharedResourceSite(d.Get("site_id").(int))
+	resource.General.Category = sharedschemas.ConstructSharedResourceCategory(d.Get("category_id").(int))
+
+	if _, ok := d.GetOk("scope"); ok {
+		resource.Scope = sharedschemas.ConstructMacOSConfigurationProfileScope(d)
+	}
+
+	resourceXML, err := xml.MarshalIndent(resource, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Jamf Pro PPPC profile '%s' to XML: %v", resource.General.Name, err)
+	}
+
+	log.Printf("[DEBUG] Constructed Jamf Pro PPPC profile XML:\n%s\n", string(resourceXML))
+
+	return resource, nil
+}
+
+// constructPPPCEntries maps the 'service' blocks into PPPC entries and validates them.
+func constructPPPCEntries(d *schema.ResourceData) ([]plist.PPPCEntry, error) {
+	var entries []plist.PPPCEntry
+
+	for _, v := range d.Get("service").(*schema.Set).List() {
+		entry := pppcEntryFromBlock(v.(map[string]any))
+		if err := plist.ValidatePPPCEntry(entry); err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// pppcEntryFromBlock converts a single 'service' block into a PPPC entry.
+func pppcEntryFromBlock(block map[string]any) plist.PPPCEntry {
+	entry := plist.PPPCEntry{
+		Service:         block["name"].(string),
+		Identifier:      block["identifier"].(string),
+		IdentifierType:  block["identifier_type"].(string),
+		CodeRequirement: block["code_requirement"].(string),
+		Authorization:   block["authorization"].(string),
+		StaticCode:      block["static_code"].(bool),
+		Comment:         block["comment"].(string),
+	}
+
+	if receivers, ok := block["apple_events_receiver"].([]any); ok && len(receivers) > 0 && receivers[0] != nil {
+		receiver := receivers[0].(map[string]any)
+		entry.AEReceiverIdentifier = receiver["identifier"].(string)
+		entry.AEReceiverIdentifierType = receiver["identifier_type"].(string)
+		entry.AEReceiverCodeRequirement = receiver["code_requirement"].(string)
+	}
+
+	return entry
+}
+
+// renderPPPCProfile renders the complete configuration profile plist holding a single PPPC payload.
+func renderPPPCProfile(d *schema.ResourceData, entries []plist.PPPCEntry, identity plist.PayloadIdentity) (string, error) {
+	return plist.RenderSinglePayloadProfile(plist.SinglePayloadProfile{
+		DisplayName:        d.Get("name").(string),
+		Description:        d.Get("description").(string),
+		Organization:       d.Get("organization").(string),
+		Scope:              pppcLevel,
+		RemovalDisallowed:  true,
+		PayloadDisplayName: pppcPayloadDisplayName,
+		PayloadType:        plist.PPPCPayloadType,
+		PayloadContent:     map[string]any{"Services": plist.BuildPPPCServices(entries)},
+		Identity:           identity,
+	})
+}
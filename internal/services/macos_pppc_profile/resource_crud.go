@@ -0,0 +1,85 @@
+package macos_pppc_profile
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	crud "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/sdkv2_crud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// create is responsible for creating a new Jamf Pro PPPC profile in the remote system.
+func create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return crud.Create(
+		ctx,
+		d,
+		meta,
+		func(d *schema.ResourceData) (*jamfpro.ResourceMacOSConfigurationProfile, error) {
+			return construct(d, meta.(*jamfpro.Client))
+		},
+		meta.(*jamfpro.Client).CreateMacOSConfigurationProfile,
+		readNoCleanup,
+	)
+}
+
+// read is responsible for reading the current state of a Jamf Pro PPPC profile from the remote system.
+func read(ctx context.Context, d *schema.ResourceData, meta any, cleanup bool) diag.Diagnostics {
+	return crud.Read(
+		ctx,
+		d,
+		meta,
+		cleanup,
+		meta.(*jamfpro.Client).GetMacOSConfigurationProfileByID,
+		updateState,
+	)
+}
+
+// readWithCleanup reads the resource with cleanup enabled
+func readWithCleanup(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return read(ctx, d, meta, true)
+}
+
+// readNoCleanup reads the resource with cleanup disabled
+func readNoCleanup(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return read(ctx, d, meta, false)
+}
+
+// update is responsible for updating an existing Jamf Pro PPPC profile on the remote system.
+// The existing profile is fetched first so its payload identifiers are preserved.
+func update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+	var diags diag.Diagnostics
+	resourceID := d.Id()
+
+	resource, err := constructForUpdate(d, client)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to construct Jamf Pro PPPC profile for update: %v", err))
+	}
+
+	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *retry.RetryError {
+		_, apiErr := client.UpdateMacOSConfigurationProfileByID(resourceID, resource)
+		if apiErr != nil {
+			return retry.RetryableError(apiErr)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to update Jamf Pro PPPC profile '%s' (ID: %s) after retries: %v", resource.General.Name, resourceID, err))
+	}
+
+	return append(diags, readNoCleanup(ctx, d, meta)...)
+}
+
+// delete is responsible for deleting a Jamf Pro PPPC profile.
+func delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return crud.Delete(
+		ctx,
+		d,
+		meta,
+		meta.(*jamfpro.Client).DeleteMacOSConfigurationProfileByID,
+	)
+}
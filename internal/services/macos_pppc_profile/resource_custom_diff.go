@@ -0,0 +1,74 @@
+package macos_pppc_profile
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/plist"
+	sharedschemas "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/shared_schemas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// mainCustomDiffFunc orchestrates all custom diff validations for PPPC profiles.
+func mainCustomDiffFunc(ctx context.Context, diff *schema.ResourceDiff, i any) error {
+	if err := validateServices(ctx, diff, i); err != nil {
+		return err
+	}
+
+	if err := sharedschemas.ValidatePayloadUUIDKey(diff, "jamfpro_macos_pppc_profile"); err != nil {
+		return err
+	}
+
+	if err := validateAllComputersScope(ctx, diff, i); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateServices checks every 'service' block against the per service authorization rules and
+// validates the syntax of its code requirements.
+func validateServices(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+	resourceName := diff.Get("name").(string)
+	if raw := diff.GetRawConfig(); !raw.IsNull() && !raw.GetAttr("service").IsWhollyKnown() {
+		return nil
+	}
+
+	seen := make(map[string]bool)
+	for _, v := range diff.Get("service").(*schema.Set).List() {
+		entry := pppcEntryFromBlock(v.(map[string]any))
+		if err := plist.ValidatePPPCEntry(entry); err != nil {
+			return fmt.Errorf("in 'jamfpro_macos_pppc_profile.%s': %v", resourceName, err)
+		}
+
+		key := fmt.Sprintf("%s|%s|%s", entry.Service, entry.Identifier, entry.AEReceiverIdentifier)
+		if seen[key] {
+			return fmt.Errorf("in 'jamfpro_macos_pppc_profile.%s': service '%s' has more than one entry for identifier '%s'", resourceName, entry.Service, entry.Identifier)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
+
+// validateAllComputersScope ensures computer targets are not set alongside 'all_computers'.
+func validateAllComputersScope(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+	resourceName := diff.Get("name").(string)
+	scopeRaw, ok := diff.GetOk("scope")
+	if !ok {
+		return nil
+	}
+
+	scope := scopeRaw.([]any)[0].(map[string]any)
+	if !scope["all_computers"].(bool) {
+		return nil
+	}
+
+	for _, field := range []string{"computer_ids", "computer_serial_numbers", "computer_udids", "computer_group_ids"} {
+		if setVal, ok := scope[field].(*schema.Set); ok && setVal.Len() > 0 {
+			return fmt.Errorf("in 'jamfpro_macos_pppc_profile.%s': when 'all_computers' scope is set to true, '%s' should not be set", resourceName, field)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,155 @@
+package macos_pppc_profile
+
+import (
+	"time"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/device_identifiers"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/plist"
+	sharedschemas "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/shared_schemas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceJamfProMacOSPPPCProfile defines the schema and CRUD operations for managing Privacy Preferences
+// Policy Control (PPPC) macOS configuration profiles in Terraform.
+func ResourceJamfProMacOSPPPCProfile() *schema.Resource {
+	return device_identifiers.WrapResource(&schema.Resource{
+		CreateContext: create,
+		ReadContext:   readWithCleanup,
+		UpdateContext: update,
+		DeleteContext: delete,
+		CustomizeDiff: mainCustomDiffFunc,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(70 * time.Second),
+			Read:   schema.DefaultTimeout(70 * time.Second),
+			Update: schema.DefaultTimeout(70 * time.Second),
+			Delete: schema.DefaultTimeout(70 * time.Second),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the PPPC configuration profile.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Jamf UI name for the PPPC configuration profile. Also used as the profile PayloadDisplayName.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the configuration profile.",
+			},
+			"organization": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The PayloadOrganization written to the profile and its PPPC payload.",
+			},
+			"uuid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The universally unique identifier for the profile.",
+			},
+			"site_id":           sharedschemas.GetSharedSchemaSite(),
+			"category_id":       sharedschemas.GetSharedSchemaCategory(),
+			"payload_uuid_mode": sharedschemas.GetSharedSchemaPayloadUUIDMode(),
+			"payload_uuid_key":  sharedschemas.GetSharedSchemaPayloadUUIDKey(),
+			"redeploy_on_update": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Newly Assigned",
+				Description:  "Defines the redeployment behaviour when an update to the profile occurs. Valid values are 'All' or 'Newly Assigned'.",
+				ValidateFunc: validation.StringInSlice([]string{"All", "Newly Assigned"}, false),
+			},
+			"scope": {
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Description: "The scope of the configuration profile.",
+				Required:    true,
+				Elem:        sharedschemas.GetSharedmacOSComputerSchemaScope(),
+			},
+			"service": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "A PPPC entry granting or denying an application or binary access to a privacy protected service. PPPC profiles are always deployed at the computer level and installed automatically.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The TCC service the entry applies to, for example 'SystemPolicyAllFiles' or 'Accessibility'.",
+							ValidateFunc: validation.StringInSlice(plist.PPPCServices(), false),
+						},
+						"identifier": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The bundle ID or installation path of the application or binary.",
+						},
+						"identifier_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      plist.PPPCIdentifierTypeBundleID,
+							Description:  "The type of 'identifier'. Valid values are 'bundleID' or 'path'.",
+							ValidateFunc: validation.StringInSlice(plist.PPPCIdentifierTypes(), false),
+						},
+						"code_requirement": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The designated code requirement of the application or binary, as returned by 'codesign -dr - <path>'. The syntax is validated at plan time.",
+						},
+						"authorization": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The access granted to the service. Valid values are 'Allow', 'Deny' or 'AllowStandardUserToSetSystemService'. Camera and Microphone only accept 'Deny'; ScreenCapture and ListenEvent only accept 'Deny' or 'AllowStandardUserToSetSystemService'.",
+							ValidateFunc: validation.StringInSlice(plist.PPPCAuthorizations(), false),
+						},
+						"static_code": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether the code requirement is validated against the static code on disk only.",
+						},
+						"comment": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "",
+							Description: "An optional comment stored with the entry.",
+						},
+						"apple_events_receiver": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "The application receiving Apple Events. Required for, and only valid with, the 'AppleEvents' service.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"identifier": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The bundle ID or installation path of the receiving application.",
+									},
+									"identifier_type": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      plist.PPPCIdentifierTypeBundleID,
+										Description:  "The type of the receiver 'identifier'. Valid values are 'bundleID' or 'path'.",
+										ValidateFunc: validation.StringInSlice(plist.PPPCIdentifierTypes(), false),
+									},
+									"code_requirement": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The designated code requirement of the receiving application.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}, device_identifiers.ComputerScopeFields...)
+}
@@ -0,0 +1,84 @@
+package macos_pppc_profile
+
+import (
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/plist"
+	sharedschemas "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/shared_schemas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// updateState updates the Terraform state with the latest PPPC profile information from the Jamf Pro API.
+func updateState(d *schema.ResourceData, resp *jamfpro.ResourceMacOSConfigurationProfile) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	resourceData := map[string]any{
+		"name":        resp.General.Name,
+		"description": resp.General.Description,
+		"uuid":        resp.General.UUID,
+		"site_id":     resp.General.Site.ID,
+		"category_id": resp.General.Category.ID,
+		"scope":       []any{sharedschemas.FlattenMacOSConfigurationProfileScope(resp.Scope)},
+	}
+
+	profile, err := plist.DecodePlist([]byte(resp.General.Payloads))
+	if err != nil {
+		return append(diags, diag.FromErr(fmt.Errorf("failed to decode PPPC profile plist: %v", err))...)
+	}
+
+	resourceData["organization"], _ = profile["PayloadOrganization"].(string)
+
+	payload, err := plist.FindPayloadOfType(profile, plist.PPPCPayloadType)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	services, _ := payload["Services"].(map[string]any)
+	entries, err := plist.ParsePPPCServices(services)
+	if err != nil {
+		return append(diags, diag.FromErr(fmt.Errorf("failed to parse PPPC services: %v", err))...)
+	}
+	resourceData["service"] = flattenPPPCEntries(entries)
+
+	for k, v := range resourceData {
+		if err := d.Set(k, v); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return diags
+}
+
+// flattenPPPCEntries converts PPPC entries into 'service' blocks.
+func flattenPPPCEntries(entries []plist.PPPCEntry) []any {
+	out := make([]any, 0, len(entries))
+
+	for _, entry := range entries {
+		block := map[string]any{
+			"name":                  entry.Service,
+			"identifier":            entry.Identifier,
+			"identifier_type":       entry.IdentifierType,
+			"code_requirement":      entry.CodeRequirement,
+			"authorization":         entry.Authorization,
+			"static_code":           entry.StaticCode,
+			"comment":               entry.Comment,
+			"apple_events_receiver": []any{},
+		}
+
+		if entry.AEReceiverIdentifier != "" {
+			block["apple_events_receiver"] = []any{
+				map[string]any{
+					"identifier":       entry.AEReceiverIdentifier,
+					"identifier_type":  entry.AEReceiverIdentifierType,
+					"code_requirement": entry.AEReceiverCodeRequirement,
+				},
+			}
+		}
+
+		out = append(out, block)
+	}
+
+	return out
+}
@@ -1,7 +1,12 @@
 package mobile_device_application
 
 import (
+	"fmt"
+	"reflect"
 	"strings"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/plist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // normalizeWhitespace removes leading/trailing whitespace and normalizes newlines
@@ -16,3 +21,57 @@ func normalizeWhitespace(s string) string {
 
 	return strings.TrimSpace(strings.Join(lines, "\n"))
 }
+
+// constructAppConfigurationSettings converts the 'preferences_map' values into plist values.
+func constructAppConfigurationSettings(preferencesMap map[string]any) (map[string]any, error) {
+	settings := make(map[string]any, len(preferencesMap))
+	for key, raw := range preferencesMap {
+		value, err := plist.DecodeAppConfigurationMapValue(raw.(string))
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for key '%s': %v", key, err)
+		}
+		settings[key] = value
+	}
+	return settings, nil
+}
+
+// stateAppConfigurationMap converts the app configuration returned by Jamf Pro into
+// 'preferences_map' values.
+func stateAppConfigurationMap(preferences string) (map[string]any, error) {
+	settings, err := plist.ParseAppConfiguration(preferences)
+	if err != nil {
+		return nil, err
+	}
+
+	preferencesMap := make(map[string]any, len(settings))
+	for key, value := range settings {
+		encoded, err := plist.EncodeAppConfigurationMapValue(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode app configuration key '%s': %v", key, err)
+		}
+		preferencesMap[key] = encoded
+	}
+	return preferencesMap, nil
+}
+
+// suppressEquivalentAppConfiguration suppresses differences between app configurations that only
+// differ in formatting, such as the XML reformatted by Jamf Pro.
+func suppressEquivalentAppConfiguration(k, old, new string, d *schema.ResourceData) bool {
+	return normalizeWhitespace(old) == normalizeWhitespace(new) || plist.AppConfigurationsEquivalent(old, new)
+}
+
+// suppressEquivalentAppConfigurationMapValue suppresses differences between 'preferences_map'
+// values that decode to the same plist value, such as "1.0" and "1".
+func suppressEquivalentAppConfigurationMapValue(k, old, new string, d *schema.ResourceData) bool {
+	if strings.HasSuffix(k, ".%") {
+		return old == new
+	}
+
+	oldValue, errOld := plist.DecodeAppConfigurationMapValue(old)
+	newValue, errNew := plist.DecodeAppConfigurationMapValue(new)
+	if errOld != nil || errNew != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(plist.CanonicalizeValue(oldValue), plist.CanonicalizeValue(newValue))
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/constructors"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/plist"
 	sharedschemas "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/shared_schemas"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -99,8 +100,20 @@ func construct(d *schema.ResourceData) (*jamfpro.ResourceMobileDeviceApplication
 
 	if v, ok := d.GetOk("app_configuration"); ok && len(v.([]any)) > 0 {
 		appConfigMap := v.([]any)[0].(map[string]any)
+		preferences := appConfigMap["preferences"].(string)
+
+		if preferencesMap := appConfigMap["preferences_map"].(map[string]any); len(preferencesMap) > 0 {
+			settings, err := constructAppConfigurationSettings(preferencesMap)
+			if err != nil {
+				return nil, fmt.Errorf("failed to construct app configuration: %v", err)
+			}
+			if preferences, err = plist.EncodeAppConfiguration(settings); err != nil {
+				return nil, err
+			}
+		}
+
 		resource.AppConfiguration = jamfpro.MobileDeviceApplicationSubsetGeneralAppConfiguration{
-			Preferences: appConfigMap["preferences"].(string),
+			Preferences: preferences,
 		}
 	}
 
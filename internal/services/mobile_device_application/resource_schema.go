@@ -3,13 +3,14 @@ package mobile_device_application
 import (
 	"time"
 
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/device_identifiers"
 	sharedschemas "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/shared_schemas"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // ResourceJamfProMobileDeviceApplication defines the schema and CRUD operations for managing Jamf Pro Mobile Device Applications in Terraform
 func ResourceJamfProMobileDeviceApplication() *schema.Resource {
-	return &schema.Resource{
+	return device_identifiers.WrapResource(&schema.Resource{
 		CreateContext: create,
 		ReadContext:   readWithCleanup,
 		UpdateContext: update,
@@ -307,12 +308,24 @@ func ResourceJamfProMobileDeviceApplication() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"preferences": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "The preferences configuration for the application.",
-							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-								return normalizeWhitespace(old) == normalizeWhitespace(new)
-							},
+							Type:     schema.TypeString,
+							Optional: true,
+							Description: "The managed app configuration of the application as a plist <dict>. Validated as a plist " +
+								"dictionary that only uses Jamf Pro payload variables such as $SERIALNUMBER, $EMAIL or $EXTENSIONATTRIBUTE_<id>.",
+							ConflictsWith:    []string{"app_configuration.0.preferences_map"},
+							ValidateDiagFunc: validateAppConfigurationPreferences(),
+							DiffSuppressFunc: suppressEquivalentAppConfiguration,
+						},
+						"preferences_map": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Description: "The managed app configuration of the application as a map, encoded as a plist <dict>. " +
+								"'true' and 'false' become booleans and whole numbers integers. Use jsonencode() for strings that " +
+								"look like numbers or booleans, arrays and dictionaries.",
+							Elem:             &schema.Schema{Type: schema.TypeString},
+							ConflictsWith:    []string{"app_configuration.0.preferences"},
+							ValidateDiagFunc: validateAppConfigurationMap(),
+							DiffSuppressFunc: suppressEquivalentAppConfigurationMapValue,
 						},
 					},
 				},
@@ -325,5 +338,5 @@ func ResourceJamfProMobileDeviceApplication() *schema.Resource {
 				Elem:        sharedschemas.GetSharedMobileDeviceSchemaScope(),
 			},
 		},
-	}
+	}, device_identifiers.MobileDeviceScopeFields...)
 }
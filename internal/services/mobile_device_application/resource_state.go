@@ -95,12 +95,23 @@ func updateState(d *schema.ResourceData, resp *jamfpro.ResourceMobileDeviceAppli
 	}
 
 	if resp.AppConfiguration.Preferences != "" {
-		appConfig := []map[string]any{
-			{
-				"preferences": normalizeWhitespace(resp.AppConfiguration.Preferences),
-			},
+		appConfig := map[string]any{
+			"preferences": normalizeWhitespace(resp.AppConfiguration.Preferences),
+		}
+
+		// When the configuration is managed through 'preferences_map', 'preferences' is not
+		// configured and is left empty so it does not show a diff.
+		if preferencesMap, ok := d.GetOk("app_configuration.0.preferences_map"); ok && len(preferencesMap.(map[string]any)) > 0 {
+			stateMap, err := stateAppConfigurationMap(resp.AppConfiguration.Preferences)
+			if err != nil {
+				diags = append(diags, diag.FromErr(err)...)
+			} else {
+				appConfig["preferences"] = ""
+				appConfig["preferences_map"] = stateMap
+			}
 		}
-		d.Set("app_configuration", appConfig)
+
+		d.Set("app_configuration", []map[string]any{appConfig})
 	}
 
 	if scopeData, err := setScope(resp); err != nil {
@@ -0,0 +1,53 @@
+package mobile_device_application
+
+import (
+	"fmt"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/plist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// validateAppConfigurationPreferences validates that the app configuration preferences are a plist
+// dictionary that only uses Jamf Pro payload variables.
+func validateAppConfigurationPreferences() schema.SchemaValidateDiagFunc {
+	return validation.ToDiagFunc(func(i any, k string) ([]string, []error) {
+		v, ok := i.(string)
+		if !ok {
+			return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+		}
+
+		settings, err := plist.ParseAppConfiguration(v)
+		if err != nil {
+			return nil, []error{fmt.Errorf("%s: %v", k, err)}
+		}
+
+		if err := plist.ValidateAppConfigurationVariables(settings); err != nil {
+			return nil, []error{fmt.Errorf("%s: %v", k, err)}
+		}
+
+		return nil, nil
+	})
+}
+
+// validateAppConfigurationMap validates that the app configuration map values can be encoded as
+// plist values and only use Jamf Pro payload variables.
+func validateAppConfigurationMap() schema.SchemaValidateDiagFunc {
+	return validation.ToDiagFunc(func(i any, k string) ([]string, []error) {
+		v, ok := i.(map[string]any)
+		if !ok {
+			return nil, []error{fmt.Errorf("expected type of %s to be map", k)}
+		}
+
+		settings, err := constructAppConfigurationSettings(v)
+		if err != nil {
+			return nil, []error{fmt.Errorf("%s: %v", k, err)}
+		}
+
+		if err := plist.ValidateAppConfigurationVariables(settings); err != nil {
+			return nil, []error{fmt.Errorf("%s: %v", k, err)}
+		}
+
+		return nil, nil
+	})
+}
@@ -25,9 +25,10 @@ import (
 // The function now reads scope data assuming TypeSet in the schema.
 //
 // Parameters:
-// - d: Schema ResourceData containing configuration
-// - mode: "create" or "update" to control UUID handling
-// - meta: Provider meta containing client for API calls
+//   - d: Schema ResourceData containing configuration
+//   - mode: "create" or "update" to control UUID handling (with 'payload_uuid_mode' set to 'deterministic', nested payload UUIDs
+//     are derived from the instance FQDN and 'payload_uuid_key' instead of reused from Jamf Pro)
+//   - meta: Provider meta containing client for API calls
 //
 // Returns:
 // - Constructed ResourceMobileDeviceConfigurationProfile
@@ -63,15 +64,34 @@ func constructJamfProMobileDeviceConfigurationProfilePlist(d *schema.ResourceDat
 	}
 
 	// Handle Payloads based on mode
-	if mode != "update" {
+	client, _ := meta.(*jamfpro.Client)
+	fqdn, uuidKey, deterministic, err := sharedschemas.GetDeterministicPayloadUUIDSource(d, client)
+	if err != nil {
+		return nil, err
+	}
+
+	if mode != "update" && !deterministic {
 		resource.General.Payloads = html.EscapeString(d.Get("payloads").(string))
+	} else if mode != "update" {
+		var newPlist map[string]any
+		if err := plist.NewDecoder(strings.NewReader(d.Get("payloads").(string))).Decode(&newPlist); err != nil {
+			return nil, fmt.Errorf("failed to decode plist payload for deterministic PayloadUUID generation: %v", err)
+		}
+
+		helpers.ApplyDeterministicPayloadUUIDs(newPlist, fqdn, uuidKey, true)
+
+		encoder := plist.NewEncoder(&buf)
+		encoder.Indent("    ")
+		if err := encoder.Encode(newPlist); err != nil {
+			return nil, fmt.Errorf("failed to encode plist payload with deterministic PayloadUUID and PayloadIdentifier: %v", err)
+		}
+		resource.General.Payloads = preMarshallingXMLPayloadEscaping(preMarshallingXMLPayloadUnescaping(buf.String()))
+
 	} else if mode == "update" {
 		var existingPlist map[string]any
 		var newPlist map[string]any
 
-		client := meta.(*jamfpro.Client)
 		resourceID := d.Id()
-		var err error
 		existingProfile, err = client.GetMobileDeviceConfigurationProfileByID(resourceID)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get existing mobile device configuration profile by ID %s for update: %v", resourceID, err)
@@ -94,16 +114,21 @@ func constructJamfProMobileDeviceConfigurationProfilePlist(d *schema.ResourceDat
 		newPlist["PayloadUUID"] = existingPlist["PayloadUUID"]
 		newPlist["PayloadIdentifier"] = existingPlist["PayloadIdentifier"]
 
-		uuidMap := make(map[string]string)
-		identifierMap := make(map[string]string)
-		helpers.ExtractUUIDs(existingPlist, uuidMap, true)
-		helpers.ExtractPayloadIdentifiers(existingPlist, identifierMap, true)
-		helpers.UpdateUUIDs(newPlist, uuidMap, identifierMap, true)
-
-		var mismatches []string
-		helpers.ValidatePayloadUUIDsMatch(existingPlist, newPlist, "Payload", &mismatches)
-		if len(mismatches) > 0 {
-			log.Printf("[WARN] Mobile device configuration profile (ID: %s) UUID mismatches found after update attempt:\n%s", resourceID, strings.Join(mismatches, "\n"))
+		if deterministic {
+			// Nested payloads take their deterministic UUIDs rather than those stored in Jamf Pro.
+			helpers.ApplyDeterministicPayloadUUIDs(newPlist, fqdn, uuidKey, false)
+		} else {
+			uuidMap := make(map[string]string)
+			identifierMap := make(map[string]string)
+			helpers.ExtractUUIDs(existingPlist, uuidMap, true)
+			helpers.ExtractPayloadIdentifiers(existingPlist, identifierMap, true)
+			helpers.UpdateUUIDs(newPlist, uuidMap, identifierMap, true)
+
+			var mismatches []string
+			helpers.ValidatePayloadUUIDsMatch(existingPlist, newPlist, "Payload", &mismatches)
+			if len(mismatches) > 0 {
+				log.Printf("[WARN] Mobile device configuration profile (ID: %s) UUID mismatches found after update attempt:\n%s", resourceID, strings.Join(mismatches, "\n"))
+			}
 		}
 
 		// Encode the plist with injections
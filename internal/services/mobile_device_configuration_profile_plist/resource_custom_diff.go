@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/plist"
+	sharedschemas "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/shared_schemas"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -26,6 +27,10 @@ func mainCustomDiffFunc(ctx context.Context, diff *schema.ResourceDiff, i any) e
 
 	}
 
+	if err := sharedschemas.ValidatePayloadUUIDKey(diff, "jamfpro_mobile_device_configuration_profile_plist"); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -5,13 +5,14 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/device_identifiers"
 	sharedschemas "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/shared_schemas"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // resourceJamfProMobileDeviceConfigurationProfilesPlist defines the schema for mobile device configuration profiles in Terraform.
 func ResourceJamfProMobileDeviceConfigurationProfilesPlist() *schema.Resource {
-	return &schema.Resource{
+	return device_identifiers.WrapResource(&schema.Resource{
 		CreateContext: create,
 		ReadContext:   readWithCleanup,
 		UpdateContext: update,
@@ -75,6 +76,8 @@ func ResourceJamfProMobileDeviceConfigurationProfilesPlist() *schema.Resource {
 					return warns, errs
 				},
 			},
+			"payload_uuid_mode": sharedschemas.GetSharedSchemaPayloadUUIDMode(),
+			"payload_uuid_key":  sharedschemas.GetSharedSchemaPayloadUUIDKey(),
 			"redeploy_on_update": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -167,5 +170,5 @@ func ResourceJamfProMobileDeviceConfigurationProfilesPlist() *schema.Resource {
 				Elem:        sharedschemas.GetSharedMobileDeviceSchemaScope(),
 			},
 		},
-	}
+	}, device_identifiers.MobileDeviceScopeFields...)
 }
@@ -0,0 +1,161 @@
+// mobile_device_inventory/api.go
+// Description: This file contains the Jamf Pro API /v2/mobile-devices/detail response types and
+// requests, which the SDK does not provide.
+package mobile_device_inventory
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+const uriMobileDevicesDetail = "/api/v2/mobile-devices/detail"
+
+// inventorySections maps the section attributes of the data sources to the API section names.
+var inventorySections = map[string]string{
+	"general":              "GENERAL",
+	"hardware":             "HARDWARE",
+	"security":             "SECURITY",
+	"applications":         "APPLICATIONS",
+	"profiles":             "PROFILES",
+	"user_and_location":    "USER_AND_LOCATION",
+	"extension_attributes": "EXTENSION_ATTRIBUTES",
+}
+
+type mobileDeviceInventory struct {
+	MobileDeviceID      string                        `json:"mobileDeviceId"`
+	DeviceType          string                        `json:"deviceType"`
+	General             *inventoryGeneral             `json:"general"`
+	Hardware            *inventoryHardware            `json:"hardware"`
+	Security            *inventorySecurity            `json:"security"`
+	UserAndLocation     *inventoryUserAndLocation     `json:"userAndLocation"`
+	Applications        []inventoryApplication        `json:"applications"`
+	Profiles            []inventoryProfile            `json:"profiles"`
+	ExtensionAttributes []inventoryExtensionAttribute `json:"extensionAttributes"`
+}
+
+type inventoryGeneral struct {
+	UDID                               string `json:"udid"`
+	DisplayName                        string `json:"displayName"`
+	AssetTag                           string `json:"assetTag"`
+	SiteID                             string `json:"siteId"`
+	LastInventoryUpdateDate            string `json:"lastInventoryUpdateDate"`
+	OSVersion                          string `json:"osVersion"`
+	OSBuild                            string `json:"osBuild"`
+	OSRapidSecurityResponse            string `json:"osRapidSecurityResponse"`
+	IPAddress                          string `json:"ipAddress"`
+	Managed                            bool   `json:"managed"`
+	Supervised                         bool   `json:"supervised"`
+	DeviceOwnershipType                string `json:"deviceOwnershipType"`
+	LastEnrolledDate                   string `json:"lastEnrolledDate"`
+	MdmProfileExpirationDate           string `json:"mdmProfileExpirationDate"`
+	TimeZone                           string `json:"timeZone"`
+	DeclarativeDeviceManagementEnabled bool   `json:"declarativeDeviceManagementEnabled"`
+	SharedIpad                         bool   `json:"sharedIpad"`
+	LastCloudBackupDate                string `json:"lastCloudBackupDate"`
+	ManagementID                       string `json:"managementId"`
+}
+
+type inventoryHardware struct {
+	CapacityMb           int    `json:"capacityMb"`
+	AvailableSpaceMb     int    `json:"availableSpaceMb"`
+	UsedSpacePercentage  int    `json:"usedSpacePercentage"`
+	BatteryLevel         int    `json:"batteryLevel"`
+	SerialNumber         string `json:"serialNumber"`
+	WifiMacAddress       string `json:"wifiMacAddress"`
+	BluetoothMacAddress  string `json:"bluetoothMacAddress"`
+	ModemFirmwareVersion string `json:"modemFirmwareVersion"`
+	Model                string `json:"model"`
+	ModelIdentifier      string `json:"modelIdentifier"`
+	ModelNumber          string `json:"modelNumber"`
+	DeviceID             string `json:"deviceId"`
+}
+
+type inventorySecurity struct {
+	DataProtected                bool `json:"dataProtected"`
+	BlockLevelEncryptionCapable  bool `json:"blockLevelEncryptionCapable"`
+	FileLevelEncryptionCapable   bool `json:"fileLevelEncryptionCapable"`
+	PasscodePresent              bool `json:"passcodePresent"`
+	PasscodeCompliant            bool `json:"passcodeCompliant"`
+	PasscodeCompliantWithProfile bool `json:"passcodeCompliantWithProfile"`
+	HardwareEncryption           int  `json:"hardwareEncryption"`
+	ActivationLockEnabled        bool `json:"activationLockEnabled"`
+	JailBreakDetected            bool `json:"jailBreakDetected"`
+	LostModeEnabled              bool `json:"lostModeEnabled"`
+}
+
+type inventoryUserAndLocation struct {
+	Username     string `json:"username"`
+	RealName     string `json:"realName"`
+	EmailAddress string `json:"emailAddress"`
+	Position     string `json:"position"`
+	PhoneNumber  string `json:"phoneNumber"`
+	DepartmentID string `json:"departmentId"`
+	BuildingID   string `json:"buildingId"`
+	Room         string `json:"room"`
+}
+
+type inventoryApplication struct {
+	Identifier       string `json:"identifier"`
+	Name             string `json:"name"`
+	Version          string `json:"version"`
+	ShortVersion     string `json:"shortVersion"`
+	ManagementStatus string `json:"managementStatus"`
+	ValidationStatus bool   `json:"validationStatus"`
+	BundleSize       string `json:"bundleSize"`
+	DynamicSize      string `json:"dynamicSize"`
+}
+
+type inventoryProfile struct {
+	DisplayName   string `json:"displayName"`
+	Version       string `json:"version"`
+	UUID          string `json:"uuid"`
+	Identifier    string `json:"identifier"`
+	Removable     bool   `json:"removable"`
+	LastInstalled string `json:"lastInstalled"`
+}
+
+type inventoryExtensionAttribute struct {
+	ID               string   `json:"id"`
+	Name             string   `json:"name"`
+	Type             string   `json:"type"`
+	Value            []string `json:"value"`
+	InventoryDisplay string   `json:"inventoryDisplay"`
+}
+
+// getMobileDevicesInventory returns every mobile device matching the RSQL filter, with the given
+// API sections populated.
+func getMobileDevicesInventory(client *jamfpro.Client, filter, sort string, sections []string) ([]mobileDeviceInventory, error) {
+	params := url.Values{}
+	for _, section := range sections {
+		params.Add("section", section)
+	}
+	if filter != "" {
+		params.Set("filter", filter)
+	}
+	if sort != "" {
+		params.Set("sort", sort)
+	}
+
+	resp, err := client.DoPaginatedGet(uriMobileDevicesDetail, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch mobile device inventory: %v", err)
+	}
+
+	devices := make([]mobileDeviceInventory, 0, len(resp.Results))
+	for _, result := range resp.Results {
+		raw, err := json.Marshal(result)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode mobile device inventory: %v", err)
+		}
+		var device mobileDeviceInventory
+		if err := json.Unmarshal(raw, &device); err != nil {
+			return nil, fmt.Errorf("failed to decode mobile device inventory: %v", err)
+		}
+		devices = append(devices, device)
+	}
+
+	return devices, nil
+}
@@ -0,0 +1,126 @@
+package mobile_device_inventory
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// lookupFilters maps the lookup attributes of the single device data source to RSQL fields.
+var lookupFilters = map[string]string{
+	"id":            "mobileDeviceId",
+	"serial_number": "hardware.serialNumber",
+	"udid":          "general.udid",
+	"name":          "general.displayName",
+}
+
+// dataSourceRead fetches the inventory of a single mobile device by its ID, serial number, UDID or
+// name. Lookups by name fail when more than one device has that name.
+func dataSourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+
+	var key, value string
+	for k := range lookupFilters {
+		if v, ok := d.GetOk(k); ok {
+			key, value = k, v.(string)
+			break
+		}
+	}
+	if key == "" {
+		return diag.FromErr(fmt.Errorf("one of 'id', 'serial_number', 'udid' or 'name' must be provided"))
+	}
+	filter := fmt.Sprintf("%s==%s", lookupFilters[key], strconv.Quote(value))
+
+	var devices []mobileDeviceInventory
+	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
+		var apiErr error
+		devices, apiErr = getMobileDevicesInventory(client, filter, "", allSections())
+		if apiErr != nil {
+			return retry.RetryableError(apiErr)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read Jamf Pro Mobile Device Inventory with %s '%s' after retries: %v", key, value, err))
+	}
+
+	switch len(devices) {
+	case 0:
+		d.SetId("")
+		return diag.FromErr(fmt.Errorf("no Jamf Pro Mobile Device found with %s '%s'", key, value))
+	case 1:
+	default:
+		return diag.FromErr(fmt.Errorf("%d Jamf Pro Mobile Devices found with %s '%s', use 'id', 'serial_number' or 'udid' instead", len(devices), key, value))
+	}
+
+	d.SetId(devices[0].MobileDeviceID)
+	for k, v := range flattenDevice(devices[0]) {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+// dataSourceReadList fetches the inventory of every mobile device matching the configured filter.
+func dataSourceReadList(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+
+	filter := d.Get("filter").(string)
+	sort := d.Get("sort").(string)
+
+	sections := allSections()
+	if v, ok := d.GetOk("sections"); ok {
+		sections = nil
+		for _, name := range v.(*schema.Set).List() {
+			sections = append(sections, inventorySections[name.(string)])
+		}
+		slices.Sort(sections)
+	}
+
+	var devices []mobileDeviceInventory
+	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
+		var apiErr error
+		devices, apiErr = getMobileDevicesInventory(client, filter, sort, sections)
+		if apiErr != nil {
+			return retry.RetryableError(apiErr)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read Jamf Pro Mobile Device Inventory with filter '%s' after retries: %v", filter, err))
+	}
+
+	list := make([]any, 0, len(devices))
+	for _, device := range devices {
+		list = append(list, flattenDevice(device))
+	}
+
+	d.SetId(fmt.Sprintf("%x", sha256.Sum256([]byte(filter+"|"+sort+"|"+strings.Join(sections, ","))))[:16])
+	if err := d.Set("total_count", len(devices)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("mobile_devices", list); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func allSections() []string {
+	sections := make([]string, 0, len(inventorySections))
+	for _, section := range inventorySections {
+		sections = append(sections, section)
+	}
+	slices.Sort(sections)
+	return sections
+}
@@ -0,0 +1,268 @@
+package mobile_device_inventory
+
+import (
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// DataSourceJamfProMobileDeviceInventory provides the inventory of a single mobile device, found by
+// its ID, serial number, UDID or name.
+func DataSourceJamfProMobileDeviceInventory() *schema.Resource {
+	lookup := []string{"id", "serial_number", "udid", "name"}
+
+	s := deviceSchema()
+	for _, key := range lookup {
+		s[key].Optional = true
+		s[key].ExactlyOneOf = lookup
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Description: "Provides the inventory of a single mobile device from the Jamf Pro API.",
+		Schema:      s,
+	}
+}
+
+// DataSourceJamfProMobileDeviceInventories provides the inventory of the mobile devices matching an
+// RSQL filter, limited to the requested sections.
+func DataSourceJamfProMobileDeviceInventories() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceReadList,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Description: "Provides the inventory of the mobile devices matching an RSQL filter from the Jamf Pro API.",
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "RSQL filter applied to the inventory, e.g. 'hardware.model==\"iPad Pro\"' or " +
+					"'general.supervised==true'. All mobile devices are returned when unset.",
+			},
+			"sort": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "mobileDeviceId:asc",
+				Description: "Sort order of the results, e.g. 'general.displayName:asc'.",
+			},
+			"sections": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Inventory sections to populate. All sections are populated when unset.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(sectionNames(), false),
+				},
+			},
+			"total_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of mobile devices matching the filter.",
+			},
+			"mobile_devices": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The mobile devices matching the filter.",
+				Elem:        &schema.Resource{Schema: deviceSchema()},
+			},
+		},
+	}
+}
+
+func sectionNames() []string {
+	names := make([]string, 0, len(inventorySections))
+	for name := range inventorySections {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// deviceSchema returns the computed attributes of a mobile device inventory record.
+func deviceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The Jamf Pro ID of the mobile device.",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The display name of the mobile device.",
+		},
+		"serial_number": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The serial number of the mobile device.",
+		},
+		"udid": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The UDID of the mobile device.",
+		},
+		"device_type": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The device type, e.g. 'iOS' or 'tvOS'.",
+		},
+		"general": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: computedAttributes(map[string]schema.ValueType{
+					"udid":                                  schema.TypeString,
+					"display_name":                          schema.TypeString,
+					"asset_tag":                             schema.TypeString,
+					"site_id":                               schema.TypeString,
+					"last_inventory_update_date":            schema.TypeString,
+					"os_version":                            schema.TypeString,
+					"os_build":                              schema.TypeString,
+					"os_rapid_security_response":            schema.TypeString,
+					"ip_address":                            schema.TypeString,
+					"managed":                               schema.TypeBool,
+					"supervised":                            schema.TypeBool,
+					"device_ownership_type":                 schema.TypeString,
+					"last_enrolled_date":                    schema.TypeString,
+					"mdm_profile_expiration_date":           schema.TypeString,
+					"time_zone":                             schema.TypeString,
+					"declarative_device_management_enabled": schema.TypeBool,
+					"shared_ipad":                           schema.TypeBool,
+					"last_cloud_backup_date":                schema.TypeString,
+					"management_id":                         schema.TypeString,
+				}),
+			},
+		},
+		"hardware": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: computedAttributes(map[string]schema.ValueType{
+					"capacity_mb":            schema.TypeInt,
+					"available_space_mb":     schema.TypeInt,
+					"used_space_percentage":  schema.TypeInt,
+					"battery_level":          schema.TypeInt,
+					"serial_number":          schema.TypeString,
+					"wifi_mac_address":       schema.TypeString,
+					"bluetooth_mac_address":  schema.TypeString,
+					"modem_firmware_version": schema.TypeString,
+					"model":                  schema.TypeString,
+					"model_identifier":       schema.TypeString,
+					"model_number":           schema.TypeString,
+					"device_id":              schema.TypeString,
+				}),
+			},
+		},
+		"security": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: computedAttributes(map[string]schema.ValueType{
+					"data_protected":                  schema.TypeBool,
+					"block_level_encryption_capable":  schema.TypeBool,
+					"file_level_encryption_capable":   schema.TypeBool,
+					"passcode_present":                schema.TypeBool,
+					"passcode_compliant":              schema.TypeBool,
+					"passcode_compliant_with_profile": schema.TypeBool,
+					"hardware_encryption":             schema.TypeInt,
+					"activation_lock_enabled":         schema.TypeBool,
+					"jail_break_detected":             schema.TypeBool,
+					"lost_mode_enabled":               schema.TypeBool,
+				}),
+			},
+		},
+		"user_and_location": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: computedAttributes(map[string]schema.ValueType{
+					"username":      schema.TypeString,
+					"real_name":     schema.TypeString,
+					"email_address": schema.TypeString,
+					"position":      schema.TypeString,
+					"phone_number":  schema.TypeString,
+					"department_id": schema.TypeString,
+					"building_id":   schema.TypeString,
+					"room":          schema.TypeString,
+				}),
+			},
+		},
+		"applications": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: computedAttributes(map[string]schema.ValueType{
+					"identifier":        schema.TypeString,
+					"name":              schema.TypeString,
+					"version":           schema.TypeString,
+					"short_version":     schema.TypeString,
+					"management_status": schema.TypeString,
+					"validation_status": schema.TypeBool,
+					"bundle_size":       schema.TypeString,
+					"dynamic_size":      schema.TypeString,
+				}),
+			},
+		},
+		"profiles": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: computedAttributes(map[string]schema.ValueType{
+					"display_name":   schema.TypeString,
+					"version":        schema.TypeString,
+					"uuid":           schema.TypeString,
+					"identifier":     schema.TypeString,
+					"removable":      schema.TypeBool,
+					"last_installed": schema.TypeString,
+				}),
+			},
+		},
+		"extension_attributes": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"name": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"type": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"values": {
+						Type:     schema.TypeList,
+						Computed: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+					"inventory_display": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// computedAttributes returns a computed attribute of the given type for each key.
+func computedAttributes(attributes map[string]schema.ValueType) map[string]*schema.Schema {
+	out := make(map[string]*schema.Schema, len(attributes))
+	for key, valueType := range attributes {
+		out[key] = &schema.Schema{
+			Type:     valueType,
+			Computed: true,
+		}
+	}
+	return out
+}
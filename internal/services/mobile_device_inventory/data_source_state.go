@@ -0,0 +1,137 @@
+package mobile_device_inventory
+
+// flattenDevice converts a mobile device inventory record into the attributes of deviceSchema.
+// Sections that were not requested are left empty.
+func flattenDevice(device mobileDeviceInventory) map[string]any {
+	out := map[string]any{
+		"id":                   device.MobileDeviceID,
+		"device_type":          device.DeviceType,
+		"general":              []any{},
+		"hardware":             []any{},
+		"security":             []any{},
+		"user_and_location":    []any{},
+		"applications":         flattenApplications(device.Applications),
+		"profiles":             flattenProfiles(device.Profiles),
+		"extension_attributes": flattenExtensionAttributes(device.ExtensionAttributes),
+	}
+
+	if g := device.General; g != nil {
+		out["name"] = g.DisplayName
+		out["udid"] = g.UDID
+		out["general"] = []any{map[string]any{
+			"udid":                                  g.UDID,
+			"display_name":                          g.DisplayName,
+			"asset_tag":                             g.AssetTag,
+			"site_id":                               g.SiteID,
+			"last_inventory_update_date":            g.LastInventoryUpdateDate,
+			"os_version":                            g.OSVersion,
+			"os_build":                              g.OSBuild,
+			"os_rapid_security_response":            g.OSRapidSecurityResponse,
+			"ip_address":                            g.IPAddress,
+			"managed":                               g.Managed,
+			"supervised":                            g.Supervised,
+			"device_ownership_type":                 g.DeviceOwnershipType,
+			"last_enrolled_date":                    g.LastEnrolledDate,
+			"mdm_profile_expiration_date":           g.MdmProfileExpirationDate,
+			"time_zone":                             g.TimeZone,
+			"declarative_device_management_enabled": g.DeclarativeDeviceManagementEnabled,
+			"shared_ipad":                           g.SharedIpad,
+			"last_cloud_backup_date":                g.LastCloudBackupDate,
+			"management_id":                         g.ManagementID,
+		}}
+	}
+
+	if h := device.Hardware; h != nil {
+		out["serial_number"] = h.SerialNumber
+		out["hardware"] = []any{map[string]any{
+			"capacity_mb":            h.CapacityMb,
+			"available_space_mb":     h.AvailableSpaceMb,
+			"used_space_percentage":  h.UsedSpacePercentage,
+			"battery_level":          h.BatteryLevel,
+			"serial_number":          h.SerialNumber,
+			"wifi_mac_address":       h.WifiMacAddress,
+			"bluetooth_mac_address":  h.BluetoothMacAddress,
+			"modem_firmware_version": h.ModemFirmwareVersion,
+			"model":                  h.Model,
+			"model_identifier":       h.ModelIdentifier,
+			"model_number":           h.ModelNumber,
+			"device_id":              h.DeviceID,
+		}}
+	}
+
+	if s := device.Security; s != nil {
+		out["security"] = []any{map[string]any{
+			"data_protected":                  s.DataProtected,
+			"block_level_encryption_capable":  s.BlockLevelEncryptionCapable,
+			"file_level_encryption_capable":   s.FileLevelEncryptionCapable,
+			"passcode_present":                s.PasscodePresent,
+			"passcode_compliant":              s.PasscodeCompliant,
+			"passcode_compliant_with_profile": s.PasscodeCompliantWithProfile,
+			"hardware_encryption":             s.HardwareEncryption,
+			"activation_lock_enabled":         s.ActivationLockEnabled,
+			"jail_break_detected":             s.JailBreakDetected,
+			"lost_mode_enabled":               s.LostModeEnabled,
+		}}
+	}
+
+	if u := device.UserAndLocation; u != nil {
+		out["user_and_location"] = []any{map[string]any{
+			"username":      u.Username,
+			"real_name":     u.RealName,
+			"email_address": u.EmailAddress,
+			"position":      u.Position,
+			"phone_number":  u.PhoneNumber,
+			"department_id": u.DepartmentID,
+			"building_id":   u.BuildingID,
+			"room":          u.Room,
+		}}
+	}
+
+	return out
+}
+
+func flattenApplications(applications []inventoryApplication) []any {
+	out := make([]any, 0, len(applications))
+	for _, app := range applications {
+		out = append(out, map[string]any{
+			"identifier":        app.Identifier,
+			"name":              app.Name,
+			"version":           app.Version,
+			"short_version":     app.ShortVersion,
+			"management_status": app.ManagementStatus,
+			"validation_status": app.ValidationStatus,
+			"bundle_size":       app.BundleSize,
+			"dynamic_size":      app.DynamicSize,
+		})
+	}
+	return out
+}
+
+func flattenProfiles(profiles []inventoryProfile) []any {
+	out := make([]any, 0, len(profiles))
+	for _, profile := range profiles {
+		out = append(out, map[string]any{
+			"display_name":   profile.DisplayName,
+			"version":        profile.Version,
+			"uuid":           profile.UUID,
+			"identifier":     profile.Identifier,
+			"removable":      profile.Removable,
+			"last_installed": profile.LastInstalled,
+		})
+	}
+	return out
+}
+
+func flattenExtensionAttributes(attributes []inventoryExtensionAttribute) []any {
+	out := make([]any, 0, len(attributes))
+	for _, ea := range attributes {
+		out = append(out, map[string]any{
+			"id":                ea.ID,
+			"name":              ea.Name,
+			"type":              ea.Type,
+			"values":            ea.Value,
+			"inventory_display": ea.InventoryDisplay,
+		})
+	}
+	return out
+}
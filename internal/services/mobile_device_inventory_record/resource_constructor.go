@@ -0,0 +1,99 @@
+package mobile_device_inventory_record
+
+import (
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/inventory_record"
+)
+
+// mobileDevicePatch is the body of PATCH /api/v2/mobile-devices/{id}. Only non nil fields are
+// sent, so every other inventory field is left untouched.
+type mobileDevicePatch struct {
+	AssetTag                   *string                   `json:"assetTag,omitempty"`
+	SiteID                     *string                   `json:"siteId,omitempty"`
+	Location                   *locationPatch            `json:"location,omitempty"`
+	UpdatedExtensionAttributes []extensionAttributePatch `json:"updatedExtensionAttributes,omitempty"`
+	IOS                        *iosPatch                 `json:"ios,omitempty"`
+}
+
+type locationPatch struct {
+	Username     *string `json:"username,omitempty"`
+	RealName     *string `json:"realName,omitempty"`
+	EmailAddress *string `json:"emailAddress,omitempty"`
+	Position     *string `json:"position,omitempty"`
+	PhoneNumber  *string `json:"phoneNumber,omitempty"`
+	DepartmentID *string `json:"departmentId,omitempty"`
+	BuildingID   *string `json:"buildingId,omitempty"`
+	Room         *string `json:"room,omitempty"`
+}
+
+type iosPatch struct {
+	Purchasing purchasingPatch `json:"purchasing"`
+}
+
+type purchasingPatch struct {
+	Purchased           *bool   `json:"purchased,omitempty"`
+	Leased              *bool   `json:"leased,omitempty"`
+	PoNumber            *string `json:"poNumber,omitempty"`
+	PoDate              *string `json:"poDate,omitempty"`
+	Vendor              *string `json:"vendor,omitempty"`
+	AppleCareID         *string `json:"appleCareId,omitempty"`
+	PurchasePrice       *string `json:"purchasePrice,omitempty"`
+	PurchasingAccount   *string `json:"purchasingAccount,omitempty"`
+	PurchasingContact   *string `json:"purchasingContact,omitempty"`
+	WarrantyExpiresDate *string `json:"warrantyExpiresDate,omitempty"`
+	LeaseExpiresDate    *string `json:"leaseExpiresDate,omitempty"`
+	LifeExpectancy      *int    `json:"lifeExpectancy,omitempty"`
+}
+
+type extensionAttributePatch struct {
+	ID    string   `json:"id"`
+	Value []string `json:"value"`
+}
+
+// construct builds the mobile device PATCH body from the changed fields.
+func construct(patch inventory_record.Patch) *mobileDevicePatch {
+	out := &mobileDevicePatch{
+		AssetTag: patch.AssetTag,
+		SiteID:   patch.SiteID,
+	}
+
+	if patch.UserAndLocation != nil || patch.BuildingID != nil || patch.DepartmentID != nil {
+		out.Location = &locationPatch{
+			BuildingID:   patch.BuildingID,
+			DepartmentID: patch.DepartmentID,
+		}
+		if u := patch.UserAndLocation; u != nil {
+			out.Location.Username = u.Username
+			out.Location.RealName = u.RealName
+			out.Location.EmailAddress = u.EmailAddress
+			out.Location.Position = u.Position
+			out.Location.PhoneNumber = u.PhoneNumber
+			out.Location.Room = u.Room
+		}
+	}
+
+	if p := patch.Purchasing; p != nil {
+		out.IOS = &iosPatch{Purchasing: purchasingPatch{
+			Purchased:           p.Purchased,
+			Leased:              p.Leased,
+			PoNumber:            p.PoNumber,
+			PoDate:              p.PoDate,
+			Vendor:              p.Vendor,
+			AppleCareID:         p.AppleCareID,
+			PurchasePrice:       p.PurchasePrice,
+			PurchasingAccount:   p.PurchasingAccount,
+			PurchasingContact:   p.PurchasingContact,
+			WarrantyExpiresDate: p.WarrantyDate,
+			LeaseExpiresDate:    p.LeaseDate,
+			LifeExpectancy:      p.LifeExpectancy,
+		}}
+	}
+
+	for _, ea := range patch.ExtensionAttributes {
+		out.UpdatedExtensionAttributes = append(out.UpdatedExtensionAttributes, extensionAttributePatch{
+			ID:    ea.ID,
+			Value: ea.Values,
+		})
+	}
+
+	return out
+}
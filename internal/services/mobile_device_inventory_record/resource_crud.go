@@ -0,0 +1,173 @@
+package mobile_device_inventory_record
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/device_identifiers"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/inventory_record"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	uriMobileDevices       = "/api/v2/mobile-devices"
+	uriMobileDevicesDetail = "/api/v2/mobile-devices/detail"
+)
+
+// create looks up the mobile device by serial number and applies the configured inventory fields.
+func create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+	serialNumber := d.Get("serial_number").(string)
+
+	id, err := lookupMobileDeviceID(client, serialNumber)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(id)
+
+	if diags := apply(ctx, d, client, schema.TimeoutCreate); diags.HasError() {
+		d.SetId("")
+		return diags
+	}
+
+	return read(ctx, d, meta, false)
+}
+
+// read reads the inventory record of the mobile device. A mobile device deleted from Jamf Pro is
+// dropped from state.
+func read(ctx context.Context, d *schema.ResourceData, meta any, cleanup bool) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+
+	var detail *mobileDeviceDetail
+	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
+		var apiErr error
+		detail, apiErr = getMobileDeviceDetail(client, d.Id())
+		if apiErr != nil {
+			return retry.RetryableError(apiErr)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read Jamf Pro Mobile Device Inventory Record for mobile device ID %s: %v", d.Id(), err))
+	}
+
+	if detail == nil {
+		if !cleanup {
+			return diag.FromErr(fmt.Errorf("mobile device ID %s was not found in Jamf Pro", d.Id()))
+		}
+		log.Printf("[WARN] Mobile device ID %s no longer exists in Jamf Pro, removing its inventory record from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := inventory_record.SetState(d, recordFromDetail(detail)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// readWithCleanup reads the resource with cleanup enabled
+func readWithCleanup(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return read(ctx, d, meta, true)
+}
+
+// update applies the changed inventory fields.
+func update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	if diags := apply(ctx, d, meta.(*jamfpro.Client), schema.TimeoutUpdate); diags.HasError() {
+		return diags
+	}
+
+	return read(ctx, d, meta, false)
+}
+
+// delete stops managing the inventory fields. The mobile device and its inventory are left as they are.
+func delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	log.Printf("[INFO] Removing Jamf Pro Mobile Device Inventory Record for mobile device ID %s from state; the mobile device is not modified", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// importState accepts the serial number of the mobile device.
+func importState(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	serialNumber := d.Id()
+
+	id, err := lookupMobileDeviceID(meta.(*jamfpro.Client), serialNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(id)
+	if err := d.Set("serial_number", serialNumber); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// apply sends the changed inventory fields to Jamf Pro.
+func apply(ctx context.Context, d *schema.ResourceData, client *jamfpro.Client, timeout string) diag.Diagnostics {
+	patch := inventory_record.ExpandPatch(d)
+	if patch.IsEmpty() {
+		return nil
+	}
+
+	payload := construct(patch)
+	endpoint := fmt.Sprintf("%s/%s", uriMobileDevices, d.Id())
+
+	err := retry.RetryContext(ctx, d.Timeout(timeout), func() *retry.RetryError {
+		resp, apiErr := client.HTTP.DoRequest("PATCH", endpoint, payload, nil)
+		if resp != nil && resp.Body != nil {
+			defer resp.Body.Close()
+		}
+		if apiErr != nil {
+			return retry.RetryableError(apiErr)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to update Jamf Pro Mobile Device Inventory Record for mobile device ID %s: %v", d.Id(), err))
+	}
+
+	return nil
+}
+
+func lookupMobileDeviceID(client *jamfpro.Client, serialNumber string) (string, error) {
+	ids, err := device_identifiers.ResolveIDs(client, device_identifiers.MobileDevices, []string{serialNumber}, nil)
+	if err != nil {
+		return "", err
+	}
+	return strconv.Itoa(ids[0]), nil
+}
+
+// getMobileDeviceDetail returns the inventory of a mobile device, or nil when it does not exist.
+func getMobileDeviceDetail(client *jamfpro.Client, id string) (*mobileDeviceDetail, error) {
+	params := url.Values{}
+	for _, section := range []string{"GENERAL", "HARDWARE", "USER_AND_LOCATION", "PURCHASING", "EXTENSION_ATTRIBUTES"} {
+		params.Add("section", section)
+	}
+	params.Set("filter", fmt.Sprintf("mobileDeviceId==%s", strconv.Quote(id)))
+
+	var out struct {
+		TotalCount int                  `json:"totalCount"`
+		Results    []mobileDeviceDetail `json:"results"`
+	}
+	resp, err := client.HTTP.DoRequest("GET", fmt.Sprintf("%s?%s", uriMobileDevicesDetail, params.Encode()), nil, &out)
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(out.Results) == 0 {
+		return nil, nil
+	}
+	return &out.Results[0], nil
+}
@@ -0,0 +1,32 @@
+package mobile_device_inventory_record
+
+import (
+	"time"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/inventory_record"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceJamfProMobileDeviceInventoryRecord defines the schema and CRUD operations for managing selected
+// inventory fields of an existing mobile device, found by its serial number.
+func ResourceJamfProMobileDeviceInventoryRecord() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: create,
+		ReadContext:   readWithCleanup,
+		UpdateContext: update,
+		DeleteContext: delete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(70 * time.Second),
+			Read:   schema.DefaultTimeout(70 * time.Second),
+			Update: schema.DefaultTimeout(70 * time.Second),
+			Delete: schema.DefaultTimeout(70 * time.Second),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importState,
+		},
+		Description: "Manages the asset tag, site, building, department, user and location, purchasing and extension " +
+			"attribute values of an existing mobile device. Only the configured fields are changed. Destroying the resource " +
+			"stops managing the fields; it never removes the mobile device or clears its inventory. Import with the serial number.",
+		Schema: inventory_record.GetSchema("mobile device"),
+	}
+}
@@ -0,0 +1,95 @@
+package mobile_device_inventory_record
+
+import (
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/inventory_record"
+)
+
+// mobileDeviceDetail is the part of a /api/v2/mobile-devices/detail result read by this resource.
+type mobileDeviceDetail struct {
+	MobileDeviceID string `json:"mobileDeviceId"`
+	General        struct {
+		UDID        string `json:"udid"`
+		DisplayName string `json:"displayName"`
+		AssetTag    string `json:"assetTag"`
+		SiteID      string `json:"siteId"`
+	} `json:"general"`
+	Hardware struct {
+		SerialNumber string `json:"serialNumber"`
+	} `json:"hardware"`
+	UserAndLocation struct {
+		Username     string `json:"username"`
+		RealName     string `json:"realName"`
+		EmailAddress string `json:"emailAddress"`
+		Position     string `json:"position"`
+		PhoneNumber  string `json:"phoneNumber"`
+		DepartmentID string `json:"departmentId"`
+		BuildingID   string `json:"buildingId"`
+		Room         string `json:"room"`
+	} `json:"userAndLocation"`
+	Purchasing struct {
+		Purchased           bool   `json:"purchased"`
+		Leased              bool   `json:"leased"`
+		PoNumber            string `json:"poNumber"`
+		PoDate              string `json:"poDate"`
+		Vendor              string `json:"vendor"`
+		AppleCareID         string `json:"appleCareId"`
+		PurchasePrice       string `json:"purchasePrice"`
+		PurchasingAccount   string `json:"purchasingAccount"`
+		PurchasingContact   string `json:"purchasingContact"`
+		WarrantyExpiresDate string `json:"warrantyExpiresDate"`
+		LeaseExpiresDate    string `json:"leaseExpiresDate"`
+		LifeExpectancy      int    `json:"lifeExpectancy"`
+	} `json:"purchasing"`
+	ExtensionAttributes []struct {
+		ID    string   `json:"id"`
+		Value []string `json:"value"`
+	} `json:"extensionAttributes"`
+}
+
+// recordFromDetail extracts the managed fields from a mobile device inventory result.
+func recordFromDetail(detail *mobileDeviceDetail) inventory_record.Record {
+	user := detail.UserAndLocation
+	purchasing := detail.Purchasing
+
+	record := inventory_record.Record{
+		ID:           detail.MobileDeviceID,
+		SerialNumber: detail.Hardware.SerialNumber,
+		UDID:         detail.General.UDID,
+		Name:         detail.General.DisplayName,
+		AssetTag:     detail.General.AssetTag,
+		SiteID:       detail.General.SiteID,
+		BuildingID:   user.BuildingID,
+		DepartmentID: user.DepartmentID,
+		UserAndLocation: inventory_record.UserAndLocation{
+			Username:     user.Username,
+			RealName:     user.RealName,
+			EmailAddress: user.EmailAddress,
+			Position:     user.Position,
+			PhoneNumber:  user.PhoneNumber,
+			Room:         user.Room,
+		},
+		Purchasing: inventory_record.Purchasing{
+			Purchased:         purchasing.Purchased,
+			Leased:            purchasing.Leased,
+			PoNumber:          purchasing.PoNumber,
+			PoDate:            purchasing.PoDate,
+			Vendor:            purchasing.Vendor,
+			AppleCareID:       purchasing.AppleCareID,
+			PurchasePrice:     purchasing.PurchasePrice,
+			PurchasingAccount: purchasing.PurchasingAccount,
+			PurchasingContact: purchasing.PurchasingContact,
+			WarrantyDate:      purchasing.WarrantyExpiresDate,
+			LeaseDate:         purchasing.LeaseExpiresDate,
+			LifeExpectancy:    purchasing.LifeExpectancy,
+		},
+	}
+
+	for _, ea := range detail.ExtensionAttributes {
+		record.ExtensionAttributes = append(record.ExtensionAttributes, inventory_record.ExtensionAttribute{
+			ID:     ea.ID,
+			Values: ea.Value,
+		})
+	}
+
+	return record
+}
@@ -0,0 +1,34 @@
+package mobile_device_prestage_scope
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/prestage_scope"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// create assigns the configured serial numbers to the mobile device prestage
+func create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return prestage_scope.MobileDevices.Create(ctx, d, meta)
+}
+
+// readWithCleanup reads the mobile device prestage scope with cleanup enabled
+func readWithCleanup(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return prestage_scope.MobileDevices.Read(ctx, d, meta)
+}
+
+// update applies changed serial numbers to the mobile device prestage
+func update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return prestage_scope.MobileDevices.Update(ctx, d, meta)
+}
+
+// delete removes the managed serial numbers from the mobile device prestage
+func delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return prestage_scope.MobileDevices.Delete(ctx, d, meta)
+}
+
+// importState accepts the ID of the mobile device prestage
+func importState(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	return prestage_scope.MobileDevices.Import(ctx, d, meta)
+}
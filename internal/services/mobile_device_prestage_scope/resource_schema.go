@@ -0,0 +1,31 @@
+package mobile_device_prestage_scope
+
+import (
+	"time"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/prestage_scope"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceJamfProMobileDevicePrestageScope defines the schema and CRUD operations for assigning mobile devices to a
+// mobile device prestage enrollment by serial number.
+func ResourceJamfProMobileDevicePrestageScope() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: create,
+		ReadContext:   readWithCleanup,
+		UpdateContext: update,
+		DeleteContext: delete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(70 * time.Second),
+			Read:   schema.DefaultTimeout(70 * time.Second),
+			Update: schema.DefaultTimeout(70 * time.Second),
+			Delete: schema.DefaultTimeout(70 * time.Second),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importState,
+		},
+		Description: "Assigns mobile devices to a mobile device prestage enrollment by serial number. " +
+			"Only one authoritative scope resource should manage a prestage; any number of non-authoritative ones can.",
+		Schema: prestage_scope.GetSchema("mobile device"),
+	}
+}
@@ -5,13 +5,14 @@ import (
 	"encoding/xml"
 	"fmt"
 	"log"
+	"strconv"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // constructJamfProNetworkSegment constructs a ResourceNetworkSegment object from the provided schema data.
-func construct(d *schema.ResourceData) (*jamfpro.ResourceNetworkSegment, error) {
+func construct(d *schema.ResourceData, client *jamfpro.Client) (*jamfpro.ResourceNetworkSegment, error) {
 	resource := &jamfpro.ResourceNetworkSegment{
 		Name:                d.Get("name").(string),
 		StartingAddress:     d.Get("starting_address").(string),
@@ -26,6 +27,16 @@ func construct(d *schema.ResourceData) (*jamfpro.ResourceNetworkSegment, error)
 		OverrideDepartments: d.Get("override_departments").(bool),
 	}
 
+	// The Classic API references the software update server by name, so resolve a configured ID to its name
+	if raw := d.GetRawConfig(); !raw.IsNull() && !raw.GetAttr("swu_server_id").IsNull() {
+		swuServerID := strconv.Itoa(d.Get("swu_server_id").(int))
+		swuServer, err := client.GetSoftwareUpdateServerByID(swuServerID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve software update server with ID '%s' for Jamf Pro Network Segment '%s': %v", swuServerID, resource.Name, err)
+		}
+		resource.SWUServer = swuServer.Name
+	}
+
 	// Serialize and pretty-print the Network Segment object as XML for logging
 	resourceXML, err := xml.MarshalIndent(resource, "", "  ")
 	if err != nil {
@@ -2,6 +2,8 @@ package network_segment
 
 import (
 	"context"
+	"encoding/xml"
+	"fmt"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
 	crud "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/sdkv2_crud"
@@ -16,7 +18,9 @@ func create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnost
 		ctx,
 		d,
 		meta,
-		construct,
+		func(d *schema.ResourceData) (*jamfpro.ResourceNetworkSegment, error) {
+			return construct(d, meta.(*jamfpro.Client))
+		},
 		meta.(*jamfpro.Client).CreateNetworkSegment,
 		readNoCleanup,
 	)
@@ -30,7 +34,9 @@ func read(ctx context.Context, d *schema.ResourceData, meta any, cleanup bool) d
 		meta,
 		cleanup,
 		meta.(*jamfpro.Client).GetNetworkSegmentByID,
-		updateState,
+		func(d *schema.ResourceData, resp *jamfpro.ResourceNetworkSegment) diag.Diagnostics {
+			return updateState(d, resp, meta.(*jamfpro.Client))
+		},
 	)
 }
 
@@ -50,12 +56,41 @@ func update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnost
 		ctx,
 		d,
 		meta,
-		construct,
-		meta.(*jamfpro.Client).UpdateNetworkSegmentByID,
+		func(d *schema.ResourceData) (*jamfpro.ResourceNetworkSegment, error) {
+			return construct(d, meta.(*jamfpro.Client))
+		},
+		func(id string, segment *jamfpro.ResourceNetworkSegment) (*jamfpro.ResponseNetworkSegmentCreatedAndUpdated, error) {
+			return updateByID(meta.(*jamfpro.Client), id, segment)
+		},
 		readNoCleanup,
 	)
 }
 
+// updateByID updates a network segment through the Classic API. The SDK omits an empty
+// 'swu_server', which leaves the existing software update server in place, so the update always
+// sends the element and an empty one clears the server.
+func updateByID(client *jamfpro.Client, id string, segment *jamfpro.ResourceNetworkSegment) (*jamfpro.ResponseNetworkSegmentCreatedAndUpdated, error) {
+	requestBody := struct {
+		XMLName xml.Name `xml:"network_segment"`
+		*jamfpro.ResourceNetworkSegment
+		SWUServer string `xml:"swu_server"`
+	}{
+		ResourceNetworkSegment: segment,
+		SWUServer:              segment.SWUServer,
+	}
+
+	var out jamfpro.ResponseNetworkSegmentCreatedAndUpdated
+	resp, err := client.HTTP.DoRequest("PUT", fmt.Sprintf("/JSSResource/networksegments/id/%s", id), &requestBody, &out)
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update Jamf Pro Network Segment with ID '%s': %v", id, err)
+	}
+
+	return &out, nil
+}
+
 // delete is responsible for deleting a Jamf Pro network segment.
 func delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	return crud.Delete(
@@ -0,0 +1,44 @@
+package network_segment
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// mainCustomDiffFunc orchestrates all custom diff validations for network segments.
+func mainCustomDiffFunc(ctx context.Context, diff *schema.ResourceDiff, meta any) error {
+	return diffSoftwareUpdateServer(ctx, diff, meta)
+}
+
+// diffSoftwareUpdateServer plans the removal of the software update server when neither
+// 'swu_server' nor 'swu_server_id' is configured. Both attributes are computed from each other,
+// so without this the values held in state would be kept and the server could never be removed.
+// When only one of them changes, the other is marked as known after apply.
+func diffSoftwareUpdateServer(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+	raw := diff.GetRawConfig()
+	if raw.IsNull() {
+		return nil
+	}
+
+	name, id := raw.GetAttr("swu_server"), raw.GetAttr("swu_server_id")
+	switch {
+	case name.IsNull() && id.IsNull():
+		if diff.Get("swu_server").(string) != "" {
+			if err := diff.SetNew("swu_server", ""); err != nil {
+				return err
+			}
+		}
+		if diff.Get("swu_server_id").(int) != 0 {
+			if err := diff.SetNew("swu_server_id", 0); err != nil {
+				return err
+			}
+		}
+	case !id.IsNull() && diff.HasChange("swu_server_id"):
+		return diff.SetNewComputed("swu_server")
+	case !name.IsNull() && diff.HasChange("swu_server"):
+		return diff.SetNewComputed("swu_server_id")
+	}
+
+	return nil
+}
@@ -20,6 +20,7 @@ func ResourceJamfProNetworkSegments() *schema.Resource {
 			Update: schema.DefaultTimeout(70 * time.Second),
 			Delete: schema.DefaultTimeout(70 * time.Second),
 		},
+		CustomizeDiff: mainCustomDiffFunc,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -60,9 +61,18 @@ func ResourceJamfProNetworkSegments() *schema.Resource {
 				Description: "The URL associated with the network segment.",
 			},
 			"swu_server": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "The software update server associated with the network segment.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"swu_server_id"},
+				Description:   "The name of the software update server associated with the network segment. Prefer 'swu_server_id'. Remove both attributes to clear the server.",
+			},
+			"swu_server_id": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"swu_server"},
+				Description:   "The ID of the software update server associated with the network segment, e.g. jamfpro_software_update_server.example.id.",
 			},
 			"building": {
 				Type:        schema.TypeString,
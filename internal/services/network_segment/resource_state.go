@@ -2,6 +2,7 @@
 package network_segment
 
 import (
+	"log"
 	"strconv"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
@@ -10,7 +11,7 @@ import (
 )
 
 // updateState updates the Terraform state with the latest Network Segment information from the Jamf Pro API.
-func updateState(d *schema.ResourceData, resp *jamfpro.ResourceNetworkSegment) diag.Diagnostics {
+func updateState(d *schema.ResourceData, resp *jamfpro.ResourceNetworkSegment, client *jamfpro.Client) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	resourceData := map[string]any{
@@ -26,6 +27,16 @@ func updateState(d *schema.ResourceData, resp *jamfpro.ResourceNetworkSegment) d
 		"department":           resp.Department,
 		"override_buildings":   resp.OverrideBuildings,
 		"override_departments": resp.OverrideDepartments,
+		"swu_server_id":        0,
+	}
+
+	if resp.SWUServer != "" {
+		swuServer, err := client.GetSoftwareUpdateServerByName(resp.SWUServer)
+		if err != nil {
+			log.Printf("[WARN] Failed to resolve the ID of software update server '%s' for Jamf Pro Network Segment '%s': %v", resp.SWUServer, resp.Name, err)
+		} else {
+			resourceData["swu_server_id"] = swuServer.ID
+		}
 	}
 
 	for key, val := range resourceData {
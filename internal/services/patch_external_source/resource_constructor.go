@@ -0,0 +1,29 @@
+package patch_external_source
+
+import (
+	"encoding/xml"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// construct builds a ResourcePatchExternalSource object from the provided schema data.
+func construct(d *schema.ResourceData) (*jamfpro.ResourcePatchExternalSource, error) {
+	resource := &jamfpro.ResourcePatchExternalSource{
+		Name:       d.Get("name").(string),
+		HostName:   d.Get("host_name").(string),
+		Port:       d.Get("port").(int),
+		SSLEnabled: d.Get("ssl_enabled").(bool),
+	}
+
+	resourceXML, err := xml.MarshalIndent(resource, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Jamf Pro Patch External Source '%s' to XML: %v", resource.Name, err)
+	}
+
+	log.Printf("[DEBUG] Constructed Jamf Pro Patch External Source XML:\n%s\n", string(resourceXML))
+
+	return resource, nil
+}
@@ -0,0 +1,98 @@
+package patch_external_source
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	crud "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/sdkv2_crud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const uriPatchExternalSources = "/JSSResource/patchexternalsources"
+
+// create creates and states a jamfpro patch external source
+func create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return crud.Create(
+		ctx,
+		d,
+		meta,
+		construct,
+		meta.(*jamfpro.Client).CreateExternalPatchSource,
+		readNoCleanup,
+	)
+}
+
+// read reads and states a jamfpro patch external source
+func read(ctx context.Context, d *schema.ResourceData, meta any, cleanup bool) diag.Diagnostics {
+	return crud.Read(
+		ctx,
+		d,
+		meta,
+		cleanup,
+		meta.(*jamfpro.Client).GetPatchExternalSourceByID,
+		updateState,
+	)
+}
+
+// readWithCleanup reads a resources and states with cleanup
+func readWithCleanup(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return read(ctx, d, meta, true)
+}
+
+// readNoCleanup reads a resource without cleanup
+func readNoCleanup(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return read(ctx, d, meta, false)
+}
+
+// update updates a jamfpro patch external source
+func update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+	return crud.Update(
+		ctx,
+		d,
+		meta,
+		construct,
+		func(id string, source *jamfpro.ResourcePatchExternalSource) (*jamfpro.ResourcePatchExternalSource, error) {
+			return updateByID(client, id, source)
+		},
+		readNoCleanup,
+	)
+}
+
+// delete deletes a jamfpro patch external source
+func delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return crud.Delete(
+		ctx,
+		d,
+		meta,
+		meta.(*jamfpro.Client).DeleteExternalPatchSourceByID,
+	)
+}
+
+// updateByID updates a patch external source in place. The SDK update posts to ID 0, which creates
+// a new source instead of updating the existing one.
+func updateByID(client *jamfpro.Client, id string, source *jamfpro.ResourcePatchExternalSource) (*jamfpro.ResourcePatchExternalSource, error) {
+	endpoint := fmt.Sprintf("%s/id/%s", uriPatchExternalSources, id)
+
+	requestBody := struct {
+		XMLName xml.Name `xml:"patch_external_source"`
+		*jamfpro.ResourcePatchExternalSource
+	}{
+		ResourcePatchExternalSource: source,
+	}
+
+	var out jamfpro.ResourcePatchExternalSource
+	resp, err := client.HTTP.DoRequest("PUT", endpoint, &requestBody, &out)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update patch external source ID %s: %v", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &out, nil
+}
@@ -0,0 +1,57 @@
+package patch_external_source
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceJamfProPatchExternalSources defines the schema and CRUD operations for managing patch external sources in Terraform.
+func ResourceJamfProPatchExternalSources() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: create,
+		ReadContext:   readWithCleanup,
+		UpdateContext: update,
+		DeleteContext: delete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(70 * time.Second),
+			Read:   schema.DefaultTimeout(70 * time.Second),
+			Update: schema.DefaultTimeout(70 * time.Second),
+			Delete: schema.DefaultTimeout(70 * time.Second),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the patch external source.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The display name of the patch external source.",
+			},
+			"host_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The host name of the patch server, e.g. 'patch.example.com'.",
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      443,
+				Description:  "The port the patch server listens on.",
+				ValidateFunc: validation.IsPortNumber,
+			},
+			"ssl_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether Jamf Pro connects to the patch server over SSL.",
+			},
+		},
+	}
+}
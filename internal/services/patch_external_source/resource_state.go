@@ -0,0 +1,27 @@
+package patch_external_source
+
+import (
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// updateState updates the Terraform state with the latest Patch External Source information from the Jamf Pro API.
+func updateState(d *schema.ResourceData, resp *jamfpro.ResourcePatchExternalSource) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	sourceData := map[string]any{
+		"name":        resp.Name,
+		"host_name":   resp.HostName,
+		"port":        resp.Port,
+		"ssl_enabled": resp.SSLEnabled,
+	}
+
+	for key, val := range sourceData {
+		if err := d.Set(key, val); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return diags
+}
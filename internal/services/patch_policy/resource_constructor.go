@@ -0,0 +1,213 @@
+package patch_policy
+
+import (
+	"encoding/xml"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/constructors"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// patchPolicy is the Classic API representation of a patch policy. The SDK request type only
+// supports scoping to all computers, so the policy scope type is used instead.
+type patchPolicy struct {
+	XMLName                      xml.Name                                                `xml:"patch_policy"`
+	ID                           int                                                     `xml:"id,omitempty"`
+	General                      patchPolicyGeneral                                      `xml:"general"`
+	Scope                        jamfpro.PolicySubsetScope                               `xml:"scope"`
+	UserInteraction              jamfpro.ResourcePatchPolicyCreateRequestUserInteraction `xml:"user_interaction"`
+	SoftwareTitleConfigurationID int                                                     `xml:"software_title_configuration_id,omitempty"`
+}
+
+// patchPolicyGeneral holds the general settings of a patch policy. Release date, minimum OS,
+// incremental updates, reboot and kill apps come from the software title definition and are only
+// read.
+type patchPolicyGeneral struct {
+	ID                 int                                               `xml:"id,omitempty"`
+	Name               string                                            `xml:"name"`
+	Enabled            bool                                              `xml:"enabled"`
+	TargetVersion      string                                            `xml:"target_version"`
+	ReleaseDate        string                                            `xml:"release_date,omitempty"`
+	IncrementalUpdates bool                                              `xml:"incremental_updates,omitempty"`
+	Reboot             bool                                              `xml:"reboot,omitempty"`
+	MinimumOS          string                                            `xml:"minimum_os,omitempty"`
+	KillApps           []jamfpro.ResourcePatchPolicyCreateRequestKillApp `xml:"kill_apps>kill_app,omitempty"`
+	DistributionMethod string                                            `xml:"distribution_method"`
+	AllowDowngrade     bool                                              `xml:"allow_downgrade"`
+	PatchUnknown       bool                                              `xml:"patch_unknown"`
+}
+
+// construct builds a patch policy object from the provided schema data.
+func construct(d *schema.ResourceData) (*patchPolicy, error) {
+	resource := &patchPolicy{
+		General: patchPolicyGeneral{
+			Name:               d.Get("name").(string),
+			Enabled:            d.Get("enabled").(bool),
+			TargetVersion:      d.Get("target_version").(string),
+			DistributionMethod: d.Get("distribution_method").(string),
+			AllowDowngrade:     d.Get("allow_downgrade").(bool),
+			PatchUnknown:       d.Get("patch_unknown").(bool),
+		},
+	}
+
+	constructUserInteraction(d, resource)
+
+	if err := constructScope(d, resource); err != nil {
+		return nil, fmt.Errorf("failed to construct scope: %v", err)
+	}
+
+	resourceXML, err := xml.MarshalIndent(resource, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Jamf Pro Patch Policy '%s' to XML: %v", resource.General.Name, err)
+	}
+
+	log.Printf("[DEBUG] Constructed Jamf Pro Patch Policy XML:\n%s\n", string(resourceXML))
+
+	return resource, nil
+}
+
+// constructUserInteraction pulls the user interaction settings from HCL. Without a block the schema
+// defaults are sent so the policy does not keep settings removed from configuration.
+func constructUserInteraction(d *schema.ResourceData, resource *patchPolicy) {
+	ui := map[string]any{}
+	for key, s := range userInteractionSchema().Schema {
+		ui[key] = s.Default
+	}
+	if v, ok := d.GetOk("user_interaction"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		ui = v.([]any)[0].(map[string]any)
+	}
+
+	str := func(key string) string {
+		s, _ := ui[key].(string)
+		return s
+	}
+
+	resource.UserInteraction = jamfpro.ResourcePatchPolicyCreateRequestUserInteraction{
+		InstallButtonText:      str("install_button_text"),
+		SelfServiceDescription: str("self_service_description"),
+		Notifications: jamfpro.ResourcePatchPolicyCreateRequestNotifications{
+			Enabled: ui["notification_enabled"].(bool),
+			Type:    str("notification_type"),
+			Subject: str("notification_subject"),
+			Message: str("notification_message"),
+			Reminders: jamfpro.ResourcePatchPolicyCreateRequestReminders{
+				Enabled:   ui["reminders_enabled"].(bool),
+				Frequency: ui["reminder_frequency"].(int),
+			},
+		},
+		Deadlines: jamfpro.ResourcePatchPolicyCreateRequestDeadlines{
+			Enabled: ui["deadline_enabled"].(bool),
+			Period:  ui["deadline_period"].(int),
+		},
+		GracePeriod: jamfpro.ResourcePatchPolicyCreateRequestGracePeriod{
+			Duration:            ui["grace_period_duration"].(int),
+			NotificationSubject: str("grace_period_notification_subject"),
+			Message:             str("grace_period_message"),
+		},
+	}
+}
+
+// constructScope pulls the scope from HCL and packages it into the patch policy.
+func constructScope(d *schema.ResourceData, resource *patchPolicy) error {
+	var err error
+
+	resource.Scope = jamfpro.PolicySubsetScope{
+		AllComputers:   d.Get("scope.0.all_computers").(bool),
+		AllJSSUsers:    d.Get("scope.0.all_jss_users").(bool),
+		Computers:      &[]jamfpro.PolicySubsetComputer{},
+		ComputerGroups: &[]jamfpro.PolicySubsetComputerGroup{},
+		JSSUsers:       &[]jamfpro.PolicySubsetJSSUser{},
+		JSSUserGroups:  &[]jamfpro.PolicySubsetJSSUserGroup{},
+		Buildings:      &[]jamfpro.PolicySubsetBuilding{},
+		Departments:    &[]jamfpro.PolicySubsetDepartment{},
+		Limitations: &jamfpro.PolicySubsetScopeLimitations{
+			Users:           &[]jamfpro.PolicySubsetUser{},
+			UserGroups:      &[]jamfpro.PolicySubsetUserGroup{},
+			NetworkSegments: &[]jamfpro.PolicySubsetNetworkSegment{},
+			IBeacons:        &[]jamfpro.PolicySubsetIBeacon{},
+		},
+		Exclusions: &jamfpro.PolicySubsetScopeExclusions{
+			Computers:       &[]jamfpro.PolicySubsetComputer{},
+			ComputerGroups:  &[]jamfpro.PolicySubsetComputerGroup{},
+			Users:           &[]jamfpro.PolicySubsetUser{},
+			UserGroups:      &[]jamfpro.PolicySubsetUserGroup{},
+			Buildings:       &[]jamfpro.PolicySubsetBuilding{},
+			Departments:     &[]jamfpro.PolicySubsetDepartment{},
+			NetworkSegments: &[]jamfpro.PolicySubsetNetworkSegment{},
+			JSSUsers:        &[]jamfpro.PolicySubsetJSSUser{},
+			JSSUserGroups:   &[]jamfpro.PolicySubsetJSSUserGroup{},
+			IBeacons:        &[]jamfpro.PolicySubsetIBeacon{},
+		},
+	}
+	scope := &resource.Scope
+
+	// Targets
+	if err = constructors.MapSetToStructs[jamfpro.PolicySubsetComputer, int]("scope.0.computer_ids", "ID", d, scope.Computers); err != nil {
+		return err
+	}
+	if err = constructors.MapSetToStructs[jamfpro.PolicySubsetComputerGroup, int]("scope.0.computer_group_ids", "ID", d, scope.ComputerGroups); err != nil {
+		return err
+	}
+	if err = constructors.MapSetToStructs[jamfpro.PolicySubsetJSSUser, int]("scope.0.jss_user_ids", "ID", d, scope.JSSUsers); err != nil {
+		return err
+	}
+	if err = constructors.MapSetToStructs[jamfpro.PolicySubsetJSSUserGroup, int]("scope.0.jss_user_group_ids", "ID", d, scope.JSSUserGroups); err != nil {
+		return err
+	}
+	if err = constructors.MapSetToStructs[jamfpro.PolicySubsetBuilding, int]("scope.0.building_ids", "ID", d, scope.Buildings); err != nil {
+		return err
+	}
+	if err = constructors.MapSetToStructs[jamfpro.PolicySubsetDepartment, int]("scope.0.department_ids", "ID", d, scope.Departments); err != nil {
+		return err
+	}
+
+	// Limitations
+	if err = constructors.MapSetToStructs[jamfpro.PolicySubsetNetworkSegment, int]("scope.0.limitations.0.network_segment_ids", "ID", d, scope.Limitations.NetworkSegments); err != nil {
+		return err
+	}
+	if err = constructors.MapSetToStructs[jamfpro.PolicySubsetUser, string]("scope.0.limitations.0.directory_service_or_local_usernames", "Name", d, scope.Limitations.Users); err != nil {
+		return err
+	}
+	if err = constructors.MapSetToStructs[jamfpro.PolicySubsetUserGroup, int]("scope.0.limitations.0.directory_service_usergroup_ids", "ID", d, scope.Limitations.UserGroups); err != nil {
+		return err
+	}
+	if err = constructors.MapSetToStructs[jamfpro.PolicySubsetIBeacon, int]("scope.0.limitations.0.ibeacon_ids", "ID", d, scope.Limitations.IBeacons); err != nil {
+		return err
+	}
+
+	// Exclusions
+	if err = constructors.MapSetToStructs[jamfpro.PolicySubsetComputer, int]("scope.0.exclusions.0.computer_ids", "ID", d, scope.Exclusions.Computers); err != nil {
+		return err
+	}
+	if err = constructors.MapSetToStructs[jamfpro.PolicySubsetComputerGroup, int]("scope.0.exclusions.0.computer_group_ids", "ID", d, scope.Exclusions.ComputerGroups); err != nil {
+		return err
+	}
+	if err = constructors.MapSetToStructs[jamfpro.PolicySubsetJSSUser, int]("scope.0.exclusions.0.jss_user_ids", "ID", d, scope.Exclusions.JSSUsers); err != nil {
+		return err
+	}
+	if err = constructors.MapSetToStructs[jamfpro.PolicySubsetJSSUserGroup, int]("scope.0.exclusions.0.jss_user_group_ids", "ID", d, scope.Exclusions.JSSUserGroups); err != nil {
+		return err
+	}
+	if err = constructors.MapSetToStructs[jamfpro.PolicySubsetBuilding, int]("scope.0.exclusions.0.building_ids", "ID", d, scope.Exclusions.Buildings); err != nil {
+		return err
+	}
+	if err = constructors.MapSetToStructs[jamfpro.PolicySubsetDepartment, int]("scope.0.exclusions.0.department_ids", "ID", d, scope.Exclusions.Departments); err != nil {
+		return err
+	}
+	if err = constructors.MapSetToStructs[jamfpro.PolicySubsetNetworkSegment, int]("scope.0.exclusions.0.network_segment_ids", "ID", d, scope.Exclusions.NetworkSegments); err != nil {
+		return err
+	}
+	if err = constructors.MapSetToStructs[jamfpro.PolicySubsetUser, string]("scope.0.exclusions.0.directory_service_or_local_usernames", "Name", d, scope.Exclusions.Users); err != nil {
+		return err
+	}
+	if err = constructors.MapSetToStructs[jamfpro.PolicySubsetUserGroup, int]("scope.0.exclusions.0.directory_service_usergroup_ids", "ID", d, scope.Exclusions.UserGroups); err != nil {
+		return err
+	}
+	if err = constructors.MapSetToStructs[jamfpro.PolicySubsetIBeacon, int]("scope.0.exclusions.0.ibeacon_ids", "ID", d, scope.Exclusions.IBeacons); err != nil {
+		return err
+	}
+
+	return nil
+}
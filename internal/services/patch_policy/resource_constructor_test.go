@@ -0,0 +1,50 @@
+package patch_policy
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConstructScopeRoundTrip(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceJamfProPatchPolicies().Schema, map[string]any{
+		"software_title_configuration_id": "3",
+		"name":                            "Google Chrome",
+		"target_version":                  "120.0",
+		"scope": []any{map[string]any{
+			"computer_ids":       []any{2, 1},
+			"computer_group_ids": []any{5},
+			"limitations": []any{map[string]any{
+				"directory_service_or_local_usernames": []any{"alice"},
+			}},
+			"exclusions": []any{map[string]any{
+				"building_ids": []any{9},
+			}},
+		}},
+	})
+
+	policy, err := construct(d)
+	require.NoError(t, err)
+
+	body, err := xml.Marshal(policy)
+	require.NoError(t, err)
+	assert.Contains(t, string(body), "<computers><computer><id>")
+	assert.Contains(t, string(body), "<limitations><users><user><name>alice</name>")
+	assert.Contains(t, string(body), "<distribution_method>prompt</distribution_method>")
+	assert.Contains(t, string(body), "<grace_period_duration>15</grace_period_duration>")
+
+	var read patchPolicy
+	require.NoError(t, xml.Unmarshal(body, &read))
+
+	scope := stateScope(read.Scope)
+	assert.Equal(t, []int{1, 2}, scope["computer_ids"])
+	assert.Equal(t, []int{5}, scope["computer_group_ids"])
+	assert.Equal(t, []string{"alice"}, scope["limitations"].([]any)[0].(map[string]any)["directory_service_or_local_usernames"])
+	assert.Equal(t, []int{9}, scope["exclusions"].([]any)[0].(map[string]any)["building_ids"])
+
+	require.False(t, updateState(d, &read).HasError())
+	assert.Equal(t, "Update", d.Get("user_interaction.0.install_button_text"))
+}
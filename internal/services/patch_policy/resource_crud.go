@@ -0,0 +1,103 @@
+package patch_policy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	crud "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/sdkv2_crud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const uriPatchPolicies = "/JSSResource/patchpolicies"
+
+// create creates and states a jamfpro patch policy
+func create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+	configurationID := d.Get("software_title_configuration_id").(string)
+	return crud.Create(
+		ctx,
+		d,
+		meta,
+		construct,
+		func(policy *patchPolicy) (*patchPolicy, error) {
+			return send(client, "POST", fmt.Sprintf("%s/softwaretitleconfig/id/%s", uriPatchPolicies, configurationID), policy)
+		},
+		readNoCleanup,
+	)
+}
+
+// read reads and states a jamfpro patch policy
+func read(ctx context.Context, d *schema.ResourceData, meta any, cleanup bool) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+	return crud.Read(
+		ctx,
+		d,
+		meta,
+		cleanup,
+		func(id string) (*patchPolicy, error) {
+			return send(client, "GET", fmt.Sprintf("%s/id/%s", uriPatchPolicies, id), nil)
+		},
+		updateState,
+	)
+}
+
+// readWithCleanup reads a resources and states with cleanup
+func readWithCleanup(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return read(ctx, d, meta, true)
+}
+
+// readNoCleanup reads a resource without cleanup
+func readNoCleanup(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return read(ctx, d, meta, false)
+}
+
+// update updates a jamfpro patch policy
+func update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+	return crud.Update(
+		ctx,
+		d,
+		meta,
+		construct,
+		func(id string, policy *patchPolicy) (*patchPolicy, error) {
+			return send(client, "PUT", fmt.Sprintf("%s/id/%s", uriPatchPolicies, id), policy)
+		},
+		readNoCleanup,
+	)
+}
+
+// delete deletes a jamfpro patch policy
+func delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return crud.Delete(
+		ctx,
+		d,
+		meta,
+		meta.(*jamfpro.Client).DeletePatchPolicyByID,
+	)
+}
+
+// send makes a Classic API patch policy request. The SDK patch policy functions do not return the ID
+// of a created policy and only support scoping to all computers.
+func send(client *jamfpro.Client, method, endpoint string, policy *patchPolicy) (*patchPolicy, error) {
+	var payload any
+	if policy != nil {
+		payload = policy
+	}
+
+	var out patchPolicy
+	resp, err := client.HTTP.DoRequest(method, endpoint, payload, &out)
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to %s patch policy at %s: %v", method, endpoint, err)
+	}
+
+	if out.ID == 0 {
+		out.ID = out.General.ID
+	}
+
+	return &out, nil
+}
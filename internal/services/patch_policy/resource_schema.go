@@ -0,0 +1,218 @@
+package patch_policy
+
+import (
+	"time"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/device_identifiers"
+	sharedschemas "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/shared_schemas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceJamfProPatchPolicies defines the schema and CRUD operations for managing patch policies in Terraform.
+func ResourceJamfProPatchPolicies() *schema.Resource {
+	return device_identifiers.WrapResource(&schema.Resource{
+		CreateContext: create,
+		ReadContext:   readWithCleanup,
+		UpdateContext: update,
+		DeleteContext: delete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(70 * time.Second),
+			Read:   schema.DefaultTimeout(70 * time.Second),
+			Update: schema.DefaultTimeout(70 * time.Second),
+			Delete: schema.DefaultTimeout(70 * time.Second),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the patch policy.",
+			},
+			"software_title_configuration_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the patch software title configuration the policy patches.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the patch policy.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the patch policy is enabled.",
+			},
+			"target_version": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The version of the software title to patch computers to. A package must be defined for this version in the software title configuration.",
+			},
+			"distribution_method": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "prompt",
+				Description:  "How the patch is distributed: 'prompt' installs it automatically, prompting users to quit the title if it is open, 'selfservice' makes it available in Self Service.",
+				ValidateFunc: validation.StringInSlice([]string{"prompt", "selfservice"}, false),
+			},
+			"allow_downgrade": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether computers with a newer version of the title are downgraded to the target version.",
+			},
+			"patch_unknown": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether computers with an unknown version of the title are patched.",
+			},
+			"release_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The release date of the target version.",
+			},
+			"minimum_os": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The minimum operating system version required by the target version.",
+			},
+			"incremental_updates": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the target version must be installed through incremental updates.",
+			},
+			"reboot": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the target version requires a restart.",
+			},
+			"kill_apps": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The apps quit before the target version is installed, as defined by the software title.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the app.",
+						},
+						"bundle_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The bundle ID of the app.",
+						},
+					},
+				},
+			},
+			"user_interaction": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Description: "The Self Service, notification, deadline and grace period settings of the patch policy.",
+				Elem:        userInteractionSchema(),
+			},
+			"scope": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "The scope of the patch policy.",
+				Elem:        sharedschemas.GetSharedmacOSComputerSchemaScope(),
+			},
+		},
+	}, device_identifiers.ComputerScopeFields...)
+}
+
+// userInteractionSchema returns the schema of the user_interaction block.
+func userInteractionSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"install_button_text": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "Update",
+				Description: "The text of the install button in Self Service.",
+			},
+			"self_service_description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the patch in Self Service.",
+			},
+			"notification_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether users are notified that the patch is available.",
+			},
+			"notification_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Self Service",
+				Description:  "How users are notified: 'Self Service' or 'Self Service and Notification Center'.",
+				ValidateFunc: validation.StringInSlice([]string{"Self Service", "Self Service and Notification Center"}, false),
+			},
+			"notification_subject": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The subject of the notification.",
+			},
+			"notification_message": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The message of the notification.",
+			},
+			"reminders_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether users are reminded of the patch until it is installed.",
+			},
+			"reminder_frequency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				Description:  "The number of days between reminders.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"deadline_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the patch is installed automatically once the deadline passes.",
+			},
+			"deadline_period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      7,
+				Description:  "The number of days after the patch becomes available until it is installed automatically.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"grace_period_duration": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      15,
+				Description:  "The number of minutes users have to quit the title before it is quit and patched.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"grace_period_notification_subject": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "Important",
+				Description: "The subject of the notification shown during the grace period.",
+			},
+			"grace_period_message": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "$APP_NAMES will quit in $DELAY_MINUTES minutes so that $SOFTWARE_TITLE can be updated. Save anything you are working on and quit the app(s).",
+				Description: "The message shown during the grace period. $APP_NAMES, $DELAY_MINUTES and $SOFTWARE_TITLE are replaced by Jamf Pro.",
+			},
+		},
+	}
+}
@@ -0,0 +1,159 @@
+package patch_policy
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// updateState updates the Terraform state with the latest Patch Policy information from the Jamf Pro API.
+func updateState(d *schema.ResourceData, resp *patchPolicy) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	killApps := make([]any, 0, len(resp.General.KillApps))
+	for _, app := range resp.General.KillApps {
+		killApps = append(killApps, map[string]any{
+			"name":      app.KillAppName,
+			"bundle_id": app.KillAppBundleID,
+		})
+	}
+
+	ui := resp.UserInteraction
+	userInteraction := []any{map[string]any{
+		"install_button_text":               ui.InstallButtonText,
+		"self_service_description":          ui.SelfServiceDescription,
+		"notification_enabled":              ui.Notifications.Enabled,
+		"notification_type":                 ui.Notifications.Type,
+		"notification_subject":              ui.Notifications.Subject,
+		"notification_message":              ui.Notifications.Message,
+		"reminders_enabled":                 ui.Notifications.Reminders.Enabled,
+		"reminder_frequency":                ui.Notifications.Reminders.Frequency,
+		"deadline_enabled":                  ui.Deadlines.Enabled,
+		"deadline_period":                   ui.Deadlines.Period,
+		"grace_period_duration":             ui.GracePeriod.Duration,
+		"grace_period_notification_subject": ui.GracePeriod.NotificationSubject,
+		"grace_period_message":              ui.GracePeriod.Message,
+	}}
+
+	policyData := map[string]any{
+		"name":                resp.General.Name,
+		"enabled":             resp.General.Enabled,
+		"target_version":      resp.General.TargetVersion,
+		"distribution_method": resp.General.DistributionMethod,
+		"allow_downgrade":     resp.General.AllowDowngrade,
+		"patch_unknown":       resp.General.PatchUnknown,
+		"release_date":        resp.General.ReleaseDate,
+		"minimum_os":          resp.General.MinimumOS,
+		"incremental_updates": resp.General.IncrementalUpdates,
+		"reboot":              resp.General.Reboot,
+		"kill_apps":           killApps,
+		"user_interaction":    userInteraction,
+		"scope":               []any{stateScope(resp.Scope)},
+	}
+
+	if resp.SoftwareTitleConfigurationID != 0 {
+		policyData["software_title_configuration_id"] = strconv.Itoa(resp.SoftwareTitleConfigurationID)
+	}
+
+	for key, val := range policyData {
+		if err := d.Set(key, val); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return diags
+}
+
+// stateScope converts the patch policy scope into the shared computer scope schema.
+func stateScope(scope jamfpro.PolicySubsetScope) map[string]any {
+	out := map[string]any{
+		"all_computers":      scope.AllComputers,
+		"all_jss_users":      scope.AllJSSUsers,
+		"computer_ids":       ids(scope.Computers, func(v jamfpro.PolicySubsetComputer) int { return v.ID }),
+		"computer_group_ids": ids(scope.ComputerGroups, func(v jamfpro.PolicySubsetComputerGroup) int { return v.ID }),
+		"jss_user_ids":       ids(scope.JSSUsers, func(v jamfpro.PolicySubsetJSSUser) int { return v.ID }),
+		"jss_user_group_ids": ids(scope.JSSUserGroups, func(v jamfpro.PolicySubsetJSSUserGroup) int { return v.ID }),
+		"building_ids":       ids(scope.Buildings, func(v jamfpro.PolicySubsetBuilding) int { return v.ID }),
+		"department_ids":     ids(scope.Departments, func(v jamfpro.PolicySubsetDepartment) int { return v.ID }),
+	}
+
+	if l := scope.Limitations; l != nil {
+		limitations := map[string]any{
+			"network_segment_ids":                  ids(l.NetworkSegments, func(v jamfpro.PolicySubsetNetworkSegment) int { return v.ID }),
+			"directory_service_or_local_usernames": names(l.Users),
+			"directory_service_usergroup_ids":      ids(l.UserGroups, func(v jamfpro.PolicySubsetUserGroup) int { return v.ID }),
+			"ibeacon_ids":                          ids(l.IBeacons, func(v jamfpro.PolicySubsetIBeacon) int { return v.ID }),
+		}
+		if !emptyBlock(limitations) {
+			out["limitations"] = []any{limitations}
+		}
+	}
+
+	if e := scope.Exclusions; e != nil {
+		exclusions := map[string]any{
+			"computer_ids":                         ids(e.Computers, func(v jamfpro.PolicySubsetComputer) int { return v.ID }),
+			"computer_group_ids":                   ids(e.ComputerGroups, func(v jamfpro.PolicySubsetComputerGroup) int { return v.ID }),
+			"jss_user_ids":                         ids(e.JSSUsers, func(v jamfpro.PolicySubsetJSSUser) int { return v.ID }),
+			"jss_user_group_ids":                   ids(e.JSSUserGroups, func(v jamfpro.PolicySubsetJSSUserGroup) int { return v.ID }),
+			"building_ids":                         ids(e.Buildings, func(v jamfpro.PolicySubsetBuilding) int { return v.ID }),
+			"department_ids":                       ids(e.Departments, func(v jamfpro.PolicySubsetDepartment) int { return v.ID }),
+			"network_segment_ids":                  ids(e.NetworkSegments, func(v jamfpro.PolicySubsetNetworkSegment) int { return v.ID }),
+			"directory_service_or_local_usernames": names(e.Users),
+			"directory_service_usergroup_ids":      ids(e.UserGroups, func(v jamfpro.PolicySubsetUserGroup) int { return v.ID }),
+			"ibeacon_ids":                          ids(e.IBeacons, func(v jamfpro.PolicySubsetIBeacon) int { return v.ID }),
+		}
+		if !emptyBlock(exclusions) {
+			out["exclusions"] = []any{exclusions}
+		}
+	}
+
+	return out
+}
+
+// ids returns the sorted, non-zero IDs of items.
+func ids[T any](items *[]T, id func(T) int) []int {
+	var out []int
+	if items == nil {
+		return out
+	}
+	for _, item := range *items {
+		if v := id(item); v != 0 {
+			out = append(out, v)
+		}
+	}
+	sort.Ints(out)
+	return out
+}
+
+func names(users *[]jamfpro.PolicySubsetUser) []string {
+	var out []string
+	if users == nil {
+		return out
+	}
+	for _, user := range *users {
+		if user.Name != "" {
+			out = append(out, user.Name)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+func emptyBlock(block map[string]any) bool {
+	for _, v := range block {
+		switch list := v.(type) {
+		case []int:
+			if len(list) > 0 {
+				return false
+			}
+		case []string:
+			if len(list) > 0 {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,76 @@
+package patch_software_title_configuration
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// configurationPayload is the request body for creating and updating a patch software title
+// configuration. Unlike the SDK type, booleans and lists are always sent so that notifications can
+// be turned off and extension attributes and packages can be removed.
+type configurationPayload struct {
+	DisplayName         string                        `json:"displayName"`
+	SoftwareTitleID     string                        `json:"softwareTitleId,omitempty"`
+	CategoryID          string                        `json:"categoryId"`
+	SiteID              string                        `json:"siteId"`
+	UiNotifications     bool                          `json:"uiNotifications"`
+	EmailNotifications  bool                          `json:"emailNotifications"`
+	ExtensionAttributes []extensionAttributePayload   `json:"extensionAttributes"`
+	Packages            []configurationPackagePayload `json:"packages"`
+}
+
+type extensionAttributePayload struct {
+	Accepted bool   `json:"accepted"`
+	EaID     string `json:"eaId"`
+}
+
+type configurationPackagePayload struct {
+	PackageID string `json:"packageId"`
+	Version   string `json:"version"`
+}
+
+// construct builds the patch software title configuration payload from the provided schema data.
+func construct(d *schema.ResourceData) (*configurationPayload, error) {
+	resource := &configurationPayload{
+		DisplayName:         d.Get("display_name").(string),
+		CategoryID:          d.Get("category_id").(string),
+		SiteID:              d.Get("site_id").(string),
+		UiNotifications:     d.Get("ui_notifications").(bool),
+		EmailNotifications:  d.Get("email_notifications").(bool),
+		ExtensionAttributes: []extensionAttributePayload{},
+		Packages:            []configurationPackagePayload{},
+	}
+
+	// The software title of a configuration cannot be changed, so it is only sent on create.
+	if d.IsNewResource() {
+		resource.SoftwareTitleID = d.Get("software_title_id").(string)
+	}
+
+	for _, v := range d.Get("extension_attribute").(*schema.Set).List() {
+		ea := v.(map[string]any)
+		resource.ExtensionAttributes = append(resource.ExtensionAttributes, extensionAttributePayload{
+			EaID:     ea["ea_id"].(string),
+			Accepted: ea["accepted"].(bool),
+		})
+	}
+
+	for _, v := range d.Get("package").(*schema.Set).List() {
+		pkg := v.(map[string]any)
+		resource.Packages = append(resource.Packages, configurationPackagePayload{
+			PackageID: pkg["package_id"].(string),
+			Version:   pkg["version"].(string),
+		})
+	}
+
+	resourceJSON, err := json.MarshalIndent(resource, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Jamf Pro Patch Software Title Configuration '%s' to JSON: %v", resource.DisplayName, err)
+	}
+
+	log.Printf("[DEBUG] Constructed Jamf Pro Patch Software Title Configuration JSON:\n%s\n", string(resourceJSON))
+
+	return resource, nil
+}
@@ -0,0 +1,89 @@
+package patch_software_title_configuration
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	crud "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/sdkv2_crud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const uriPatchSoftwareTitleConfigurations = "/api/v2/patch-software-title-configurations"
+
+// create creates and states a jamfpro patch software title configuration
+func create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+	return crud.Create(
+		ctx,
+		d,
+		meta,
+		construct,
+		func(payload *configurationPayload) (*jamfpro.ResponsePatchSoftwareTitleConfigurationCreate, error) {
+			var out jamfpro.ResponsePatchSoftwareTitleConfigurationCreate
+			return &out, send(client, "POST", uriPatchSoftwareTitleConfigurations, payload, &out)
+		},
+		readNoCleanup,
+	)
+}
+
+// read reads and states a jamfpro patch software title configuration
+func read(ctx context.Context, d *schema.ResourceData, meta any, cleanup bool) diag.Diagnostics {
+	return crud.Read(
+		ctx,
+		d,
+		meta,
+		cleanup,
+		meta.(*jamfpro.Client).GetPatchSoftwareTitleConfigurationById,
+		updateState,
+	)
+}
+
+// readWithCleanup reads a resources and states with cleanup
+func readWithCleanup(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return read(ctx, d, meta, true)
+}
+
+// readNoCleanup reads a resource without cleanup
+func readNoCleanup(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return read(ctx, d, meta, false)
+}
+
+// update updates a jamfpro patch software title configuration
+func update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+	return crud.Update(
+		ctx,
+		d,
+		meta,
+		construct,
+		func(id string, payload *configurationPayload) (*jamfpro.ResourcePatchSoftwareTitleConfiguration, error) {
+			var out jamfpro.ResourcePatchSoftwareTitleConfiguration
+			return &out, send(client, "PATCH", fmt.Sprintf("%s/%s", uriPatchSoftwareTitleConfigurations, id), payload, &out)
+		},
+		readNoCleanup,
+	)
+}
+
+// delete deletes a jamfpro patch software title configuration
+func delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return crud.Delete(
+		ctx,
+		d,
+		meta,
+		meta.(*jamfpro.Client).DeletePatchSoftwareTitleConfigurationById,
+	)
+}
+
+// send sends payload to endpoint and decodes the response into out.
+func send(client *jamfpro.Client, method, endpoint string, payload *configurationPayload, out any) error {
+	resp, err := client.HTTP.DoRequest(method, endpoint, payload, out)
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return fmt.Errorf("failed to %s patch software title configuration '%s': %v", method, payload.DisplayName, err)
+	}
+	return nil
+}
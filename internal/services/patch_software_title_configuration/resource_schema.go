@@ -0,0 +1,142 @@
+package patch_software_title_configuration
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceJamfProPatchSoftwareTitleConfigurations defines the schema and CRUD operations for managing
+// patch software title configurations in Terraform.
+func ResourceJamfProPatchSoftwareTitleConfigurations() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: create,
+		ReadContext:   readWithCleanup,
+		UpdateContext: update,
+		DeleteContext: delete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(70 * time.Second),
+			Read:   schema.DefaultTimeout(70 * time.Second),
+			Update: schema.DefaultTimeout(70 * time.Second),
+			Delete: schema.DefaultTimeout(70 * time.Second),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the patch software title configuration.",
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The display name of the patch software title configuration.",
+			},
+			"software_title_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the software title in its patch source. Use the 'jamfpro_patch_title_catalog' data source to look up titles.",
+			},
+			"category_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "-1",
+				Description: "The ID of the category of the software title. '-1' for no category.",
+			},
+			"site_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "-1",
+				Description: "The ID of the site of the software title. '-1' for no site.",
+			},
+			"ui_notifications": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether Jamf Pro shows a notification in the web interface when a new version of the title is released.",
+			},
+			"email_notifications": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether Jamf Pro sends an email notification when a new version of the title is released.",
+			},
+			"extension_attribute": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Extension attributes required by the software title. An extension attribute must be accepted before the title can report patch status.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ea_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The key of the extension attribute as defined by the software title, e.g. 'google-chrome-ea'.",
+						},
+						"accepted": {
+							Type:        schema.TypeBool,
+							Required:    true,
+							Description: "Whether the extension attribute is accepted.",
+						},
+					},
+				},
+			},
+			"package": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The package to deploy for each version of the software title.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"package_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The ID of the package.",
+						},
+						"version": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The version of the software title the package installs.",
+						},
+						"display_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The display name of the package.",
+						},
+					},
+				},
+			},
+			"software_title_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the software title in its patch source.",
+			},
+			"software_title_name_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name ID of the software title in its patch source.",
+			},
+			"software_title_publisher": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The publisher of the software title.",
+			},
+			"jamf_official": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the software title is provided by Jamf.",
+			},
+			"patch_source_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the patch source providing the software title.",
+			},
+			"patch_source_enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the patch source providing the software title is enabled.",
+			},
+		},
+	}
+}
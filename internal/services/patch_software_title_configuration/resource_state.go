@@ -0,0 +1,70 @@
+package patch_software_title_configuration
+
+import (
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// updateState updates the Terraform state with the latest Patch Software Title Configuration information from the Jamf Pro API.
+func updateState(d *schema.ResourceData, resp *jamfpro.ResourcePatchSoftwareTitleConfiguration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	configurationData := map[string]any{
+		"display_name":             resp.DisplayName,
+		"software_title_id":        resp.SoftwareTitleID,
+		"category_id":              resp.CategoryID,
+		"site_id":                  resp.SiteID,
+		"ui_notifications":         resp.UiNotifications,
+		"email_notifications":      resp.EmailNotifications,
+		"extension_attribute":      stateExtensionAttributes(d, resp.ExtensionAttributes),
+		"package":                  statePackages(resp.Packages),
+		"software_title_name":      resp.SoftwareTitleName,
+		"software_title_name_id":   resp.SoftwareTitleNameId,
+		"software_title_publisher": resp.SoftwareTitlePublisher,
+		"jamf_official":            resp.JamfOfficial,
+		"patch_source_name":        resp.PatchSourceName,
+		"patch_source_enabled":     resp.PatchSourceEnabled,
+	}
+
+	for key, val := range configurationData {
+		if err := d.Set(key, val); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return diags
+}
+
+// stateExtensionAttributes returns the extension attributes that are configured or accepted. The API
+// lists every extension attribute of the title, including ones never accepted.
+func stateExtensionAttributes(d *schema.ResourceData, eas []jamfpro.PatchSoftwareTitleConfigurationSubsetExtensionAttribute) []any {
+	configured := make(map[string]bool)
+	for _, v := range d.Get("extension_attribute").(*schema.Set).List() {
+		configured[v.(map[string]any)["ea_id"].(string)] = true
+	}
+
+	out := make([]any, 0, len(eas))
+	for _, ea := range eas {
+		if !ea.Accepted && !configured[ea.EaID] {
+			continue
+		}
+		out = append(out, map[string]any{
+			"ea_id":    ea.EaID,
+			"accepted": ea.Accepted,
+		})
+	}
+	return out
+}
+
+func statePackages(packages []jamfpro.PatchSoftwareTitleConfigurationSubsetPackage) []any {
+	out := make([]any, 0, len(packages))
+	for _, pkg := range packages {
+		out = append(out, map[string]any{
+			"package_id":   pkg.PackageId,
+			"version":      pkg.Version,
+			"display_name": pkg.DisplayName,
+		})
+	}
+	return out
+}
@@ -0,0 +1,92 @@
+package patch_title_catalog
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/xml"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const uriPatchAvailableTitles = "/JSSResource/patchavailabletitles/sourceid"
+
+// availableTitles is the Classic API list of titles available from a patch source.
+type availableTitles struct {
+	XMLName xml.Name         `xml:"patch_available_titles"`
+	Titles  []availableTitle `xml:"available_titles>available_title"`
+}
+
+type availableTitle struct {
+	NameID         string `xml:"name_id"`
+	CurrentVersion string `xml:"current_version"`
+	Publisher      string `xml:"publisher"`
+	LastModified   string `xml:"last_modified"`
+	AppName        string `xml:"app_name"`
+}
+
+// dataSourceRead fetches the titles available from a patch source, optionally filtered by name.
+func dataSourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+	sourceID := d.Get("source_id").(string)
+	name := d.Get("name").(string)
+
+	var catalog availableTitles
+	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
+		resp, apiErr := client.HTTP.DoRequest("GET", fmt.Sprintf("%s/%s", uriPatchAvailableTitles, sourceID), nil, &catalog)
+		if resp != nil && resp.Body != nil {
+			defer resp.Body.Close()
+		}
+		if apiErr != nil {
+			return retry.RetryableError(apiErr)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read Jamf Pro Patch Title Catalog for source ID %s after retries: %v", sourceID, err))
+	}
+
+	matched := filterTitles(catalog.Titles, name)
+
+	titles := make([]any, 0, len(matched))
+	for _, title := range matched {
+		titles = append(titles, map[string]any{
+			"name_id":         title.NameID,
+			"app_name":        title.AppName,
+			"publisher":       title.Publisher,
+			"current_version": title.CurrentVersion,
+			"last_modified":   title.LastModified,
+		})
+	}
+
+	if err := d.Set("titles", titles); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%x", sha256.Sum256([]byte(sourceID+"|"+name)))[:16])
+
+	return nil
+}
+
+// filterTitles returns the titles whose app name contains name, ignoring case, sorted by app name.
+func filterTitles(titles []availableTitle, name string) []availableTitle {
+	needle := strings.ToLower(name)
+
+	out := make([]availableTitle, 0, len(titles))
+	for _, title := range titles {
+		if strings.Contains(strings.ToLower(title.AppName), needle) {
+			out = append(out, title)
+		}
+	}
+
+	sort.SliceStable(out, func(i, j int) bool {
+		return strings.ToLower(out[i].AppName) < strings.ToLower(out[j].AppName)
+	})
+
+	return out
+}
@@ -0,0 +1,64 @@
+package patch_title_catalog
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProPatchTitleCatalog provides the software titles available from a patch source.
+func DataSourceJamfProPatchTitleCatalog() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(70 * time.Second),
+		},
+		Schema: map[string]*schema.Schema{
+			"source_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "1",
+				Description: "The ID of the patch source to list titles from. '1' is the Jamf patch source; use the ID of a 'jamfpro_patch_external_source' for an external source.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return titles whose app name contains this value, ignoring case.",
+			},
+			"titles": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The software titles available from the patch source, sorted by app name.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the title in the patch source.",
+						},
+						"app_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the title.",
+						},
+						"publisher": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The publisher of the title.",
+						},
+						"current_version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The latest version of the title known to the patch source.",
+						},
+						"last_modified": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "When the title was last updated in the patch source.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
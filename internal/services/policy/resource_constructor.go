@@ -465,12 +465,16 @@ func constructPayloadAccountMaintenance(d *schema.ResourceData, resource *jamfpr
 		if directoryBindings, ok := data["directory_bindings"]; ok && len(directoryBindings.([]any)) > 0 {
 			directoryBindingsList := directoryBindings.([]any)
 			bindings := []jamfpro.PolicySubsetAccountMaintenanceDirectoryBindings{}
-			for _, binding := range directoryBindingsList {
-				bindingData := binding.(map[string]any)
-				bindings = append(bindings, jamfpro.PolicySubsetAccountMaintenanceDirectoryBindings{
-					ID:   bindingData["id"].(int),
-					Name: bindingData["name"].(string),
-				})
+			for _, directoryBinding := range directoryBindingsList {
+				if directoryBinding == nil {
+					continue
+				}
+				for _, binding := range directoryBinding.(map[string]any)["binding"].([]any) {
+					bindingData := binding.(map[string]any)
+					bindings = append(bindings, jamfpro.PolicySubsetAccountMaintenanceDirectoryBindings{
+						ID: bindingData["id"].(int),
+					})
+				}
 			}
 			outBlock.DirectoryBindings = &bindings
 		}
@@ -122,10 +122,10 @@ func getPolicySchemaDirectoryBinding() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"id": {
-							Type:        schema.TypeInt,
-							Optional:    true,
-							Description: "The unique identifier of the binding.",
-							Default:     -1,
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The unique identifier of the directory binding, e.g. jamfpro_directory_binding.example.id.",
 						},
 						"name": {
 							Type:        schema.TypeString,
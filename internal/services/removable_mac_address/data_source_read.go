@@ -0,0 +1,50 @@
+package removable_mac_address
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceRead fetches the details of a specific Jamf Pro Removable MAC Address using either its ID or its Name.
+func dataSourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+
+	lookupMethod, lookupValue := "ID", d.Get("id").(string)
+	get := client.GetRemovableMACAddressByID
+	if v := d.Get("name").(string); v != "" && lookupValue == "" {
+		lookupMethod, lookupValue, get = "name", v, client.GetRemovableMACAddressByName
+	}
+
+	var resource *jamfpro.ResourceRemovableMacAddress
+	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
+		var apiErr error
+		resource, apiErr = get(lookupValue)
+		if apiErr != nil {
+			return retry.RetryableError(apiErr)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read Jamf Pro Removable MAC Address with %s '%s' after retries: %v", lookupMethod, lookupValue, err))
+	}
+
+	if resource == nil {
+		d.SetId("")
+		return diag.FromErr(fmt.Errorf("the Jamf Pro Removable MAC Address was not found"))
+	}
+
+	d.SetId(strconv.Itoa(resource.ID))
+
+	if err := d.Set("name", resource.Name); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
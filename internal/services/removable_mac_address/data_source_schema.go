@@ -0,0 +1,32 @@
+package removable_mac_address
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProRemovableMacAddresses provides information about a specific Jamf Pro Removable MAC Address by its ID or Name.
+func DataSourceJamfProRemovableMacAddresses() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(30 * time.Second),
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The unique identifier of the removable MAC address.",
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The MAC address of the removable network adapter.",
+			},
+		},
+	}
+}
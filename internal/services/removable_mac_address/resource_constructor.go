@@ -0,0 +1,26 @@
+package removable_mac_address
+
+import (
+	"encoding/xml"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// construct constructs a ResourceRemovableMacAddress object from the provided schema data.
+func construct(d *schema.ResourceData) (*jamfpro.ResourceRemovableMacAddress, error) {
+	resource := &jamfpro.ResourceRemovableMacAddress{
+		Name: d.Get("name").(string),
+	}
+
+	resourceXML, err := xml.MarshalIndent(resource, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Jamf Pro Removable MAC Address '%s' to XML: %v", resource.Name, err)
+	}
+
+	log.Printf("[DEBUG] Constructed Jamf Pro Removable MAC Address XML:\n%s\n", string(resourceXML))
+
+	return resource, nil
+}
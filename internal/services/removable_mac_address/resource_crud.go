@@ -0,0 +1,67 @@
+package removable_mac_address
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	crud "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/sdkv2_crud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// create is responsible for creating a new Jamf Pro Removable MAC Address in the remote system.
+func create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+
+	return crud.Create(
+		ctx,
+		d,
+		meta,
+		construct,
+		meta.(*jamfpro.Client).CreateRemovableMACAddress,
+		readNoCleanup,
+	)
+}
+
+// read is responsible for reading the current state of a Jamf Pro Removable MAC Address Resource from the remote system.
+func read(ctx context.Context, d *schema.ResourceData, meta any, cleanup bool) diag.Diagnostics {
+	return crud.Read(
+		ctx,
+		d,
+		meta,
+		cleanup,
+		meta.(*jamfpro.Client).GetRemovableMACAddressByID,
+		updateState,
+	)
+}
+
+// readWithCleanup reads the resource with cleanup enabled
+func readWithCleanup(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return read(ctx, d, meta, true)
+}
+
+// readNoCleanup reads the resource with cleanup disabled
+func readNoCleanup(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return read(ctx, d, meta, false)
+}
+
+// update is responsible for updating an existing Jamf Pro Removable MAC Address on the remote system.
+func update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return crud.Update(
+		ctx,
+		d,
+		meta,
+		construct,
+		meta.(*jamfpro.Client).UpdateRemovableMACAddressByID,
+		readNoCleanup,
+	)
+}
+
+// delete is responsible for deleting a Jamf Pro Removable MAC Address.
+func delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return crud.Delete(
+		ctx,
+		d,
+		meta,
+		meta.(*jamfpro.Client).DeleteRemovableMACAddressByID,
+	)
+}
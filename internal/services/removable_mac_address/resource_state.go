@@ -0,0 +1,23 @@
+package removable_mac_address
+
+import (
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// updateState updates the Terraform state with the latest Removable MAC Address information from the Jamf Pro API.
+func updateState(d *schema.ResourceData, resp *jamfpro.ResourceRemovableMacAddress) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if err := d.Set("id", strconv.Itoa(resp.ID)); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	if err := d.Set("name", resp.Name); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
@@ -0,0 +1,89 @@
+package scope_preview
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/scope_preview"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceRead evaluates the configured scope against the current Jamf Pro inventory.
+func dataSourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+
+	scopeData, ok := d.Get("scope").([]any)[0].(map[string]any)
+	if !ok {
+		return diag.FromErr(fmt.Errorf("'scope' must be set"))
+	}
+
+	scope, err := scope_preview.ScopeFromMap(client, scopeData)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	computers, err := scope_preview.LoadComputers(client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	result, err := scope_preview.Evaluate(computers, scope, scope_preview.NewDirectory(client))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to evaluate scope: %v", err))
+	}
+
+	log.Printf("[DEBUG] Scope preview: %d targeted, %d excluded, %d effective", len(result.Targeted), len(result.Excluded), len(result.Effective))
+
+	d.SetId(resultID(result.Effective))
+
+	fields := map[string]any{
+		"targeted_count":     len(result.Targeted),
+		"excluded_count":     len(result.Excluded),
+		"computer_count":     len(result.Effective),
+		"computers":          flattenComputers(result.Effective),
+		"excluded_computers": flattenComputers(result.Excluded),
+		"warnings":           result.Warnings,
+	}
+	for k, v := range fields {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	var diags diag.Diagnostics
+	for _, warning := range result.Warnings {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Scope preview is incomplete",
+			Detail:   warning,
+		})
+	}
+
+	return diags
+}
+
+func flattenComputers(computers []scope_preview.Computer) []any {
+	out := make([]any, 0, len(computers))
+	for _, c := range computers {
+		out = append(out, map[string]any{
+			"id":            c.ID,
+			"name":          c.Name,
+			"serial_number": c.SerialNumber,
+			"udid":          c.UDID,
+		})
+	}
+	return out
+}
+
+// resultID derives a stable ID from the effective computer IDs.
+func resultID(computers []scope_preview.Computer) string {
+	h := sha256.New()
+	for _, c := range computers {
+		fmt.Fprintf(h, "%d,", c.ID)
+	}
+	return fmt.Sprintf("%x", h.Sum(nil))[:16]
+}
@@ -0,0 +1,91 @@
+package scope_preview
+
+import (
+	"time"
+
+	sharedschemas "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/shared_schemas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProScopePreview computes the computers a policy or macOS configuration profile scope
+// would apply to, from the current group memberships and inventory.
+func DataSourceJamfProScopePreview() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Description: "Previews the effective computer set of a scope. Targets are matched by ID, serial number, UDID, " +
+			"group membership, building, department, assigned user and network segment; limitations and exclusions are then " +
+			"applied. Directory service user groups and iBeacons cannot be evaluated from inventory and are reported in 'warnings'.",
+		Schema: map[string]*schema.Schema{
+			"scope": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "The scope to preview, in the same form as the 'scope' block of policies and macOS configuration profiles.",
+				Elem:        sharedschemas.GetSharedmacOSComputerSchemaScope(),
+			},
+			"targeted_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of computers matched by the scope targets, before limitations and exclusions.",
+			},
+			"excluded_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of targeted computers removed by limitations or exclusions.",
+			},
+			"computer_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of computers the scope applies to.",
+			},
+			"computers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The computers the scope applies to, ordered by ID.",
+				Elem:        computerSchema(),
+			},
+			"excluded_computers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The targeted computers removed by limitations or exclusions, ordered by ID.",
+				Elem:        computerSchema(),
+			},
+			"warnings": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Scope entries that could not be evaluated and were ignored.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func computerSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The Jamf Pro ID of the computer.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the computer.",
+			},
+			"serial_number": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The serial number of the computer.",
+			},
+			"udid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The UDID of the computer.",
+			},
+		},
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
 	sharedschemas "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/shared_schemas"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/smart_criteria"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -20,8 +21,13 @@ func construct(d *schema.ResourceData) (*jamfpro.ResourceComputerGroup, error) {
 
 	resource.Site = sharedschemas.ConstructSharedResourceSite(d.Get("site_id").(int))
 
-	if v, ok := d.GetOk("criteria"); ok {
-		resource.Criteria = constructComputerGroupSubsetContainerCriteria(v.([]any))
+	criteriaList, err := smart_criteria.GetCriteriaList(d)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(criteriaList) > 0 {
+		resource.Criteria = constructComputerGroupSubsetContainerCriteria(criteriaList)
 	}
 
 	resourceXML, err := xml.MarshalIndent(resource, "", "  ")
@@ -5,6 +5,8 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/group_dependencies"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/smart_criteria"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -15,6 +17,14 @@ func mainCustomDiffFunc(ctx context.Context, diff *schema.ResourceDiff, i any) e
 		return err
 	}
 
+	if err := smart_criteria.ValidateCriteriaDiff(ctx, diff, i, "jamfpro_smart_computer_group", smart_criteria.GetComputerCriteriaFields); err != nil {
+		return err
+	}
+
+	if err := group_dependencies.ValidateNoCycles(ctx, diff, i, "jamfpro_smart_computer_group", group_dependencies.ComputerGroups); err != nil {
+		return err
+	}
+
 	return nil
 }
 
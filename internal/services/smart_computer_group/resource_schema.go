@@ -6,6 +6,7 @@ import (
 	"time"
 
 	sharedschemas "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/shared_schemas"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/smart_criteria"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -67,7 +68,9 @@ func ResourceJamfProSmartComputerGroups() *schema.Resource {
 				Computed:    true,
 				Description: "Boolean selection to state if the group is a Smart group or not. If false then the group is a static group.",
 			},
-			"site_id": sharedschemas.GetSharedSchemaSite(),
+			"site_id":             sharedschemas.GetSharedSchemaSite(),
+			"criteria_expression": smart_criteria.GetSchemaCriteriaExpression(),
+			"validate_criteria":   smart_criteria.GetSchemaValidateCriteria(),
 			"criteria": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -0,0 +1,102 @@
+package smart_computer_group_membership
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/group_membership"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// udidLookupBatchSize is the number of computer IDs resolved per inventory request.
+const udidLookupBatchSize = 100
+
+// dataSourceRead fetches the members of a computer group by ID or name. Member UDIDs are not part
+// of the Classic API group and are resolved from computer inventory for the selected page only.
+func dataSourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+
+	resourceID := d.Get("id").(string)
+	name := d.Get("name").(string)
+
+	var group *jamfpro.ResourceComputerGroup
+	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
+		var apiErr error
+		if resourceID != "" {
+			group, apiErr = client.GetComputerGroupByID(resourceID)
+		} else {
+			group, apiErr = client.GetComputerGroupByName(name)
+		}
+		if apiErr != nil {
+			return retry.RetryableError(apiErr)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read Jamf Pro Computer Group membership: %v", err))
+	}
+
+	var members []group_membership.Member
+	if group.Computers != nil {
+		for _, c := range *group.Computers {
+			members = append(members, group_membership.Member{
+				ID:           c.ID,
+				Name:         c.Name,
+				SerialNumber: c.SerialNumber,
+				MacAddress:   c.MacAddress,
+			})
+		}
+	}
+
+	page, total, err := group_membership.Select(members, group_membership.GetOptions(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := resolveUDIDs(client, page); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to resolve UDIDs of computer group '%s' members: %v", group.Name, err))
+	}
+
+	if err := group_membership.SetState(d, group.ID, group.Name, group.IsSmart, page, total); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// resolveUDIDs sets the UDID of each member from computer inventory.
+func resolveUDIDs(client *jamfpro.Client, members []group_membership.Member) error {
+	for start := 0; start < len(members); start += udidLookupBatchSize {
+		batch := members[start:min(start+udidLookupBatchSize, len(members))]
+
+		ids := make([]string, len(batch))
+		for i, m := range batch {
+			ids[i] = strconv.Itoa(m.ID)
+		}
+
+		params := url.Values{}
+		params.Set("section", "GENERAL")
+		params.Set("filter", fmt.Sprintf("id=in=(%s)", strings.Join(ids, ",")))
+
+		inventory, err := client.GetComputersInventory(params)
+		if err != nil {
+			return err
+		}
+
+		udids := make(map[string]string, len(inventory.Results))
+		for _, computer := range inventory.Results {
+			udids[computer.ID] = computer.UDID
+		}
+		for i := range batch {
+			batch[i].UDID = udids[strconv.Itoa(batch[i].ID)]
+		}
+	}
+
+	return nil
+}
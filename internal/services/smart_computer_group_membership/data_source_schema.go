@@ -0,0 +1,19 @@
+package smart_computer_group_membership
+
+import (
+	"time"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/group_membership"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProSmartComputerGroupMembership provides the current members of a computer group.
+func DataSourceJamfProSmartComputerGroupMembership() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(2 * time.Minute),
+		},
+		Schema: group_membership.GetSchema("computer"),
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"log"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/smart_criteria"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -22,8 +23,13 @@ func construct(d *schema.ResourceData) (*jamfpro.ResourceMobileDeviceGroup, erro
 		resource.Site = jamfpro.SharedResourceSite{ID: (d.Get("site_id").(int))}
 	}
 
-	if v, ok := d.GetOk("criteria"); ok {
-		resource.Criteria = constructMobileGroupSubsetContainerCriteria(v.([]any))
+	criteriaList, err := smart_criteria.GetCriteriaList(d)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(criteriaList) > 0 {
+		resource.Criteria = constructMobileGroupSubsetContainerCriteria(criteriaList)
 	}
 
 	resourceXML, err := xml.MarshalIndent(resource, "", "  ")
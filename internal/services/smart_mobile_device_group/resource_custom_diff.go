@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/group_dependencies"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/smart_criteria"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -14,6 +16,14 @@ func mainCustomDiffFunc(ctx context.Context, diff *schema.ResourceDiff, i any) e
 		return err
 	}
 
+	if err := smart_criteria.ValidateCriteriaDiff(ctx, diff, i, "jamfpro_smart_mobile_device_group", smart_criteria.GetMobileDeviceCriteriaFields); err != nil {
+		return err
+	}
+
+	if err := group_dependencies.ValidateNoCycles(ctx, diff, i, "jamfpro_smart_mobile_device_group", group_dependencies.MobileDeviceGroups); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	sharedschemas "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/shared_schemas"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/smart_criteria"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -61,7 +62,9 @@ func ResourceJamfProSmartMobileGroups() *schema.Resource {
 				Required:    true,
 				Description: "The unique name of the Jamf Pro mobile group.",
 			},
-			"site_id": sharedschemas.GetSharedSchemaSite(),
+			"site_id":             sharedschemas.GetSharedSchemaSite(),
+			"criteria_expression": smart_criteria.GetSchemaCriteriaExpression(),
+			"validate_criteria":   smart_criteria.GetSchemaValidateCriteria(),
 			"criteria": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -2,6 +2,7 @@ package smart_mobile_device_group
 
 import (
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/smart_criteria"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -20,11 +21,11 @@ func updateState(d *schema.ResourceData, resp *jamfpro.ResourceMobileDeviceGroup
 
 	if resp.Criteria.Size != 0 && resp.Criteria.Criterion != nil {
 		criteria := setMobileSmartGroupSubsetContainerCriteria(resp.Criteria)
-		if err := d.Set("criteria", criteria); err != nil {
+		if err := smart_criteria.SetCriteriaState(d, criteria); err != nil {
 			diags = append(diags, diag.FromErr(err)...)
 		}
 	} else {
-		if err := d.Set("criteria", []any{}); err != nil {
+		if err := smart_criteria.SetCriteriaState(d, []any{}); err != nil {
 			diags = append(diags, diag.FromErr(err)...)
 		}
 	}
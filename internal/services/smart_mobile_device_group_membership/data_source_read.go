@@ -0,0 +1,63 @@
+package smart_mobile_device_group_membership
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/group_membership"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceRead fetches the members of a mobile device group by ID or name.
+func dataSourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+
+	resourceID := d.Get("id").(string)
+	name := d.Get("name").(string)
+
+	var group *jamfpro.ResourceMobileDeviceGroup
+	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
+		var apiErr error
+		if resourceID != "" {
+			group, apiErr = client.GetMobileDeviceGroupByID(resourceID)
+		} else {
+			group, apiErr = client.GetMobileDeviceGroupByName(name)
+		}
+		if apiErr != nil {
+			return retry.RetryableError(apiErr)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read Jamf Pro Mobile Device Group membership: %v", err))
+	}
+
+	members := make([]group_membership.Member, 0, len(group.MobileDevices))
+	for _, device := range group.MobileDevices {
+		macAddress := device.WifiMacAddress
+		if macAddress == "" {
+			macAddress = device.MacAddress
+		}
+		members = append(members, group_membership.Member{
+			ID:           device.ID,
+			Name:         device.Name,
+			SerialNumber: device.SerialNumber,
+			UDID:         device.UDID,
+			MacAddress:   macAddress,
+		})
+	}
+
+	page, total, err := group_membership.Select(members, group_membership.GetOptions(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := group_membership.SetState(d, group.ID, group.Name, group.IsSmart, page, total); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,19 @@
+package smart_mobile_device_group_membership
+
+import (
+	"time"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/group_membership"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProSmartMobileDeviceGroupMembership provides the current members of a mobile device group.
+func DataSourceJamfProSmartMobileDeviceGroupMembership() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(2 * time.Minute),
+		},
+		Schema: group_membership.GetSchema("mobile device"),
+	}
+}
@@ -0,0 +1,60 @@
+package software_update_server
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceRead fetches the details of a specific Jamf Pro Software Update Server using either its ID or its Name.
+func dataSourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+
+	lookupMethod, lookupValue := "ID", d.Get("id").(string)
+	get := client.GetSoftwareUpdateServerByID
+	if v := d.Get("name").(string); v != "" && lookupValue == "" {
+		lookupMethod, lookupValue, get = "name", v, client.GetSoftwareUpdateServerByName
+	}
+
+	var resource *jamfpro.ResourceSoftwareUpdateServer
+	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
+		var apiErr error
+		resource, apiErr = get(lookupValue)
+		if apiErr != nil {
+			return retry.RetryableError(apiErr)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read Jamf Pro Software Update Server with %s '%s' after retries: %v", lookupMethod, lookupValue, err))
+	}
+
+	if resource == nil {
+		d.SetId("")
+		return diag.FromErr(fmt.Errorf("the Jamf Pro Software Update Server was not found"))
+	}
+
+	d.SetId(strconv.Itoa(resource.ID))
+
+	resourceData := map[string]any{
+		"name":            resource.Name,
+		"ip_address":      resource.IPAddress,
+		"port":            resource.Port,
+		"set_system_wide": resource.SetSystemWide,
+	}
+
+	var diags diag.Diagnostics
+	for key, val := range resourceData {
+		if err := d.Set(key, val); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,47 @@
+package software_update_server
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProSoftwareUpdateServers provides information about a specific Jamf Pro Software Update Server by its ID or Name.
+func DataSourceJamfProSoftwareUpdateServers() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(30 * time.Second),
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The unique identifier of the software update server.",
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the software update server.",
+			},
+			"ip_address": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The IP address or hostname of the software update server.",
+			},
+			"port": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The port of the software update server.",
+			},
+			"set_system_wide": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the software update server is set for all users of a computer.",
+			},
+		},
+	}
+}
@@ -0,0 +1,29 @@
+package software_update_server
+
+import (
+	"encoding/xml"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// construct constructs a ResourceSoftwareUpdateServer object from the provided schema data.
+func construct(d *schema.ResourceData) (*jamfpro.ResourceSoftwareUpdateServer, error) {
+	resource := &jamfpro.ResourceSoftwareUpdateServer{
+		Name:          d.Get("name").(string),
+		IPAddress:     d.Get("ip_address").(string),
+		Port:          d.Get("port").(int),
+		SetSystemWide: d.Get("set_system_wide").(bool),
+	}
+
+	resourceXML, err := xml.MarshalIndent(resource, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Jamf Pro Software Update Server '%s' to XML: %v", resource.Name, err)
+	}
+
+	log.Printf("[DEBUG] Constructed Jamf Pro Software Update Server XML:\n%s\n", string(resourceXML))
+
+	return resource, nil
+}
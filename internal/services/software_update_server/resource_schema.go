@@ -0,0 +1,57 @@
+package software_update_server
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceJamfProSoftwareUpdateServers defines the schema and CRUD operations for managing Jamf Pro Software Update Servers in Terraform.
+func ResourceJamfProSoftwareUpdateServers() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: create,
+		ReadContext:   readWithCleanup,
+		UpdateContext: update,
+		DeleteContext: delete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(70 * time.Second),
+			Read:   schema.DefaultTimeout(70 * time.Second),
+			Update: schema.DefaultTimeout(70 * time.Second),
+			Delete: schema.DefaultTimeout(70 * time.Second),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the software update server.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the software update server.",
+			},
+			"ip_address": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The IP address or hostname of the software update server.",
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      8088,
+				ValidateFunc: validation.IsPortNumber,
+				Description:  "The port of the software update server.",
+			},
+			"set_system_wide": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the software update server is set for all users of a computer instead of only the computer level.",
+			},
+		},
+	}
+}
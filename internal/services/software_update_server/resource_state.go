@@ -0,0 +1,30 @@
+package software_update_server
+
+import (
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// updateState updates the Terraform state with the latest Software Update Server information from the Jamf Pro API.
+func updateState(d *schema.ResourceData, resp *jamfpro.ResourceSoftwareUpdateServer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	resourceData := map[string]any{
+		"id":              strconv.Itoa(resp.ID),
+		"name":            resp.Name,
+		"ip_address":      resp.IPAddress,
+		"port":            resp.Port,
+		"set_system_wide": resp.SetSystemWide,
+	}
+
+	for key, val := range resourceData {
+		if err := d.Set(key, val); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/group_dependencies"
 	crud "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/sdkv2_crud"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -63,6 +64,6 @@ func delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnost
 		ctx,
 		d,
 		meta,
-		meta.(*jamfpro.Client).DeleteComputerGroupByID,
+		group_dependencies.DeleteComputerGroupFunc(ctx, meta.(*jamfpro.Client), d.Get("name").(string)),
 	)
 }
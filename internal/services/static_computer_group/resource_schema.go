@@ -3,6 +3,7 @@ package static_computer_group
 import (
 	"time"
 
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/device_identifiers"
 	sharedschemas "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/shared_schemas"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -33,7 +34,7 @@ const (
 
 // ResourceJamfProStaticComputerGroups defines the schema and CRUD operations for managing Jamf Pro static Computer Groups in Terraform.
 func ResourceJamfProStaticComputerGroups() *schema.Resource {
-	return &schema.Resource{
+	return device_identifiers.WrapResource(&schema.Resource{
 		CreateContext: create,
 		ReadContext:   readWithCleanup,
 		UpdateContext: update,
@@ -72,6 +73,27 @@ func ResourceJamfProStaticComputerGroups() *schema.Resource {
 					Type: schema.TypeInt,
 				},
 			},
+			"assigned_computer_serial_numbers": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "assigned computers by serial number, resolved to ids at apply time",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"assigned_computer_udids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "assigned computers by UDID, resolved to ids at apply time",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 		},
-	}
+	}, device_identifiers.Field{
+		IDs:           "assigned_computer_ids",
+		SerialNumbers: "assigned_computer_serial_numbers",
+		UDIDs:         "assigned_computer_udids",
+		Kind:          device_identifiers.Computers,
+	})
 }
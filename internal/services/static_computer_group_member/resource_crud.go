@@ -0,0 +1,146 @@
+package static_computer_group_member
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/errors"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/group_membership"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// create adds the computer to the static computer group.
+func create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+	groupID := d.Get("group_id").(string)
+	computerID := d.Get("computer_id").(int)
+
+	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
+		group, apiErr := client.GetComputerGroupByID(groupID)
+		if apiErr != nil {
+			return retry.RetryableError(apiErr)
+		}
+		if group.IsSmart {
+			return retry.NonRetryableError(fmt.Errorf("computer group '%s' (ID %s) is a smart group; membership can only be managed for static groups", group.Name, groupID))
+		}
+
+		if apiErr := group_membership.AddComputersToGroup(client, groupID, computerID); apiErr != nil {
+			return retry.RetryableError(apiErr)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to add computer ID %d to Jamf Pro Static Computer Group ID %s: %v", computerID, groupID, err))
+	}
+
+	d.SetId(group_membership.MemberID(groupID, computerID))
+
+	return read(ctx, d, meta, false)
+}
+
+// read checks that the computer is still a member of the group. A computer removed outside of
+// Terraform is dropped from state so it is added again on the next apply.
+func read(ctx context.Context, d *schema.ResourceData, meta any, cleanup bool) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+
+	groupID, computerID, err := group_membership.ParseMemberID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var group *jamfpro.ResourceComputerGroup
+	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
+		var apiErr error
+		group, apiErr = client.GetComputerGroupByID(groupID)
+		if apiErr != nil {
+			if strings.Contains(apiErr.Error(), "404") {
+				return retry.NonRetryableError(apiErr)
+			}
+			return retry.RetryableError(apiErr)
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.HandleResourceNotFoundError(err, d, cleanup)
+	}
+
+	isMember := group.Computers != nil && slices.ContainsFunc(*group.Computers, func(c jamfpro.ComputerGroupSubsetComputer) bool {
+		return c.ID == computerID
+	})
+	if !isMember {
+		if !cleanup {
+			return diag.FromErr(fmt.Errorf("computer ID %d was not found in Jamf Pro Static Computer Group '%s' (ID %s) after it was added", computerID, group.Name, groupID))
+		}
+		log.Printf("[WARN] Computer ID %d is no longer a member of Jamf Pro Static Computer Group '%s' (ID %s), removing it from state", computerID, group.Name, groupID)
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("group_id", groupID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("computer_id", computerID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// readWithCleanup reads the resource with cleanup enabled
+func readWithCleanup(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return read(ctx, d, meta, true)
+}
+
+// delete removes the computer from the static computer group, leaving other members in place.
+func delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+
+	groupID, computerID, err := group_membership.ParseMemberID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *retry.RetryError {
+		apiErr := group_membership.RemoveComputersFromGroup(client, groupID, computerID)
+		if apiErr != nil {
+			if strings.Contains(apiErr.Error(), "404") {
+				return nil
+			}
+			return retry.RetryableError(apiErr)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to remove computer ID %d from Jamf Pro Static Computer Group ID %s: %v", computerID, groupID, err))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// importState accepts an ID in the form '<group_id>:<computer_id>'.
+func importState(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	groupID, computerID, err := group_membership.ParseMemberID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(group_membership.MemberID(groupID, computerID))
+	if err := d.Set("group_id", groupID); err != nil {
+		return nil, err
+	}
+	if err := d.Set("computer_id", computerID); err != nil {
+		return nil, err
+	}
+
+	log.Printf("[DEBUG] Importing computer ID %d of Jamf Pro Static Computer Group ID %s", computerID, groupID)
+
+	return []*schema.ResourceData{d}, nil
+}
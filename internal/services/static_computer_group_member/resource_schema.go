@@ -0,0 +1,54 @@
+package static_computer_group_member
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// numericID matches a Jamf Pro numeric resource ID.
+var numericID = regexp.MustCompile(`^[0-9]+$`)
+
+// ResourceJamfProStaticComputerGroupMember defines the schema and CRUD operations for managing a single
+// computer's membership of a Jamf Pro static computer group without owning the group's full member list.
+func ResourceJamfProStaticComputerGroupMember() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: create,
+		ReadContext:   readWithCleanup,
+		DeleteContext: delete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(70 * time.Second),
+			Read:   schema.DefaultTimeout(70 * time.Second),
+			Delete: schema.DefaultTimeout(70 * time.Second),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importState,
+		},
+		Description: "Adds a single computer to a static computer group using the group's add / remove delta API. " +
+			"Use it with a group whose 'assigned_computer_ids' is not managed, or is ignored with 'lifecycle.ignore_changes', " +
+			"so members added here, by other configurations or in the Jamf Pro GUI do not conflict.",
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the membership in the form '<group_id>:<computer_id>'.",
+			},
+			"group_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(numericID, "must be a numeric Jamf Pro ID"),
+				Description:  "The ID of the static computer group.",
+			},
+			"computer_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The ID of the computer to add to the group.",
+			},
+		},
+	}
+}
@@ -3,6 +3,7 @@ package static_mobile_device_group
 import (
 	"time"
 
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/device_identifiers"
 	sharedschemas "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/shared_schemas"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -33,7 +34,7 @@ const (
 
 // ResourceJamfProStaticMobileDeviceGroups defines the schema and CRUD operations for managing Jamf Pro static Mobile Device Groups in Terraform.
 func ResourceJamfProStaticMobileDeviceGroups() *schema.Resource {
-	return &schema.Resource{
+	return device_identifiers.WrapResource(&schema.Resource{
 		CreateContext: create,
 		ReadContext:   readWithCleanup,
 		UpdateContext: update,
@@ -72,6 +73,27 @@ func ResourceJamfProStaticMobileDeviceGroups() *schema.Resource {
 					Type: schema.TypeInt,
 				},
 			},
+			"assigned_mobile_device_serial_numbers": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "assigned mobile devices by serial number, resolved to ids at apply time",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"assigned_mobile_device_udids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "assigned mobile devices by UDID, resolved to ids at apply time",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 		},
-	}
+	}, device_identifiers.Field{
+		IDs:           "assigned_mobile_device_ids",
+		SerialNumbers: "assigned_mobile_device_serial_numbers",
+		UDIDs:         "assigned_mobile_device_udids",
+		Kind:          device_identifiers.MobileDevices,
+	})
 }
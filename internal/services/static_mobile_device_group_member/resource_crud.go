@@ -0,0 +1,146 @@
+package static_mobile_device_group_member
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/errors"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/group_membership"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// create adds the mobile device to the static mobile device group.
+func create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+	groupID := d.Get("group_id").(string)
+	deviceID := d.Get("mobile_device_id").(int)
+
+	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
+		group, apiErr := client.GetMobileDeviceGroupByID(groupID)
+		if apiErr != nil {
+			return retry.RetryableError(apiErr)
+		}
+		if group.IsSmart {
+			return retry.NonRetryableError(fmt.Errorf("mobile device group '%s' (ID %s) is a smart group; membership can only be managed for static groups", group.Name, groupID))
+		}
+
+		if apiErr := group_membership.AddMobileDevicesToGroup(client, groupID, deviceID); apiErr != nil {
+			return retry.RetryableError(apiErr)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to add mobile device ID %d to Jamf Pro Static Mobile Device Group ID %s: %v", deviceID, groupID, err))
+	}
+
+	d.SetId(group_membership.MemberID(groupID, deviceID))
+
+	return read(ctx, d, meta, false)
+}
+
+// read checks that the mobile device is still a member of the group. A mobile device removed outside of
+// Terraform is dropped from state so it is added again on the next apply.
+func read(ctx context.Context, d *schema.ResourceData, meta any, cleanup bool) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+
+	groupID, deviceID, err := group_membership.ParseMemberID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var group *jamfpro.ResourceMobileDeviceGroup
+	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
+		var apiErr error
+		group, apiErr = client.GetMobileDeviceGroupByID(groupID)
+		if apiErr != nil {
+			if strings.Contains(apiErr.Error(), "404") {
+				return retry.NonRetryableError(apiErr)
+			}
+			return retry.RetryableError(apiErr)
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.HandleResourceNotFoundError(err, d, cleanup)
+	}
+
+	isMember := slices.ContainsFunc(group.MobileDevices, func(m jamfpro.MobileDeviceGroupSubsetDeviceItem) bool {
+		return m.ID == deviceID
+	})
+	if !isMember {
+		if !cleanup {
+			return diag.FromErr(fmt.Errorf("mobile device ID %d was not found in Jamf Pro Static Mobile Device Group '%s' (ID %s) after it was added", deviceID, group.Name, groupID))
+		}
+		log.Printf("[WARN] Mobile device ID %d is no longer a member of Jamf Pro Static Mobile Device Group '%s' (ID %s), removing it from state", deviceID, group.Name, groupID)
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("group_id", groupID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("mobile_device_id", deviceID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// readWithCleanup reads the resource with cleanup enabled
+func readWithCleanup(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return read(ctx, d, meta, true)
+}
+
+// delete removes the mobile device from the static mobile device group, leaving other members in place.
+func delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+
+	groupID, deviceID, err := group_membership.ParseMemberID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *retry.RetryError {
+		apiErr := group_membership.RemoveMobileDevicesFromGroup(client, groupID, deviceID)
+		if apiErr != nil {
+			if strings.Contains(apiErr.Error(), "404") {
+				return nil
+			}
+			return retry.RetryableError(apiErr)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to remove mobile device ID %d from Jamf Pro Static Mobile Device Group ID %s: %v", deviceID, groupID, err))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// importState accepts an ID in the form '<group_id>:<mobile_device_id>'.
+func importState(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	groupID, deviceID, err := group_membership.ParseMemberID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(group_membership.MemberID(groupID, deviceID))
+	if err := d.Set("group_id", groupID); err != nil {
+		return nil, err
+	}
+	if err := d.Set("mobile_device_id", deviceID); err != nil {
+		return nil, err
+	}
+
+	log.Printf("[DEBUG] Importing mobile device ID %d of Jamf Pro Static Mobile Device Group ID %s", deviceID, groupID)
+
+	return []*schema.ResourceData{d}, nil
+}
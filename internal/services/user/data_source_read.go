@@ -0,0 +1,129 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceRead fetches the details of a specific Jamf Pro user from Jamf Pro using its ID,
+// username or email address. Once the details are fetched, they are set in the data source's state.
+func dataSourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+
+	lookupMethod, lookupValue := "ID", d.Get("id").(string)
+	get := client.GetUserByID
+	if v := d.Get("username").(string); v != "" {
+		lookupMethod, lookupValue, get = "username", v, client.GetUserByName
+	} else if v := d.Get("email").(string); v != "" {
+		lookupMethod, lookupValue, get = "email", v, func(email string) (*jamfpro.ResourceUser, error) {
+			return getUserByEmail(client, email)
+		}
+	}
+
+	var resource *jamfpro.ResourceUser
+	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
+		var apiErr error
+		resource, apiErr = get(lookupValue)
+		if apiErr != nil {
+			if errors.Is(apiErr, errAmbiguousEmail) {
+				return retry.NonRetryableError(apiErr)
+			}
+			return retry.RetryableError(apiErr)
+		}
+		return nil
+	})
+
+	if err != nil {
+		//nolint:err113
+		return diag.FromErr(fmt.Errorf("failed to read Jamf Pro User with %s '%s' after retries: %w", lookupMethod, lookupValue, err))
+	}
+
+	if resource == nil || resource.ID == 0 {
+		d.SetId("")
+		return diag.FromErr(fmt.Errorf("the Jamf Pro User with %s '%s' was not found", lookupMethod, lookupValue))
+	}
+
+	d.SetId(strconv.Itoa(resource.ID))
+
+	email := resource.Email
+	if email == "" {
+		email = resource.EmailAddress
+	}
+
+	siteIDs := make([]int, 0, len(resource.Sites))
+	for _, site := range resource.Sites {
+		siteIDs = append(siteIDs, site.ID)
+	}
+	sort.Ints(siteIDs)
+
+	extensionAttributes := make([]any, 0, len(resource.ExtensionAttributes.Attributes))
+	for _, ea := range resource.ExtensionAttributes.Attributes {
+		extensionAttributes = append(extensionAttributes, map[string]any{
+			"id":    ea.ID,
+			"name":  ea.Name,
+			"value": ea.Value,
+		})
+	}
+
+	userData := map[string]any{
+		"username":             resource.Name,
+		"email":                email,
+		"full_name":            resource.FullName,
+		"phone_number":         resource.PhoneNumber,
+		"position":             resource.Position,
+		"ldap_server_id":       resource.LDAPServer.ID,
+		"site_ids":             siteIDs,
+		"extension_attributes": extensionAttributes,
+	}
+
+	var diags diag.Diagnostics
+	for key, val := range userData {
+		if err := d.Set(key, val); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return diags
+}
+
+// errAmbiguousEmail is returned by getUserByEmail when more than one user has the email address.
+var errAmbiguousEmail = errors.New("more than one Jamf Pro User has this email address, use 'id' or 'username' instead")
+
+// getUserByEmail looks up the single user with the given email address. The Classic API returns a
+// list of users for an email lookup, so the list is fetched directly and the matching user is then
+// read by ID.
+func getUserByEmail(client *jamfpro.Client, email string) (*jamfpro.ResourceUser, error) {
+	endpoint := fmt.Sprintf("/JSSResource/users/email/%s", url.PathEscape(email))
+
+	var users jamfpro.ResponseUsersList
+	resp, err := client.HTTP.DoRequest("GET", endpoint, nil, &users)
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(users.Users) {
+	case 0:
+		return nil, nil
+	case 1:
+	default:
+		return nil, fmt.Errorf("%w: found %d", errAmbiguousEmail, len(users.Users))
+	}
+
+	if users.Users[0].ID == 0 {
+		return nil, fmt.Errorf("the Jamf Pro User with email '%s' was returned without an ID", email)
+	}
+
+	return client.GetUserByID(strconv.Itoa(users.Users[0].ID))
+}
@@ -0,0 +1,88 @@
+package user
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProUsers provides information about a specific Jamf Pro User by its ID, username or email.
+func DataSourceJamfProUsers() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(30 * time.Second),
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The unique identifier of the user.",
+				ExactlyOneOf: []string{"id", "username", "email"},
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The username of the user.",
+			},
+			"email": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The email address of the user.",
+			},
+			"full_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The full name of the user.",
+			},
+			"phone_number": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The phone number of the user.",
+			},
+			"position": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The position of the user.",
+			},
+			"ldap_server_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The ID of the LDAP server the user is looked up in.",
+			},
+			"site_ids": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "The IDs of the sites the user belongs to.",
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"extension_attributes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The user extension attribute values of the user.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The ID of the user extension attribute.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the user extension attribute.",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The value of the user extension attribute.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,62 @@
+package user
+
+import (
+	"encoding/xml"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// construct builds a ResourceUser object from the provided schema data.
+func construct(d *schema.ResourceData) (*jamfpro.ResourceUser, error) {
+	email := d.Get("email").(string)
+
+	resource := &jamfpro.ResourceUser{
+		Name:         d.Get("username").(string),
+		FullName:     d.Get("full_name").(string),
+		Email:        email,
+		EmailAddress: email,
+		PhoneNumber:  d.Get("phone_number").(string),
+		Position:     d.Get("position").(string),
+		LDAPServer: jamfpro.UserSubsetLDAPServer{
+			ID: d.Get("ldap_server_id").(int),
+		},
+		ExtensionAttributes: jamfpro.UserSubsetExtensionAttributes{
+			Attributes: configuredExtensionAttributes(d.Get("extension_attribute")),
+		},
+		Sites: []jamfpro.SharedResourceSite{},
+	}
+
+	for _, id := range d.Get("site_ids").(*schema.Set).List() {
+		resource.Sites = append(resource.Sites, jamfpro.SharedResourceSite{ID: id.(int)})
+	}
+
+	resourceXML, err := xml.MarshalIndent(resource, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Jamf Pro User '%s' to XML: %v", resource.Name, err)
+	}
+
+	log.Printf("[DEBUG] Constructed Jamf Pro User XML:\n%s\n", string(resourceXML))
+
+	return resource, nil
+}
+
+// configuredExtensionAttributes returns the extension attribute values in the configuration.
+func configuredExtensionAttributes(value any) []jamfpro.UserSubsetExtensionAttribute {
+	set, ok := value.(*schema.Set)
+	if !ok {
+		return nil
+	}
+
+	var out []jamfpro.UserSubsetExtensionAttribute
+	for _, item := range set.List() {
+		block := item.(map[string]any)
+		out = append(out, jamfpro.UserSubsetExtensionAttribute{
+			ID:    block["id"].(int),
+			Value: block["value"].(string),
+		})
+	}
+	return out
+}
@@ -0,0 +1,89 @@
+package user
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceJamfProUser defines the schema and CRUD operations for managing Jamf Pro Users in Terraform
+func ResourceJamfProUser() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: create,
+		ReadContext:   readWithCleanup,
+		UpdateContext: update,
+		DeleteContext: delete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(70 * time.Second),
+			Read:   schema.DefaultTimeout(70 * time.Second),
+			Update: schema.DefaultTimeout(70 * time.Second),
+			Delete: schema.DefaultTimeout(70 * time.Second),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the user.",
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The username of the user.",
+			},
+			"full_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The full name of the user.",
+			},
+			"email": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The email address of the user.",
+			},
+			"phone_number": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The phone number of the user.",
+			},
+			"position": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The position of the user.",
+			},
+			"ldap_server_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The ID of the LDAP server the user is looked up in.",
+			},
+			"site_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The IDs of the sites the user belongs to.",
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"extension_attribute": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Description: "User extension attribute values to set. Only the extension attributes listed here are managed; " +
+					"other extension attribute values of the user are left untouched.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "The ID of the user extension attribute.",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The value of the user extension attribute.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,59 @@
+package user
+
+import (
+	"slices"
+	"sort"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// updateState updates the Terraform state with the latest User information from the Jamf Pro API.
+// Only the extension attributes already in the configuration are written, so values managed
+// elsewhere do not show as drift.
+func updateState(d *schema.ResourceData, resp *jamfpro.ResourceUser) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	email := resp.Email
+	if email == "" {
+		email = resp.EmailAddress
+	}
+
+	siteIDs := make([]int, 0, len(resp.Sites))
+	for _, site := range resp.Sites {
+		siteIDs = append(siteIDs, site.ID)
+	}
+	sort.Ints(siteIDs)
+
+	configured := configuredExtensionAttributes(d.Get("extension_attribute"))
+	var extensionAttributes []any
+	for _, ea := range resp.ExtensionAttributes.Attributes {
+		if !slices.ContainsFunc(configured, func(c jamfpro.UserSubsetExtensionAttribute) bool { return c.ID == ea.ID }) {
+			continue
+		}
+		extensionAttributes = append(extensionAttributes, map[string]any{
+			"id":    ea.ID,
+			"value": ea.Value,
+		})
+	}
+
+	userData := map[string]any{
+		"username":            resp.Name,
+		"full_name":           resp.FullName,
+		"email":               email,
+		"phone_number":        resp.PhoneNumber,
+		"position":            resp.Position,
+		"ldap_server_id":      resp.LDAPServer.ID,
+		"site_ids":            siteIDs,
+		"extension_attribute": extensionAttributes,
+	}
+
+	for key, val := range userData {
+		if err := d.Set(key, val); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,56 @@
+package user_extension_attribute
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceRead fetches the details of a specific Jamf Pro User Extension Attribute from Jamf Pro using its ID or
+// name. Once the details are fetched, they are set in the data source's state.
+func dataSourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+
+	resourceID := d.Get("id").(string)
+	name := d.Get("name").(string)
+
+	if resourceID == "" && name == "" {
+		return diag.FromErr(fmt.Errorf("either 'id' or 'name' must be provided"))
+	}
+
+	endpoint := fmt.Sprintf("%s/id/%s", uriUserExtensionAttributes, resourceID)
+	lookupMethod, lookupValue := "ID", resourceID
+	if name != "" {
+		endpoint = fmt.Sprintf("%s/name/%s", uriUserExtensionAttributes, url.PathEscape(name))
+		lookupMethod, lookupValue = "name", name
+	}
+
+	var resource *userExtensionAttribute
+	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
+		var apiErr error
+		resource, apiErr = send(client, "GET", endpoint, nil)
+		if apiErr != nil {
+			return retry.RetryableError(apiErr)
+		}
+		return nil
+	})
+
+	if err != nil {
+		//nolint:err113
+		return diag.FromErr(fmt.Errorf("failed to read Jamf Pro User Extension Attribute with %s '%s' after retries: %w", lookupMethod, lookupValue, err))
+	}
+
+	if resource == nil {
+		d.SetId("")
+		return diag.FromErr(fmt.Errorf("the Jamf Pro User Extension Attribute was not found"))
+	}
+
+	d.SetId(strconv.Itoa(resource.ID))
+	return updateState(d, resource)
+}
@@ -0,0 +1,52 @@
+package user_extension_attribute
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProUserExtensionAttributes provides information about a specific Jamf Pro User Extension Attribute
+func DataSourceJamfProUserExtensionAttributes() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(30 * time.Second),
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The unique identifier of the user extension attribute.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The unique name of the user extension attribute.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Description of the user extension attribute.",
+			},
+			"data_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Data type of the user extension attribute.",
+			},
+			"input_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The input type used to populate the user extension attribute.",
+			},
+			"popup_menu_choices": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The choices of the pop-up menu, in display order.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
@@ -0,0 +1,63 @@
+package user_extension_attribute
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var (
+	errPopupMenuChoicesRequired       = errors.New("popup_menu_choices must be set when input_type is 'Pop-up Menu'")
+	errPopupMenuChoicesShouldNotBeSet = errors.New("popup_menu_choices should not be set when input_type is 'Text Field'")
+)
+
+// userExtensionAttribute is the Classic API representation of a user extension attribute. The SDK
+// type has no pop-up menu choices.
+type userExtensionAttribute struct {
+	XMLName     xml.Name  `xml:"user_extension_attribute"`
+	ID          int       `xml:"id,omitempty"`
+	Name        string    `xml:"name"`
+	Description string    `xml:"description"`
+	DataType    string    `xml:"data_type"`
+	InputType   inputType `xml:"input_type"`
+}
+
+type inputType struct {
+	Type         string   `xml:"type"`
+	PopupChoices []string `xml:"popup_choices>choice,omitempty"`
+}
+
+// construct builds a user extension attribute object from the provided schema data.
+func construct(d *schema.ResourceData) (*userExtensionAttribute, error) {
+	resource := &userExtensionAttribute{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		DataType:    d.Get("data_type").(string),
+		InputType: inputType{
+			Type: d.Get("input_type").(string),
+		},
+	}
+
+	for _, choice := range d.Get("popup_menu_choices").([]any) {
+		resource.InputType.PopupChoices = append(resource.InputType.PopupChoices, choice.(string))
+	}
+
+	switch {
+	case resource.InputType.Type == "Pop-up Menu" && len(resource.InputType.PopupChoices) == 0:
+		return nil, fmt.Errorf("failed to construct: %w", errPopupMenuChoicesRequired)
+	case resource.InputType.Type == "Text Field" && len(resource.InputType.PopupChoices) > 0:
+		return nil, fmt.Errorf("failed to construct: %w", errPopupMenuChoicesShouldNotBeSet)
+	}
+
+	resourceXML, err := xml.MarshalIndent(resource, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Jamf Pro User Extension Attribute '%s' to XML: %v", resource.Name, err)
+	}
+
+	log.Printf("[DEBUG] Constructed Jamf Pro User Extension Attribute XML:\n%s\n", string(resourceXML))
+
+	return resource, nil
+}
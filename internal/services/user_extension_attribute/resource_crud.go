@@ -0,0 +1,97 @@
+package user_extension_attribute
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	crud "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/sdkv2_crud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const uriUserExtensionAttributes = "/JSSResource/userextensionattributes"
+
+// create is responsible for creating a new Jamf Pro User Extension Attribute in the remote system.
+func create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+	return crud.Create(
+		ctx,
+		d,
+		meta,
+		construct,
+		func(resource *userExtensionAttribute) (*userExtensionAttribute, error) {
+			return send(client, "POST", uriUserExtensionAttributes+"/id/0", resource)
+		},
+		readNoCleanup,
+	)
+}
+
+// read is responsible for reading the current state of a Jamf Pro User Extension Attribute from the remote system.
+func read(ctx context.Context, d *schema.ResourceData, meta any, cleanup bool) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+	return crud.Read(
+		ctx,
+		d,
+		meta,
+		cleanup,
+		func(id string) (*userExtensionAttribute, error) {
+			return send(client, "GET", fmt.Sprintf("%s/id/%s", uriUserExtensionAttributes, id), nil)
+		},
+		updateState,
+	)
+}
+
+// readWithCleanup reads the resource with cleanup enabled
+func readWithCleanup(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return read(ctx, d, meta, true)
+}
+
+// readNoCleanup reads the resource with cleanup disabled
+func readNoCleanup(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return read(ctx, d, meta, false)
+}
+
+// update is responsible for updating an existing Jamf Pro User Extension Attribute on the remote system.
+func update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+	return crud.Update(
+		ctx,
+		d,
+		meta,
+		construct,
+		func(id string, resource *userExtensionAttribute) (*userExtensionAttribute, error) {
+			return send(client, "PUT", fmt.Sprintf("%s/id/%s", uriUserExtensionAttributes, id), resource)
+		},
+		readNoCleanup,
+	)
+}
+
+// delete is responsible for deleting a Jamf Pro User Extension Attribute.
+func delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return crud.Delete(
+		ctx,
+		d,
+		meta,
+		meta.(*jamfpro.Client).DeleteUserExtensionAttributeByID,
+	)
+}
+
+// send makes a Classic API user extension attribute request. The SDK type has no pop-up menu choices.
+func send(client *jamfpro.Client, method, endpoint string, resource *userExtensionAttribute) (*userExtensionAttribute, error) {
+	var payload any
+	if resource != nil {
+		payload = resource
+	}
+
+	var out userExtensionAttribute
+	resp, err := client.HTTP.DoRequest(method, endpoint, payload, &out)
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to %s user extension attribute at %s: %v", method, endpoint, err)
+	}
+
+	return &out, nil
+}
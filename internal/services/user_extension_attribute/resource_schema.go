@@ -0,0 +1,64 @@
+package user_extension_attribute
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceJamfProUserExtensionAttribute defines the schema and CRUD operations for managing Jamf Pro User Extension Attributes in Terraform.
+func ResourceJamfProUserExtensionAttribute() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: create,
+		ReadContext:   readWithCleanup,
+		UpdateContext: update,
+		DeleteContext: delete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(70 * time.Second),
+			Read:   schema.DefaultTimeout(70 * time.Second),
+			Update: schema.DefaultTimeout(70 * time.Second),
+			Delete: schema.DefaultTimeout(70 * time.Second),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the user extension attribute.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The unique name of the user extension attribute.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the user extension attribute.",
+			},
+			"data_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "String",
+				Description:  "Data type of the user extension attribute. Can be String, Integer, or Date.",
+				ValidateFunc: validation.StringInSlice([]string{"String", "Integer", "Date"}, false),
+			},
+			"input_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Text Field",
+				Description:  "The input type used to populate the user extension attribute. Can be Text Field or Pop-up Menu.",
+				ValidateFunc: validation.StringInSlice([]string{"Text Field", "Pop-up Menu"}, false),
+			},
+			"popup_menu_choices": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The choices of the pop-up menu, in display order. Provide only when input_type is 'Pop-up Menu'.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
@@ -0,0 +1,27 @@
+package user_extension_attribute
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// updateState updates the Terraform state with the latest User Extension Attribute information from the Jamf Pro API.
+func updateState(d *schema.ResourceData, resp *userExtensionAttribute) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	attributeData := map[string]any{
+		"name":               resp.Name,
+		"description":        resp.Description,
+		"data_type":          resp.DataType,
+		"input_type":         resp.InputType.Type,
+		"popup_menu_choices": resp.InputType.PopupChoices,
+	}
+
+	for key, val := range attributeData {
+		if err := d.Set(key, val); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return diags
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
 	sharedschemas "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/shared_schemas"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/smart_criteria"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -20,8 +21,12 @@ func construct(d *schema.ResourceData) (*jamfpro.ResourceUserGroup, error) {
 
 	resource.Site = sharedschemas.ConstructSharedResourceSite(d.Get("site_id").(int))
 
-	criteria := d.Get("criteria").([]any)
-	for _, criterion := range criteria {
+	criteriaList, err := smart_criteria.GetCriteriaList(d)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, criterion := range criteriaList {
 		c := criterion.(map[string]any)
 		resource.Criteria = append(resource.Criteria, jamfpro.SharedSubsetCriteria{
 			Name:         c["name"].(string),
@@ -30,18 +30,18 @@ func validateIsSmartAttribute(_ context.Context, diff *schema.ResourceDiff, _ an
 	}
 
 	usersBlockExists := len(diff.Get("assigned_user_ids").([]any)) > 0
-	criteriaBlockExists := len(diff.Get("criteria").([]any)) > 0
+	criteriaBlockExists := len(diff.Get("criteria").([]any)) > 0 || diff.Get("criteria_expression").(string) != ""
 
 	if isSmart.(bool) && usersBlockExists {
 		return fmt.Errorf("in 'jamfpro_user_group.%s': 'users' block is not allowed when 'is_smart' is set to true", resourceName)
 	}
 
 	if !isSmart.(bool) && criteriaBlockExists {
-		return fmt.Errorf("in 'jamfpro_user_group.%s': 'criteria' block is not allowed when 'is_smart' is set to false", resourceName)
+		return fmt.Errorf("in 'jamfpro_user_group.%s': 'criteria' block or 'criteria_expression' is not allowed when 'is_smart' is set to false", resourceName)
 	}
 
 	if isSmart.(bool) && !criteriaBlockExists {
-		return fmt.Errorf("in 'jamfpro_user_group.%s': 'criteria' block is required when 'is_smart' is set to true", resourceName)
+		return fmt.Errorf("in 'jamfpro_user_group.%s': 'criteria' block or 'criteria_expression' is required when 'is_smart' is set to true", resourceName)
 	}
 
 	if !isSmart.(bool) && !usersBlockExists {
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	sharedschemas "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/shared_schemas"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/smart_criteria"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -62,7 +63,8 @@ func ResourceJamfProUserGroups() *schema.Resource {
 				Optional:    true,
 				Description: "Indicates if notifications are sent on change.",
 			},
-			"site_id": sharedschemas.GetSharedSchemaSite(),
+			"site_id":             sharedschemas.GetSharedSchemaSite(),
+			"criteria_expression": smart_criteria.GetSchemaCriteriaExpression(),
 			"criteria": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -4,6 +4,7 @@ import (
 	"strconv"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/smart_criteria"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -40,7 +41,9 @@ func updateState(d *schema.ResourceData, resp *jamfpro.ResourceUserGroup) diag.D
 		}
 	}
 
-	d.Set("criteria", criteria)
+	if err := smart_criteria.SetCriteriaState(d, criteria); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
 
 	if !resp.IsSmart {
 		var userIDStrList []int
@@ -0,0 +1,60 @@
+package venafi_settings
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/redact"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// venafiSettings is the Jamf Pro API representation of Venafi connector settings. The SDK does not
+// support the Venafi endpoints.
+type venafiSettings struct {
+	ID                     string `json:"id,omitempty"`
+	Name                   string `json:"name"`
+	ProxyAddress           string `json:"proxyAddress"`
+	RevocationEnabled      bool   `json:"revocationEnabled"`
+	ClientID               string `json:"clientId,omitempty"`
+	Username               string `json:"username,omitempty"`
+	Password               string `json:"password,omitempty"`
+	RefreshToken           string `json:"refreshToken,omitempty"`
+	PasswordConfigured     bool   `json:"passwordConfigured,omitempty"`
+	RefreshTokenConfigured bool   `json:"refreshTokenConfigured,omitempty"`
+}
+
+type createResponse struct {
+	ID   string `json:"id"`
+	Href string `json:"href"`
+}
+
+// construct builds a venafiSettings object from the provided schema data. Secrets are only sent
+// on create or when they change, as Jamf Pro does not return them.
+func construct(d *schema.ResourceData) (*venafiSettings, error) {
+	resource := &venafiSettings{
+		Name:              d.Get("name").(string),
+		ProxyAddress:      d.Get("proxy_address").(string),
+		RevocationEnabled: d.Get("revocation_enabled").(bool),
+		ClientID:          d.Get("client_id").(string),
+		Username:          d.Get("username").(string),
+	}
+
+	isNew := d.Id() == ""
+
+	if isNew || d.HasChange("password") {
+		resource.Password = d.Get("password").(string)
+	}
+
+	if isNew || d.HasChange("refresh_token") {
+		resource.RefreshToken = d.Get("refresh_token").(string)
+	}
+
+	resourceJSON, err := redact.SerializeAndRedactJSON(resource, []string{"Password", "RefreshToken"})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Jamf Pro Venafi Settings '%s' to JSON: %v", resource.Name, err)
+	}
+
+	log.Printf("[DEBUG] Constructed Jamf Pro Venafi Settings JSON:\n%s\n", string(resourceJSON))
+
+	return resource, nil
+}
@@ -0,0 +1,113 @@
+package venafi_settings
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	crud "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/sdkv2_crud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const uriVenafiSettings = "/api/v1/pki/venafi"
+
+// create is responsible for creating new Venafi settings in Jamf Pro with terraform.
+func create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+	return crud.Create(
+		ctx,
+		d,
+		meta,
+		construct,
+		func(settings *venafiSettings) (*createResponse, error) {
+			var out createResponse
+			if err := send(client, "POST", uriVenafiSettings, settings, &out); err != nil {
+				return nil, err
+			}
+			return &out, nil
+		},
+		readNoCleanup,
+	)
+}
+
+// read is responsible for reading the current state of Venafi settings from Jamf Pro.
+func read(ctx context.Context, d *schema.ResourceData, meta any, cleanup bool) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+	return crud.Read(
+		ctx,
+		d,
+		meta,
+		cleanup,
+		func(id string) (*venafiSettings, error) {
+			var out venafiSettings
+			if err := send(client, "GET", fmt.Sprintf("%s/%s", uriVenafiSettings, id), nil, &out); err != nil {
+				return nil, err
+			}
+			return &out, nil
+		},
+		updateState,
+	)
+}
+
+// readWithCleanup reads the resource with cleanup enabled
+func readWithCleanup(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return read(ctx, d, meta, true)
+}
+
+// readNoCleanup reads the resource with cleanup disabled
+func readNoCleanup(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return read(ctx, d, meta, false)
+}
+
+// update is responsible for updating existing Venafi settings in Jamf Pro.
+func update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+	return crud.Update(
+		ctx,
+		d,
+		meta,
+		construct,
+		func(id string, settings *venafiSettings) (*venafiSettings, error) {
+			var out venafiSettings
+			if err := send(client, "PATCH", fmt.Sprintf("%s/%s", uriVenafiSettings, id), settings, &out); err != nil {
+				return nil, err
+			}
+			return &out, nil
+		},
+		readNoCleanup,
+	)
+}
+
+// delete is responsible for deleting Venafi settings from Jamf Pro.
+func delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+	return crud.Delete(
+		ctx,
+		d,
+		meta,
+		func(id string) error {
+			return send(client, "DELETE", fmt.Sprintf("%s/%s", uriVenafiSettings, id), nil, nil)
+		},
+	)
+}
+
+// send makes a Jamf Pro API Venafi settings request. Updates and deletes may be answered with 204 No
+// Content, which the HTTP client fails to unmarshal, so such a response is only checked for its
+// status code.
+func send(client *jamfpro.Client, method, endpoint string, settings *venafiSettings, out any) error {
+	var payload any
+	if settings != nil {
+		payload = settings
+	}
+
+	resp, err := client.HTTP.DoRequest(method, endpoint, payload, out)
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil && (resp == nil || resp.StatusCode != 204) {
+		return fmt.Errorf("failed to %s Venafi settings at %s: %v", method, endpoint, err)
+	}
+
+	return nil
+}
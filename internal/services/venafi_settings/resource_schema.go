@@ -0,0 +1,83 @@
+package venafi_settings
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceJamfProVenafiSettings defines the schema and CRUD operations for managing Jamf Pro Venafi
+// certificate authority connector settings in Terraform
+func ResourceJamfProVenafiSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: create,
+		ReadContext:   readWithCleanup,
+		UpdateContext: update,
+		DeleteContext: delete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(70 * time.Second),
+			Read:   schema.DefaultTimeout(70 * time.Second),
+			Update: schema.DefaultTimeout(70 * time.Second),
+			Delete: schema.DefaultTimeout(70 * time.Second),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the Venafi settings.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The display name of the Venafi certificate authority in Jamf Pro.",
+			},
+			"proxy_address": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The address of the Jamf Pro PKI Proxy Server the Venafi connector uses.",
+			},
+			"revocation_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether certificates issued through Venafi are revoked when they are removed from devices.",
+			},
+			"client_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "jamf-pro",
+				Description: "The Venafi API integration client ID.",
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The username of the Venafi account used to obtain a refresh token.",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The password of the Venafi account used to obtain a refresh token.",
+			},
+			"refresh_token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "A Venafi refresh token. Can be used instead of a username and password.",
+			},
+			"password_configured": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether a password is configured in Jamf Pro.",
+			},
+			"refresh_token_configured": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether a refresh token is configured in Jamf Pro.",
+			},
+		},
+	}
+}
@@ -0,0 +1,30 @@
+package venafi_settings
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// updateState updates the Terraform state with the latest Venafi settings information from the Jamf Pro API.
+// The password and refresh token are not returned by Jamf Pro and are kept as configured.
+func updateState(d *schema.ResourceData, resp *venafiSettings) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	settings := map[string]any{
+		"name":                     resp.Name,
+		"proxy_address":            resp.ProxyAddress,
+		"revocation_enabled":       resp.RevocationEnabled,
+		"client_id":                resp.ClientID,
+		"username":                 resp.Username,
+		"password_configured":      resp.PasswordConfigured,
+		"refresh_token_configured": resp.RefreshTokenConfigured,
+	}
+
+	for key, val := range settings {
+		if err := d.Set(key, val); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,72 @@
+package volume_purchasing_subscription
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// subscription is the Jamf Pro API representation of a volume purchasing subscription. The SDK
+// type omits 'enabled' when false, so a subscription could never be disabled.
+type subscription struct {
+	ID                 string                                                         `json:"id,omitempty"`
+	Name               string                                                         `json:"name"`
+	Enabled            bool                                                           `json:"enabled"`
+	Triggers           []string                                                       `json:"triggers"`
+	LocationIDs        []string                                                       `json:"locationIds"`
+	InternalRecipients []jamfpro.VolumePurchasingSubscriptionSubsetInternalRecipients `json:"internalRecipients"`
+	ExternalRecipients []jamfpro.VolumePurchasingSubscriptionSubsetExternalRecipients `json:"externalRecipients"`
+	SiteID             string                                                         `json:"siteId"`
+}
+
+// construct builds a subscription object from the provided schema data.
+func construct(d *schema.ResourceData) (*subscription, error) {
+	resource := &subscription{
+		Name:               d.Get("name").(string),
+		Enabled:            d.Get("enabled").(bool),
+		Triggers:           setToStrings(d.Get("triggers")),
+		LocationIDs:        setToStrings(d.Get("location_ids")),
+		InternalRecipients: []jamfpro.VolumePurchasingSubscriptionSubsetInternalRecipients{},
+		ExternalRecipients: []jamfpro.VolumePurchasingSubscriptionSubsetExternalRecipients{},
+		SiteID:             d.Get("site_id").(string),
+	}
+
+	for _, v := range d.Get("internal_recipient").([]any) {
+		recipient := v.(map[string]any)
+		resource.InternalRecipients = append(resource.InternalRecipients, jamfpro.VolumePurchasingSubscriptionSubsetInternalRecipients{
+			AccountId: recipient["account_id"].(string),
+			Frequency: recipient["frequency"].(string),
+		})
+	}
+
+	for _, v := range d.Get("external_recipient").([]any) {
+		recipient := v.(map[string]any)
+		resource.ExternalRecipients = append(resource.ExternalRecipients, jamfpro.VolumePurchasingSubscriptionSubsetExternalRecipients{
+			Name:  recipient["name"].(string),
+			Email: recipient["email"].(string),
+		})
+	}
+
+	resourceJSON, err := json.MarshalIndent(resource, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Jamf Pro Volume Purchasing Subscription '%s' to JSON: %v", resource.Name, err)
+	}
+
+	log.Printf("[DEBUG] Constructed Jamf Pro Volume Purchasing Subscription JSON:\n%s\n", string(resourceJSON))
+
+	return resource, nil
+}
+
+// setToStrings converts a schema set of strings into a non nil slice.
+func setToStrings(v any) []string {
+	out := []string{}
+	if set, ok := v.(*schema.Set); ok {
+		for _, item := range set.List() {
+			out = append(out, item.(string))
+		}
+	}
+	return out
+}
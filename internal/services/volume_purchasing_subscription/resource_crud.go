@@ -0,0 +1,114 @@
+package volume_purchasing_subscription
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	crud "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/sdkv2_crud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const uriVolumePurchasingSubscriptions = "/api/v1/volume-purchasing-subscriptions"
+
+// createResponse is the response of a volume purchasing subscription create request.
+type createResponse struct {
+	ID   string `json:"id"`
+	Href string `json:"href"`
+}
+
+// create is responsible for creating a new volume purchasing subscription in Jamf Pro.
+func create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+	return crud.Create(
+		ctx,
+		d,
+		meta,
+		construct,
+		func(resource *subscription) (*createResponse, error) {
+			var out createResponse
+			if err := send(client, "POST", uriVolumePurchasingSubscriptions, resource, &out); err != nil {
+				return nil, err
+			}
+			return &out, nil
+		},
+		readNoCleanup,
+	)
+}
+
+// read is responsible for reading the current state of a volume purchasing subscription from Jamf Pro.
+func read(ctx context.Context, d *schema.ResourceData, meta any, cleanup bool) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+	return crud.Read(
+		ctx,
+		d,
+		meta,
+		cleanup,
+		func(id string) (*subscription, error) {
+			var out subscription
+			if err := send(client, "GET", fmt.Sprintf("%s/%s", uriVolumePurchasingSubscriptions, id), nil, &out); err != nil {
+				return nil, err
+			}
+			return &out, nil
+		},
+		updateState,
+	)
+}
+
+// readWithCleanup reads the resource with cleanup enabled
+func readWithCleanup(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return read(ctx, d, meta, true)
+}
+
+// readNoCleanup reads the resource with cleanup disabled
+func readNoCleanup(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return read(ctx, d, meta, false)
+}
+
+// update is responsible for updating an existing volume purchasing subscription in Jamf Pro.
+func update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+	return crud.Update(
+		ctx,
+		d,
+		meta,
+		construct,
+		func(id string, resource *subscription) (*subscription, error) {
+			var out subscription
+			if err := send(client, "PUT", fmt.Sprintf("%s/%s", uriVolumePurchasingSubscriptions, id), resource, &out); err != nil {
+				return nil, err
+			}
+			return &out, nil
+		},
+		readNoCleanup,
+	)
+}
+
+// delete is responsible for deleting a volume purchasing subscription from Jamf Pro.
+func delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return crud.Delete(
+		ctx,
+		d,
+		meta,
+		meta.(*jamfpro.Client).DeleteVolumePurchasingSubscriptionByID,
+	)
+}
+
+// send makes a Jamf Pro API volume purchasing subscription request.
+func send(client *jamfpro.Client, method, endpoint string, resource *subscription, out any) error {
+	var payload any
+	if resource != nil {
+		payload = resource
+	}
+
+	resp, err := client.HTTP.DoRequest(method, endpoint, payload, out)
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return fmt.Errorf("failed to %s volume purchasing subscription at %s: %v", method, endpoint, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package volume_purchasing_subscription
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceJamfProVolumePurchasingSubscription defines the schema and CRUD operations for managing
+// Jamf Pro volume purchasing notification subscriptions in Terraform
+func ResourceJamfProVolumePurchasingSubscription() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: create,
+		ReadContext:   readWithCleanup,
+		UpdateContext: update,
+		DeleteContext: delete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(70 * time.Second),
+			Read:   schema.DefaultTimeout(70 * time.Second),
+			Update: schema.DefaultTimeout(70 * time.Second),
+			Delete: schema.DefaultTimeout(70 * time.Second),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the volume purchasing subscription.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the volume purchasing subscription.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether notifications are sent for the subscription.",
+			},
+			"triggers": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The events that send a notification. Valid values are 'NO_MORE_LICENSES' and 'REMOVED_FROM_APP_STORE'.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"NO_MORE_LICENSES", "REMOVED_FROM_APP_STORE"}, false),
+				},
+			},
+			"location_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The IDs of the volume purchasing locations the subscription sends notifications for.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"site_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "-1",
+				Description: "The site ID associated with the volume purchasing subscription. Default is '-1'.",
+			},
+			"internal_recipient": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Jamf Pro user accounts that receive the notifications.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The ID of the Jamf Pro user account.",
+						},
+						"frequency": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "DAILY",
+							Description: "How often the account is notified, e.g. 'DAILY'.",
+						},
+					},
+				},
+			},
+			"external_recipient": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Email recipients without a Jamf Pro user account that receive the notifications.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the recipient.",
+						},
+						"email": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The email address of the recipient.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
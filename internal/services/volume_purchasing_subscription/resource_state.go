@@ -0,0 +1,46 @@
+package volume_purchasing_subscription
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// updateState updates the Terraform state with the latest volume purchasing subscription
+// information from the Jamf Pro API.
+func updateState(d *schema.ResourceData, resp *subscription) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	internalRecipients := make([]any, 0, len(resp.InternalRecipients))
+	for _, recipient := range resp.InternalRecipients {
+		internalRecipients = append(internalRecipients, map[string]any{
+			"account_id": recipient.AccountId,
+			"frequency":  recipient.Frequency,
+		})
+	}
+
+	externalRecipients := make([]any, 0, len(resp.ExternalRecipients))
+	for _, recipient := range resp.ExternalRecipients {
+		externalRecipients = append(externalRecipients, map[string]any{
+			"name":  recipient.Name,
+			"email": recipient.Email,
+		})
+	}
+
+	subscriptionData := map[string]any{
+		"name":               resp.Name,
+		"enabled":            resp.Enabled,
+		"triggers":           resp.Triggers,
+		"location_ids":       resp.LocationIDs,
+		"site_id":            resp.SiteID,
+		"internal_recipient": internalRecipients,
+		"external_recipient": externalRecipients,
+	}
+
+	for key, val := range subscriptionData {
+		if err := d.Set(key, val); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,119 @@
+package vpp_license_assignment
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// licenseScope is the set of users a license assignment is scoped to.
+type licenseScope struct {
+	AllUsers         bool
+	UserIDs          []int
+	GroupIDs         []int
+	ExcludedUserIDs  []int
+	ExcludedGroupIDs []int
+}
+
+// getter is implemented by both schema.ResourceData and schema.ResourceDiff.
+type getter interface {
+	Get(key string) any
+}
+
+// getLicenseScope reads the license scope from the resource data or diff.
+func getLicenseScope(d getter) licenseScope {
+	return licenseScope{
+		AllUsers:         d.Get("all_jss_users").(bool),
+		UserIDs:          intsFromSet(d.Get("jss_user_ids")),
+		GroupIDs:         intsFromSet(d.Get("jss_user_group_ids")),
+		ExcludedUserIDs:  intsFromSet(d.Get("exclusion_jss_user_ids")),
+		ExcludedGroupIDs: intsFromSet(d.Get("exclusion_jss_user_group_ids")),
+	}
+}
+
+// countLicensedUsers returns the number of distinct users the scope assigns a license to. User
+// group members are resolved through the Classic API.
+func countLicensedUsers(client *jamfpro.Client, scope licenseScope) (int, error) {
+	users := map[int]struct{}{}
+
+	if scope.AllUsers {
+		list, err := client.GetUsers()
+		if err != nil {
+			return 0, fmt.Errorf("failed to list users: %v", err)
+		}
+		for _, user := range list.Users {
+			users[user.ID] = struct{}{}
+		}
+	} else {
+		for _, id := range scope.UserIDs {
+			users[id] = struct{}{}
+		}
+		if err := addUserGroupMembers(client, scope.GroupIDs, users); err != nil {
+			return 0, err
+		}
+	}
+
+	excluded := map[int]struct{}{}
+	for _, id := range scope.ExcludedUserIDs {
+		excluded[id] = struct{}{}
+	}
+	if err := addUserGroupMembers(client, scope.ExcludedGroupIDs, excluded); err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for id := range users {
+		if _, ok := excluded[id]; !ok {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+// addUserGroupMembers adds the IDs of the members of the user groups to users.
+func addUserGroupMembers(client *jamfpro.Client, groupIDs []int, users map[int]struct{}) error {
+	for _, groupID := range groupIDs {
+		group, err := client.GetUserGroupByID(strconv.Itoa(groupID))
+		if err != nil {
+			return fmt.Errorf("failed to get user group %d: %v", groupID, err)
+		}
+		for _, user := range group.Users {
+			users[user.ID] = struct{}{}
+		}
+	}
+	return nil
+}
+
+// getContent returns the purchased content with the Adam ID from the volume purchasing location,
+// or nil when the location has no such content.
+func getContent(client *jamfpro.Client, locationID int, adamID string) (*jamfpro.VolumePurchasingSubsetContent, error) {
+	content, err := client.GetVolumePurchasingContentForLocationByID(strconv.Itoa(locationID), nil, "")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range content.Results {
+		if item.AdamId == adamID {
+			return &item, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// intsFromSet converts a schema set of integers into a slice.
+func intsFromSet(v any) []int {
+	set, ok := v.(*schema.Set)
+	if !ok {
+		return nil
+	}
+
+	out := make([]int, 0, set.Len())
+	for _, item := range set.List() {
+		out = append(out, item.(int))
+	}
+	return out
+}
@@ -0,0 +1,90 @@
+package vpp_license_assignment
+
+import (
+	"encoding/xml"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	contentTypeIOSApp = "ios_app"
+	contentTypeMacApp = "mac_app"
+	contentTypeEbook  = "ebook"
+)
+
+// vppAssignment is the Classic API representation of a VPP assignment. The SDK create does not
+// return the ID of the assignment.
+type vppAssignment struct {
+	XMLName xml.Name                           `xml:"vpp_assignment"`
+	ID      int                                `xml:"id,omitempty"`
+	General jamfpro.VPPAssignmentSubsetGeneral `xml:"general"`
+	IOSApps []jamfpro.VPPSubsetVPPApp          `xml:"ios_apps>ios_app,omitempty"`
+	MacApps []jamfpro.VPPSubsetVPPApp          `xml:"mac_apps>mac_app,omitempty"`
+	EBooks  []jamfpro.VPPSubsetVPPApp          `xml:"ebooks>ebook,omitempty"`
+	Scope   jamfpro.VPPAssignmentSubsetScope   `xml:"scope"`
+}
+
+// construct builds a vppAssignment object from the provided schema data.
+func construct(d *schema.ResourceData) (*vppAssignment, error) {
+	adamID, err := strconv.Atoi(d.Get("adam_id").(string))
+	if err != nil {
+		return nil, fmt.Errorf("invalid adam_id: %v", err)
+	}
+
+	resource := &vppAssignment{
+		General: jamfpro.VPPAssignmentSubsetGeneral{
+			Name:              d.Get("name").(string),
+			VPPAdminAccountID: d.Get("volume_purchasing_location_id").(int),
+		},
+		Scope: jamfpro.VPPAssignmentSubsetScope{
+			AllJSSUsers:   d.Get("all_jss_users").(bool),
+			JSSUsers:      constructUsers(d.Get("jss_user_ids")),
+			JSSUserGroups: constructUserGroups(d.Get("jss_user_group_ids")),
+			Exclusions: jamfpro.VPPAssignmentSubsetScopeExclusions{
+				JSSUsers:      constructUsers(d.Get("exclusion_jss_user_ids")),
+				JSSUserGroups: constructUserGroups(d.Get("exclusion_jss_user_group_ids")),
+			},
+		},
+	}
+
+	content := []jamfpro.VPPSubsetVPPApp{{AdamID: adamID}}
+	switch d.Get("content_type").(string) {
+	case contentTypeMacApp:
+		resource.MacApps = content
+	case contentTypeEbook:
+		resource.EBooks = content
+	default:
+		resource.IOSApps = content
+	}
+
+	resourceXML, err := xml.MarshalIndent(resource, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Jamf Pro VPP License Assignment '%s' to XML: %v", resource.General.Name, err)
+	}
+
+	log.Printf("[DEBUG] Constructed Jamf Pro VPP License Assignment XML:\n%s\n", string(resourceXML))
+
+	return resource, nil
+}
+
+// constructUsers converts a set of user IDs into VPP assignment users.
+func constructUsers(v any) []jamfpro.VPPSubsetVPPUser {
+	var users []jamfpro.VPPSubsetVPPUser
+	for _, id := range v.(*schema.Set).List() {
+		users = append(users, jamfpro.VPPSubsetVPPUser{ID: id.(int)})
+	}
+	return users
+}
+
+// constructUserGroups converts a set of user group IDs into VPP assignment user groups.
+func constructUserGroups(v any) []jamfpro.VPPSubsetVPPUserGroup {
+	var groups []jamfpro.VPPSubsetVPPUserGroup
+	for _, id := range v.(*schema.Set).List() {
+		groups = append(groups, jamfpro.VPPSubsetVPPUserGroup{ID: id.(int)})
+	}
+	return groups
+}
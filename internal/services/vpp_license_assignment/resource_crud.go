@@ -0,0 +1,126 @@
+package vpp_license_assignment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	crud "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/sdkv2_crud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const uriVPPAssignments = "/JSSResource/vppassignments"
+
+// create is responsible for creating a new VPP license assignment in Jamf Pro.
+func create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+	return crud.Create(
+		ctx,
+		d,
+		meta,
+		construct,
+		func(assignment *vppAssignment) (*vppAssignment, error) {
+			return send(client, "POST", fmt.Sprintf("%s/id/0", uriVPPAssignments), assignment)
+		},
+		readNoCleanup,
+	)
+}
+
+// read is responsible for reading the current state of a VPP license assignment from Jamf Pro,
+// along with the license counts of the assigned content.
+func read(ctx context.Context, d *schema.ResourceData, meta any, cleanup bool) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+	diags := crud.Read(
+		ctx,
+		d,
+		meta,
+		cleanup,
+		func(id string) (*vppAssignment, error) {
+			return send(client, "GET", fmt.Sprintf("%s/id/%s", uriVPPAssignments, id), nil)
+		},
+		updateState,
+	)
+	if diags.HasError() || d.Id() == "" {
+		return diags
+	}
+
+	content, err := getContent(client, d.Get("volume_purchasing_location_id").(int), d.Get("adam_id").(string))
+	if err != nil {
+		return append(diags, diag.FromErr(fmt.Errorf("failed to get volume purchasing content: %v", err))...)
+	}
+	if content != nil {
+		diags = append(diags, stateContent(d, content)...)
+	}
+
+	// Imported assignments have no license count yet, which would count their licenses twice on the next plan.
+	if d.Get("assigned_license_count").(int) == 0 {
+		assigned, err := countLicensedUsers(client, getLicenseScope(d))
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		if err := d.Set("assigned_license_count", assigned); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return diags
+}
+
+// readWithCleanup reads the resource with cleanup enabled
+func readWithCleanup(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return read(ctx, d, meta, true)
+}
+
+// readNoCleanup reads the resource with cleanup disabled
+func readNoCleanup(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return read(ctx, d, meta, false)
+}
+
+// update is responsible for updating an existing VPP license assignment in Jamf Pro.
+func update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+	return crud.Update(
+		ctx,
+		d,
+		meta,
+		construct,
+		func(id string, assignment *vppAssignment) (*vppAssignment, error) {
+			return send(client, "PUT", fmt.Sprintf("%s/id/%s", uriVPPAssignments, id), assignment)
+		},
+		readNoCleanup,
+	)
+}
+
+// delete is responsible for deleting a VPP license assignment from Jamf Pro.
+func delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return crud.Delete(
+		ctx,
+		d,
+		meta,
+		meta.(*jamfpro.Client).DeleteVPPAssignmentByID,
+	)
+}
+
+// send makes a Classic API VPP assignment request.
+func send(client *jamfpro.Client, method, endpoint string, assignment *vppAssignment) (*vppAssignment, error) {
+	var payload any
+	if assignment != nil {
+		payload = assignment
+	}
+
+	var out vppAssignment
+	resp, err := client.HTTP.DoRequest(method, endpoint, payload, &out)
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to %s VPP assignment at %s: %v", method, endpoint, err)
+	}
+
+	if out.ID == 0 {
+		out.ID = out.General.ID
+	}
+
+	return &out, nil
+}
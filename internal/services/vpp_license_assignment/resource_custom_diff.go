@@ -0,0 +1,77 @@
+package vpp_license_assignment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// licenseKeys are the attributes that change the number of licenses an assignment requires.
+var licenseKeys = []string{
+	"volume_purchasing_location_id",
+	"adam_id",
+	"all_jss_users",
+	"jss_user_ids",
+	"jss_user_group_ids",
+	"exclusion_jss_user_ids",
+	"exclusion_jss_user_group_ids",
+}
+
+// mainCustomDiffFunc orchestrates all custom diff validations.
+func mainCustomDiffFunc(ctx context.Context, diff *schema.ResourceDiff, meta any) error {
+	return validateLicenseAvailability(ctx, diff, meta)
+}
+
+// validateLicenseAvailability fails the plan when the assignment requires more licenses than the
+// volume purchasing location has available, license_count_total - license_count_in_use. Licenses
+// already held through this assignment are not counted again.
+func validateLicenseAvailability(_ context.Context, diff *schema.ResourceDiff, meta any) error {
+	client, ok := meta.(*jamfpro.Client)
+	if !ok {
+		return nil
+	}
+
+	for _, key := range licenseKeys {
+		if !diff.NewValueKnown(key) {
+			return diff.SetNewComputed("assigned_license_count")
+		}
+	}
+
+	if diff.Id() != "" && !diff.HasChanges(licenseKeys...) {
+		return nil
+	}
+
+	resourceName := diff.Get("name").(string)
+	locationID := diff.Get("volume_purchasing_location_id").(int)
+	adamID := diff.Get("adam_id").(string)
+
+	content, err := getContent(client, locationID, adamID)
+	if err != nil {
+		return fmt.Errorf("in 'jamfpro_vpp_license_assignment.%s': failed to get content of volume purchasing location %d: %v", resourceName, locationID, err)
+	}
+	if content == nil {
+		return fmt.Errorf("in 'jamfpro_vpp_license_assignment.%s': adam_id %s was not found in the content of volume purchasing location %d", resourceName, adamID, locationID)
+	}
+
+	requested, err := countLicensedUsers(client, getLicenseScope(diff))
+	if err != nil {
+		return fmt.Errorf("in 'jamfpro_vpp_license_assignment.%s': failed to count assigned users: %v", resourceName, err)
+	}
+
+	alreadyAssigned := 0
+	if diff.Id() != "" && !diff.HasChanges("volume_purchasing_location_id", "adam_id") {
+		old, _ := diff.GetChange("assigned_license_count")
+		alreadyAssigned = old.(int)
+	}
+
+	available := content.LicenseCountTotal - content.LicenseCountInUse
+	if additional := requested - alreadyAssigned; additional > available {
+		return fmt.Errorf("in 'jamfpro_vpp_license_assignment.%s': assigning '%s' (adam_id %s) requires %d additional licenses, "+
+			"but volume purchasing location %d only has %d available (license_count_total %d - license_count_in_use %d)",
+			resourceName, content.Name, adamID, additional, locationID, available, content.LicenseCountTotal, content.LicenseCountInUse)
+	}
+
+	return diff.SetNew("assigned_license_count", requested)
+}
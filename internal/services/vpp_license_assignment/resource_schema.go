@@ -0,0 +1,110 @@
+package vpp_license_assignment
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceJamfProVPPLicenseAssignment defines the schema and CRUD operations for managing Jamf Pro
+// volume purchasing license assignments in Terraform
+func ResourceJamfProVPPLicenseAssignment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: create,
+		ReadContext:   readWithCleanup,
+		UpdateContext: update,
+		DeleteContext: delete,
+		CustomizeDiff: mainCustomDiffFunc,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(70 * time.Second),
+			Read:   schema.DefaultTimeout(70 * time.Second),
+			Update: schema.DefaultTimeout(70 * time.Second),
+			Delete: schema.DefaultTimeout(70 * time.Second),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the license assignment.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the license assignment.",
+			},
+			"volume_purchasing_location_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The ID of the volume purchasing location the licenses are assigned from.",
+			},
+			"adam_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The Apple ID (Adam ID) of the purchased content to assign licenses for.",
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[0-9]+$`), "must be a numeric Adam ID"),
+			},
+			"content_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      contentTypeIOSApp,
+				Description:  "The type of the content. Valid values are 'ios_app', 'mac_app' and 'ebook'.",
+				ValidateFunc: validation.StringInSlice([]string{contentTypeIOSApp, contentTypeMacApp, contentTypeEbook}, false),
+			},
+			"all_jss_users": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether licenses are assigned to all Jamf Pro users.",
+			},
+			"jss_user_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The IDs of the Jamf Pro users licenses are assigned to.",
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"jss_user_group_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The IDs of the Jamf Pro user groups whose members licenses are assigned to.",
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"exclusion_jss_user_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The IDs of the Jamf Pro users excluded from the assignment.",
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"exclusion_jss_user_group_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The IDs of the Jamf Pro user groups whose members are excluded from the assignment.",
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"content_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the purchased content.",
+			},
+			"license_count_total": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The total number of licenses of the content in the volume purchasing location.",
+			},
+			"license_count_in_use": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of licenses of the content in use.",
+			},
+			"assigned_license_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of users the assignment assigns a license to, as counted when the plan was made.",
+			},
+		},
+	}
+}
@@ -0,0 +1,85 @@
+package vpp_license_assignment
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// updateState updates the Terraform state with the latest VPP license assignment information from
+// the Jamf Pro API.
+func updateState(d *schema.ResourceData, resp *vppAssignment) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	contentType, adamID := contentTypeIOSApp, ""
+	switch {
+	case len(resp.IOSApps) > 0:
+		adamID = strconv.Itoa(resp.IOSApps[0].AdamID)
+	case len(resp.MacApps) > 0:
+		contentType, adamID = contentTypeMacApp, strconv.Itoa(resp.MacApps[0].AdamID)
+	case len(resp.EBooks) > 0:
+		contentType, adamID = contentTypeEbook, strconv.Itoa(resp.EBooks[0].AdamID)
+	}
+
+	assignmentData := map[string]any{
+		"name":                          resp.General.Name,
+		"volume_purchasing_location_id": resp.General.VPPAdminAccountID,
+		"adam_id":                       adamID,
+		"content_type":                  contentType,
+		"all_jss_users":                 resp.Scope.AllJSSUsers,
+		"jss_user_ids":                  userIDs(resp.Scope.JSSUsers),
+		"jss_user_group_ids":            userGroupIDs(resp.Scope.JSSUserGroups),
+		"exclusion_jss_user_ids":        userIDs(resp.Scope.Exclusions.JSSUsers),
+		"exclusion_jss_user_group_ids":  userGroupIDs(resp.Scope.Exclusions.JSSUserGroups),
+	}
+
+	for key, val := range assignmentData {
+		if err := d.Set(key, val); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return diags
+}
+
+// stateContent sets the license counts of the assigned content.
+func stateContent(d *schema.ResourceData, content *jamfpro.VolumePurchasingSubsetContent) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	contentData := map[string]any{
+		"content_name":         content.Name,
+		"license_count_total":  content.LicenseCountTotal,
+		"license_count_in_use": content.LicenseCountInUse,
+	}
+
+	for key, val := range contentData {
+		if err := d.Set(key, val); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return diags
+}
+
+// userIDs returns the sorted IDs of VPP assignment users.
+func userIDs(users []jamfpro.VPPSubsetVPPUser) []int {
+	ids := make([]int, 0, len(users))
+	for _, user := range users {
+		ids = append(ids, user.ID)
+	}
+	sort.Ints(ids)
+	return ids
+}
+
+// userGroupIDs returns the sorted IDs of VPP assignment user groups.
+func userGroupIDs(groups []jamfpro.VPPSubsetVPPUserGroup) []int {
+	ids := make([]int, 0, len(groups))
+	for _, group := range groups {
+		ids = append(ids, group.ID)
+	}
+	sort.Ints(ids)
+	return ids
+}